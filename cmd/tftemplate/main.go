@@ -2,8 +2,11 @@ package main
 
 import (
 	_ "embed"
+	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 	"text/template"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -27,6 +30,53 @@ var (
 	resourceSweepTemplateFile string
 	//go:embed sweep.go.tmpl
 	resourceSweepTestTemplateFile string
+	//go:embed framework_resource.go.tmpl
+	frameworkResourceTemplateFile string
+	//go:embed framework_resource_test.go.tmpl
+	frameworkResourceTestTemplateFile string
+	//go:embed framework_resource_description.md.tmpl
+	frameworkResourceDescriptionTemplateFile string
+	//go:embed action.go.tmpl
+	actionTemplateFile string
+	//go:embed action_test.go.tmpl
+	actionTestTemplateFile string
+	//go:embed action_description.md.tmpl
+	actionDescriptionTemplateFile string
+	//go:embed list.go.tmpl
+	listTemplateFile string
+	//go:embed list_test.go.tmpl
+	listTestTemplateFile string
+	//go:embed ephemeral.go.tmpl
+	ephemeralTemplateFile string
+	//go:embed ephemeral_test.go.tmpl
+	ephemeralTestTemplateFile string
+	//go:embed ephemeral_description.md.tmpl
+	ephemeralDescriptionTemplateFile string
+)
+
+const providerFileName = "../../provider/framework.go"
+
+// targets are the kinds of types which can be generated. The framework ones are registered in ScalewayProvider.
+var targets = []string{"resource", "datasource", "framework-resource", "action", "list", "ephemeral"}
+
+// providerMethods are the methods of ScalewayProvider returning the constructors of each framework target.
+var providerMethods = map[string]string{
+	"framework-resource": "Resources",
+	"action":             "Actions",
+	"list":               "ListResources",
+	"ephemeral":          "EphemeralResources",
+}
+
+var (
+	targetsFlag  = flag.String("targets", "", "Comma separated targets to generate: "+strings.Join(targets, ", "))
+	apiFlag      = flag.String("api", "", "API name (function, instance, container)")
+	resourceFlag = flag.String("resource", "", "Resource name (FunctionNamespace, InstanceServer)")
+	localityFlag = flag.String("locality", "zone", "Resource locality: zone or region")
+	actionFlag   = flag.String("action", "", "Action name, for the action target (Restart, ApplyMaintenance)")
+	helpersFlag  = flag.Bool("helpers", false, "Generate helpers, will override ../../internal/services/{api}/helpers_{api}.go")
+	waitersFlag  = flag.Bool("waiters", true, "Generate waiters, will be added to ../../internal/services/{api}/waiter.go")
+	sweepFlag    = flag.Bool("sweep", true, "Generate sweeper, will be added to ../../internal/services/{api}/sweep.go")
+	registerFlag = flag.Bool("register", true, "Register the generated framework types in "+providerFileName)
 )
 
 var resourceQS = []*survey.Question{
@@ -34,7 +84,7 @@ var resourceQS = []*survey.Question{
 		Name: "targets",
 		Prompt: &survey.MultiSelect{
 			Message: "Select targets to generate",
-			Options: []string{"resource", "datasource"},
+			Options: targets,
 			Default: []string{"resource"},
 		},
 	},
@@ -76,6 +126,22 @@ var resourceQS = []*survey.Question{
 			Default: true,
 		},
 	},
+	{
+		Name: "register",
+		Prompt: &survey.Confirm{
+			Message: "Register framework types ? Will be added to " + providerFileName,
+			Default: true,
+		},
+	},
+}
+
+var actionQS = []*survey.Question{
+	{
+		Name:      "action",
+		Prompt:    &survey.Input{Message: "Action name (Restart, ApplyMaintenance)"},
+		Validate:  survey.Required,
+		Transform: survey.Title,
+	},
 }
 
 func contains[T comparable](slice []T, expected T) bool {
@@ -88,22 +154,82 @@ func contains[T comparable](slice []T, expected T) bool {
 	return false
 }
 
+type generatorInput struct {
+	Targets  []string
+	API      string
+	Resource string
+	Locality string
+	Action   string
+	Helpers  bool
+	Waiters  bool
+	Sweep    bool
+	Register bool
+}
+
+// askResourceInput reads the input from the flags, or asks for it if neither the targets, API nor resource flags are set.
+func askResourceInput() (generatorInput, error) {
+	input := generatorInput{}
+
+	if *targetsFlag == "" && *apiFlag == "" && *resourceFlag == "" {
+		err := survey.Ask(resourceQS, &input)
+		if err == nil && contains(input.Targets, "action") {
+			err = survey.Ask(actionQS, &input)
+		}
+
+		return input, err
+	}
+
+	input = generatorInput{
+		Targets:  strings.Split(*targetsFlag, ","),
+		API:      *apiFlag,
+		Resource: *resourceFlag,
+		Locality: *localityFlag,
+		Action:   *actionFlag,
+		Helpers:  *helpersFlag,
+		Waiters:  *waitersFlag,
+		Sweep:    *sweepFlag,
+		Register: *registerFlag,
+	}
+
+	if *targetsFlag == "" {
+		input.Targets = []string{"resource"}
+	}
+
+	for _, target := range input.Targets {
+		if !contains(targets, target) {
+			return input, fmt.Errorf("unknown target %q, expected one of %s", target, strings.Join(targets, ", "))
+		}
+	}
+
+	switch {
+	case input.API == "" || input.Resource == "":
+		return input, fmt.Errorf("-api and -resource are required")
+	case input.Locality != "zone" && input.Locality != "region":
+		return input, fmt.Errorf("unknown locality %q, expected zone or region", input.Locality)
+	case contains(input.Targets, "action") && input.Action == "":
+		return input, fmt.Errorf("-action is required for the action target")
+	}
+
+	input.Resource = strings.ToUpper(input.Resource[:1]) + input.Resource[1:]
+	if input.Action != "" {
+		input.Action = strings.ToUpper(input.Action[:1]) + input.Action[1:]
+	}
+
+	return input, nil
+}
+
 func main() {
-	resourceInput := struct {
-		Targets  []string
-		API      string
-		Resource string
-		Locality string
-		Helpers  bool
-		Waiters  bool
-		Sweep    bool
-	}{}
-	err := survey.Ask(resourceQS, &resourceInput)
+	flag.Parse()
+
+	resourceInput, err := askResourceInput()
 	if err != nil {
 		log.Fatalln(err)
 	}
 	resourceData := models.NewResourceTemplate(resourceInput.API, resourceInput.Resource, resourceInput.Locality)
 	resourceData.SupportWaiters = resourceInput.Waiters
+	resourceData.SetAction(resourceInput.Action)
+
+	hasResource := contains(resourceInput.Targets, "resource") || contains(resourceInput.Targets, "framework-resource")
 
 	templates := []*TerraformTemplate{
 		{
@@ -140,15 +266,70 @@ func main() {
 		{
 			FileName:     fmt.Sprintf("../../internal/services/%s/testfuncs/sweep.go", resourceData.API),
 			TemplateFile: resourceSweepTemplateFile,
-			Skip:         !contains(resourceInput.Targets, "resource"),
+			Skip:         !hasResource || !resourceInput.Sweep,
 			Append:       true,
 		},
 		{
 			FileName:     fmt.Sprintf("../../internal/services/%s/sweep_test.go", resourceData.API),
 			TemplateFile: resourceSweepTestTemplateFile,
-			Skip:         !contains(resourceInput.Targets, "resource"),
+			Skip:         !hasResource || !resourceInput.Sweep,
 			Append:       true,
 		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/%s_resource.go", resourceData.API, resourceData.ResourceCleanHCL),
+			TemplateFile: frameworkResourceTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "framework-resource"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/%s_resource_test.go", resourceData.API, resourceData.ResourceCleanHCL),
+			TemplateFile: frameworkResourceTestTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "framework-resource"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/descriptions/%s_resource.md", resourceData.API, resourceData.ResourceCleanHCL),
+			TemplateFile: frameworkResourceDescriptionTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "framework-resource"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/%s_%s_action.go", resourceData.API, resourceData.ResourceCleanHCL, resourceData.ActionHCL),
+			TemplateFile: actionTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "action"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/%s_%s_action_test.go", resourceData.API, resourceData.ResourceCleanHCL, resourceData.ActionHCL),
+			TemplateFile: actionTestTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "action"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/descriptions/%s_%s_action.md", resourceData.API, resourceData.ResourceCleanHCL, resourceData.ActionHCL),
+			TemplateFile: actionDescriptionTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "action"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/%s_list.go", resourceData.API, resourceData.ResourceCleanHCL),
+			TemplateFile: listTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "list"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/%s_list_test.go", resourceData.API, resourceData.ResourceCleanHCL),
+			TemplateFile: listTestTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "list"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/%s_ephemeral_resource.go", resourceData.API, resourceData.ResourceCleanHCL),
+			TemplateFile: ephemeralTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "ephemeral"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/%s_ephemeral_resource_test.go", resourceData.API, resourceData.ResourceCleanHCL),
+			TemplateFile: ephemeralTestTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "ephemeral"),
+		},
+		{
+			FileName:     fmt.Sprintf("../../internal/services/%s/descriptions/%s_ephemeral_resource.md", resourceData.API, resourceData.ResourceCleanHCL),
+			TemplateFile: ephemeralDescriptionTemplateFile,
+			Skip:         !contains(resourceInput.Targets, "ephemeral"),
+		},
 	}
 
 	for _, tmpl := range templates {
@@ -160,6 +341,8 @@ func main() {
 		}
 	}
 
+	failed := false
+
 	for _, tmpl := range templates {
 		if tmpl.Skip {
 			continue
@@ -167,6 +350,44 @@ func main() {
 		err := executeTemplate(tmpl, resourceData)
 		if err != nil {
 			log.Println(err)
+
+			failed = true
+		}
+	}
+
+	if resourceInput.Register && !registerFrameworkTypes(resourceInput.Targets, resourceData) {
+		failed = true
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// registerFrameworkTypes registers the generated framework types in the provider and returns false if any of them failed.
+func registerFrameworkTypes(targets []string, resourceData models.ResourceTemplate) bool {
+	registered := true
+
+	constructors := map[string]string{
+		"framework-resource": "New" + resourceData.ResourceClean + "Resource",
+		"action":             "New" + resourceData.ResourceClean + resourceData.Action + "Action",
+		"list":               "New" + resourceData.ResourceClean + "ListResource",
+		"ephemeral":          "New" + resourceData.ResourceClean + "EphemeralResource",
+	}
+
+	for _, target := range targets {
+		method, isFramework := providerMethods[target]
+		if !isFramework {
+			continue
+		}
+
+		err := registerInProvider(providerFileName, method, resourceData.API, constructors[target])
+		if err != nil {
+			log.Printf("failed to register %s in %s: %s", constructors[target], providerFileName, err)
+
+			registered = false
 		}
 	}
+
+	return registered
 }
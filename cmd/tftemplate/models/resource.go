@@ -18,8 +18,11 @@ type ResourceTemplate struct {
 	ResourceCleanLow        string // namespace
 	ResourceFistLetterUpper string
 	ResourceHCL             string // function_namespace
+	ResourceCleanHCL        string // namespace
 	API                     string // function
 	APIFirstLetterUpper     string // Function
+	Action                  string // Restart
+	ActionHCL               string // restart
 
 	SupportWaiters bool // If resource have waiters
 }
@@ -65,7 +68,7 @@ func splitByWord(sentence string) []string {
 func cleanResource(api string, resource string, upperCase bool) string {
 	words := splitByWord(resource)
 
-	if strings.ToLower(words[0]) == strings.ToLower(api) {
+	if len(words) > 1 && strings.ToLower(words[0]) == strings.ToLower(api) {
 		words = words[1:]
 	}
 
@@ -112,7 +115,15 @@ func NewResourceTemplate(api string, resource string, locality string) ResourceT
 		ResourceClean:          cleanResource(api, resource, true),
 		ResourceCleanLow:       cleanResource(api, resource, false),
 		ResourceHCL:            strings.Join(resourceWordsLower(resource), "_"),
+		ResourceCleanHCL:       strings.Join(resourceWordsLower(cleanResource(api, resource, true)), "_"),
 		API:                    api,
 		APIFirstLetterUpper:    FirstLetterUpper(api),
 	}
 }
+
+// SetAction sets the name of the generated action
+// action: Restart, ApplyMaintenance
+func (t *ResourceTemplate) SetAction(action string) {
+	t.Action = action
+	t.ActionHCL = strings.Join(resourceWordsLower(action), "_")
+}
@@ -33,3 +33,27 @@ func Test_splitByWord(t *testing.T) {
 		})
 	}
 }
+
+func TestNewResourceTemplate(t *testing.T) {
+	tests := []struct {
+		api              string
+		resource         string
+		resourceClean    string
+		resourceCleanHCL string
+	}{
+		{"function", "FunctionNamespace", "Namespace", "namespace"},
+		{"rdb", "RdbReadReplica", "ReadReplica", "read_replica"},
+		{"datalab", "Datalab", "Datalab", "datalab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			got := NewResourceTemplate(tt.api, tt.resource, "region")
+			if got.ResourceClean != tt.resourceClean {
+				t.Errorf("ResourceClean = %v, want %v", got.ResourceClean, tt.resourceClean)
+			}
+			if got.ResourceCleanHCL != tt.resourceCleanHCL {
+				t.Errorf("ResourceCleanHCL = %v, want %v", got.ResourceCleanHCL, tt.resourceCleanHCL)
+			}
+		})
+	}
+}
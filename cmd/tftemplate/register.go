@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"strings"
+)
+
+const servicesImportPrefix = "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/"
+
+// registerInProvider adds a constructor to the list returned by a method of ScalewayProvider.
+// The package of the service is imported if needed, with an alias if its name is already used by another import.
+func registerInProvider(fileName string, method string, api string, constructor string) error {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	src, pkg := ensureImport(string(content), api)
+
+	start := strings.Index(src, "func (p *ScalewayProvider) "+method+"(")
+	if start == -1 {
+		return fmt.Errorf("method %s not found in %s", method, fileName)
+	}
+
+	listStart := strings.Index(src[start:], "{\n\t\t")
+	listEnd := strings.Index(src[start:], "\n\t}\n}")
+	if listStart == -1 || listEnd == -1 || listEnd < listStart {
+		return fmt.Errorf("constructor list of %s not found in %s", method, fileName)
+	}
+
+	listStart += start + 2
+	listEnd += start + 1
+
+	entries := strings.Split(strings.TrimSuffix(src[listStart:listEnd], "\n"), "\n")
+	entry := "\t\t" + pkg + "." + constructor + ","
+
+	for _, e := range entries {
+		if e == entry {
+			return nil
+		}
+	}
+
+	entries = insertEntry(entries, entry, pkg)
+
+	src = src[:listStart] + strings.Join(entries, "\n") + "\n" + src[listEnd:]
+
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", fileName, err)
+	}
+
+	return os.WriteFile(fileName, formatted, 0o644)
+}
+
+// insertEntry inserts an entry after the entries of the same package, or before the first package sorted after it.
+func insertEntry(entries []string, entry string, pkg string) []string {
+	position := len(entries)
+
+	for i, e := range entries {
+		ePkg := strings.SplitN(strings.TrimSpace(e), ".", 2)[0]
+		if ePkg == pkg {
+			position = i + 1
+		} else if ePkg > pkg && position == len(entries) {
+			position = i
+
+			break
+		}
+	}
+
+	entries = append(entries, "")
+	copy(entries[position+1:], entries[position:])
+	entries[position] = entry
+
+	return entries
+}
+
+// ensureImport imports the package of a service and returns the updated source and the name of the package.
+func ensureImport(src string, api string) (string, string) {
+	importPath := servicesImportPrefix + api
+	importsStart := strings.Index(src, "import (\n")
+	importsEnd := strings.Index(src, "\n)\n")
+
+	lines := strings.Split(src[importsStart+len("import (\n"):importsEnd], "\n")
+	names := map[string]bool{}
+	position := -1
+	firstService := len(lines)
+
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		linePath := strings.Trim(fields[len(fields)-1], `"`)
+		if linePath == importPath {
+			if len(fields) == 2 {
+				return src, fields[0]
+			}
+
+			return src, api
+		}
+
+		name := path.Base(linePath)
+		if len(fields) == 2 {
+			name = fields[0]
+		}
+
+		names[name] = true
+
+		if strings.HasPrefix(linePath, servicesImportPrefix) {
+			firstService = min(firstService, i)
+
+			if linePath < importPath {
+				position = i + 1
+			}
+		}
+	}
+
+	pkg := api
+	importLine := "\t\"" + importPath + "\""
+
+	if names[api] {
+		pkg = api + "service"
+		importLine = "\t" + pkg + " \"" + importPath + "\""
+	}
+
+	if position == -1 {
+		position = firstService
+	}
+
+	lines = append(lines, "")
+	copy(lines[position+1:], lines[position:])
+	lines[position] = importLine
+
+	return src[:importsStart+len("import (\n")] + strings.Join(lines, "\n") + src[importsEnd:], pkg
+}
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// copyProviderFixture copies the provider fixture to a temporary directory and returns its path.
+func copyProviderFixture(t *testing.T) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("testdata", "framework.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileName := filepath.Join(t.TempDir(), "framework.go")
+
+	err = os.WriteFile(fileName, content, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fileName
+}
+
+// importLines returns the lines of the import block of src.
+func importLines(src string) []string {
+	start := strings.Index(src, "import (\n") + len("import (\n")
+	end := strings.Index(src, "\n)\n")
+
+	return strings.Split(src[start:end], "\n")
+}
+
+// constructorLines returns the constructors returned by a method of ScalewayProvider in src.
+func constructorLines(src string, method string) []string {
+	start := strings.Index(src, "func (p *ScalewayProvider) "+method+"(")
+	start += strings.Index(src[start:], "{\n\t\t") + 2
+	end := start + strings.Index(src[start:], "\n\t}\n}")
+
+	return strings.Split(src[start:end], "\n")
+}
+
+func TestRegisterInProvider(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "framework.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fixtureImports := importLines(string(fixture))
+
+	tests := []struct {
+		name         string
+		method       string
+		api          string
+		constructor  string
+		wantImports  []string
+		wantEntries  []string
+		wantErr      string
+		wantNoChange bool
+	}{
+		{
+			name:        "imported service",
+			method:      "Resources",
+			api:         "vpc",
+			constructor: "NewRouteResource",
+			wantImports: fixtureImports,
+			wantEntries: []string{
+				"\t\taccount.NewProjectResource,",
+				"\t\tvpc.NewVPCResource,",
+				"\t\tvpc.NewRouteResource,",
+			},
+		},
+		{
+			name:        "new service",
+			method:      "ListResources",
+			api:         "k8s",
+			constructor: "NewClusterListResource",
+			wantImports: []string{
+				"\t\"context\"",
+				"",
+				"\t\"github.com/hashicorp/terraform-plugin-framework/action\"",
+				"\t\"github.com/hashicorp/terraform-plugin-framework/function\"",
+				"\t\"github.com/hashicorp/terraform-plugin-framework/list\"",
+				"\t\"github.com/hashicorp/terraform-plugin-framework/resource\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account\"",
+				"\tfunctionservice \"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/function\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/k8s\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify\"",
+			},
+			wantEntries: []string{
+				"\t\taccount.NewProjectListResource,",
+				"\t\tfunctionservice.NewFunctionListResource,",
+				"\t\tinstance.NewServerListResource,",
+				"\t\tk8s.NewClusterListResource,",
+				"\t\tvpc.NewVPCListResource,",
+			},
+		},
+		{
+			name:        "aliased service",
+			method:      "Actions",
+			api:         "function",
+			constructor: "NewSyncFunctionAction",
+			wantImports: fixtureImports,
+			wantEntries: []string{
+				"\t\tfunctionservice.NewSyncFunctionAction,",
+				"\t\tinstance.NewServerAction,",
+			},
+		},
+		{
+			name:        "new service with a used package name",
+			method:      "Actions",
+			api:         "action",
+			constructor: "NewRunAction",
+			wantImports: []string{
+				"\t\"context\"",
+				"",
+				"\t\"github.com/hashicorp/terraform-plugin-framework/action\"",
+				"\t\"github.com/hashicorp/terraform-plugin-framework/function\"",
+				"\t\"github.com/hashicorp/terraform-plugin-framework/list\"",
+				"\t\"github.com/hashicorp/terraform-plugin-framework/resource\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account\"",
+				"\tactionservice \"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/action\"",
+				"\tfunctionservice \"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/function\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc\"",
+				"\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify\"",
+			},
+			wantEntries: []string{
+				"\t\tactionservice.NewRunAction,",
+				"\t\tinstance.NewServerAction,",
+			},
+		},
+		{
+			name:         "already registered",
+			method:       "ListResources",
+			api:          "instance",
+			constructor:  "NewServerListResource",
+			wantNoChange: true,
+		},
+		{
+			name:        "unknown method",
+			method:      "EphemeralResources",
+			api:         "secret",
+			constructor: "NewVersionEphemeralResource",
+			wantErr:     "method EphemeralResources not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fileName := copyProviderFixture(t)
+
+			err := registerInProvider(fileName, tt.method, tt.api, tt.constructor)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("registerInProvider() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("registerInProvider() error = %v", err)
+			}
+
+			content, err := os.ReadFile(fileName)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := string(content)
+
+			if tt.wantNoChange {
+				if src != string(fixture) {
+					t.Errorf("registerInProvider() changed the provider file:\n%s", src)
+				}
+
+				return
+			}
+
+			if got := importLines(src); !reflect.DeepEqual(got, tt.wantImports) {
+				t.Errorf("imports = %q, want %q", got, tt.wantImports)
+			}
+
+			if got := constructorLines(src, tt.method); !reflect.DeepEqual(got, tt.wantEntries) {
+				t.Errorf("%s constructors = %q, want %q", tt.method, got, tt.wantEntries)
+			}
+		})
+	}
+}
+
+func TestRegisterInProvider_MissingFile(t *testing.T) {
+	err := registerInProvider(filepath.Join(t.TempDir(), "framework.go"), "Resources", "vpc", "NewRouteResource")
+	if !os.IsNotExist(err) {
+		t.Errorf("registerInProvider() error = %v, want a missing file error", err)
+	}
+}
+
+func TestEnsureImport(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "framework.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		api        string
+		wantPkg    string
+		wantImport string
+	}{
+		{
+			api:     "instance",
+			wantPkg: "instance",
+		},
+		{
+			api:     "function",
+			wantPkg: "functionservice",
+		},
+		{
+			api:        "rdb",
+			wantPkg:    "rdb",
+			wantImport: "\t\"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/rdb\"",
+		},
+		{
+			api:        "list",
+			wantPkg:    "listservice",
+			wantImport: "\tlistservice \"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/list\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.api, func(t *testing.T) {
+			t.Parallel()
+
+			src, pkg := ensureImport(string(fixture), tt.api)
+			if pkg != tt.wantPkg {
+				t.Errorf("ensureImport() package = %q, want %q", pkg, tt.wantPkg)
+			}
+
+			if tt.wantImport == "" {
+				if src != string(fixture) {
+					t.Errorf("ensureImport() changed the source:\n%s", src)
+				}
+
+				return
+			}
+
+			before, after := importLines(string(fixture)), importLines(src)
+			if len(after) != len(before)+1 {
+				t.Fatalf("ensureImport() imports = %q, want one more import than %q", after, before)
+			}
+
+			for i, line := range after {
+				if line == tt.wantImport {
+					if !reflect.DeepEqual(append(after[:i:i], after[i+1:]...), before) {
+						t.Errorf("ensureImport() imports = %q, want %q inserted in %q", after, tt.wantImport, before)
+					}
+
+					return
+				}
+			}
+
+			t.Errorf("ensureImport() imports = %q, want %q", after, tt.wantImport)
+		})
+	}
+}
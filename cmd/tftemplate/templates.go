@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bytes"
+	"go/format"
 	"log"
 	"os"
 	"strings"
@@ -26,7 +28,7 @@ func executeTemplate(tmpl *TerraformTemplate, data models.ResourceTemplate) erro
 	var outputFile *os.File
 	var err error
 	lastInd := strings.LastIndex(tmpl.FileName, "/")
-	_ = os.Mkdir(tmpl.FileName[:lastInd], os.ModePerm)
+	_ = os.MkdirAll(tmpl.FileName[:lastInd], os.ModePerm)
 	if tmpl.Append {
 		outputFile, err = os.OpenFile(tmpl.FileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
 	} else {
@@ -37,10 +39,26 @@ func executeTemplate(tmpl *TerraformTemplate, data models.ResourceTemplate) erro
 	}
 	defer outputFile.Close()
 
-	err = tmpl.Template.Execute(outputFile, data)
+	output := bytes.Buffer{}
+
+	err = tmpl.Template.Execute(&output, data)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	content := output.Bytes()
+
+	// Appended templates are not complete files and cannot be formatted
+	if strings.HasSuffix(tmpl.FileName, ".go") && !tmpl.Append {
+		formatted, err := format.Source(content)
+		if err != nil {
+			log.Printf("failed to format %s: %s\n", tmpl.FileName, err)
+		} else {
+			content = formatted
+		}
+	}
+
+	_, err = outputFile.Write(content)
+
+	return err
 }
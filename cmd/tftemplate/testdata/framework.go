@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	functionservice "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/function"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+type ScalewayProvider struct {
+	providerMeta *meta.Meta
+}
+
+func (p *ScalewayProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		account.NewProjectResource,
+		vpc.NewVPCResource,
+	}
+}
+
+func (p *ScalewayProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		verify.NewUUIDFunction,
+	}
+}
+
+func (p *ScalewayProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		instance.NewServerAction,
+	}
+}
+
+func (p *ScalewayProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		account.NewProjectListResource,
+		functionservice.NewFunctionListResource,
+		instance.NewServerListResource,
+		vpc.NewVPCListResource,
+	}
+}
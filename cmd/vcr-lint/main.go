@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+const usage = `Usage:
+  %[1]s [flags] [cassette directory or file]...
+
+Checks the cassettes, internal/services by default, for:
+  sensitive-field     JSON fields which should have been anonymized
+  sensitive-header    headers which should have been anonymized
+  secret-value        values that look like private keys, kubeconfig credentials or passwords in URLs
+  deprecated-api      calls to deprecated APIs or endpoints
+  duplicate-polling   polling interactions the compressor would remove
+
+Exits with code 1 when issues are found.
+
+Flags:
+`
+
+func main() {
+	log.SetFlags(0)
+
+	os.Exit(run(os.Args[0], os.Args[1:], os.Stdout))
+}
+
+// run lints the cassettes and returns the exit code: 1 when issues are found or cassettes cannot be read,
+// 2 when the flags are invalid.
+func run(name string, args []string, stdout io.Writer) int {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+
+	format := flags.String("format", "text", "output format: text or json")
+	enabledRules := flags.String("rules", strings.Join(rules, ","), "comma separated rules to check")
+
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), usage, name)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *format != "text" && *format != "json" {
+		log.Printf("Invalid format %q\n", *format)
+		flags.Usage()
+
+		return 2
+	}
+
+	enabled := map[string]bool{}
+
+	for rule := range strings.SplitSeq(*enabledRules, ",") {
+		if rule = strings.TrimSpace(rule); rule == "" {
+			continue
+		}
+
+		if !slices.Contains(rules, rule) {
+			log.Printf("Unknown rule %q\n", rule)
+			flags.Usage()
+
+			return 2
+		}
+
+		enabled[rule] = true
+	}
+
+	roots := flags.Args()
+	if len(roots) == 0 {
+		roots = []string{"internal/services"}
+	}
+
+	findings := []finding{}
+
+	for _, root := range roots {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() || !strings.HasSuffix(path, ".cassette.yaml") {
+				return nil
+			}
+
+			cassetteFindings, err := lintCassette(path, enabled)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			findings = append(findings, cassetteFindings...)
+
+			return nil
+		})
+		if err != nil {
+			log.Printf("Error while reading cassettes: %v\n", err)
+
+			return 1
+		}
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(findings)
+	} else {
+		for _, f := range findings {
+			location := f.File
+			if f.Interaction != nil {
+				location = fmt.Sprintf("%s:%d", f.File, *f.Interaction)
+			}
+
+			fmt.Fprintf(stdout, "%s: %s: %s\n", location, f.Rule, f.Message)
+		}
+
+		fmt.Fprintf(stdout, "%d issues found\n", len(findings))
+	}
+
+	if len(findings) > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// lintCassette checks the interactions of a cassette with the enabled rules.
+func lintCassette(file string, enabled map[string]bool) ([]finding, error) {
+	path := strings.TrimSuffix(file, ".yaml")
+
+	interactions, err := acctest.LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := []finding(nil)
+
+	for id, i := range interactions {
+		findings = append(findings, lintInteraction(file, id, i, enabled)...)
+	}
+
+	if enabled[ruleDuplicatePolling] {
+		polling, err := lintPolling(file, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if polling != nil {
+			findings = append(findings, *polling)
+		}
+	}
+
+	return findings, nil
+}
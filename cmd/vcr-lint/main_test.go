@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	// Invalid flags are reported in the logs
+	log.SetOutput(io.Discard)
+
+	m.Run()
+}
+
+// writeCassette writes a cassette made of GET interactions returning the given response bodies.
+func writeCassette(t *testing.T, dir string, name string, responseBodies ...string) string {
+	t.Helper()
+
+	content := "---\nversion: 2\ninteractions:\n"
+
+	for id, body := range responseBodies {
+		quotedBody, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		content += "- id: " + strconv.Itoa(id) + `
+  request:
+    proto: HTTP/1.1
+    host: api.scaleway.com
+    url: https://api.scaleway.com/rdb/v1/regions/fr-par/instances/11111111-1111-1111-1111-111111111111
+    method: GET
+  response:
+    proto: HTTP/2.0
+    body: ` + string(quotedBody) + `
+    headers:
+      Content-Type:
+      - application/json
+    status: 200 OK
+    code: 200
+`
+	}
+
+	path := filepath.Join(dir, "testdata", name+".cassette.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	cleanDir := filepath.Join(dir, "clean")
+	leakDir := filepath.Join(dir, "leak")
+
+	writeCassette(t, cleanDir, "clean", `{"id":"11111111-1111-1111-1111-111111111111","status":"ready"}`)
+	leakPath := writeCassette(t, leakDir, "leak",
+		`{"id":"11111111-1111-1111-1111-111111111111","status":"initializing"}`,
+		`{"id":"11111111-1111-1111-1111-111111111111","status":"initializing"}`,
+		`{"id":"11111111-1111-1111-1111-111111111111","status":"ready","password":"hunter2"}`,
+	)
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantOutput string
+	}{
+		{
+			name:       "no issues",
+			args:       []string{cleanDir},
+			wantCode:   0,
+			wantOutput: "0 issues found\n",
+		},
+		{
+			name:     "issues",
+			args:     []string{cleanDir, leakDir},
+			wantCode: 1,
+			wantOutput: leakPath + ":2: sensitive-field: response.password is not anonymized\n" +
+				leakPath + ": duplicate-polling: 1 polling interactions in a transient state can be removed with go run ./cmd/vcr-compressor " + strings.TrimSuffix(leakPath, ".yaml") + "\n" +
+				"2 issues found\n",
+		},
+		{
+			name:       "enabled rules",
+			args:       []string{"-rules", "duplicate-polling", leakPath},
+			wantCode:   1,
+			wantOutput: leakPath + ": duplicate-polling: 1 polling interactions in a transient state can be removed with go run ./cmd/vcr-compressor " + strings.TrimSuffix(leakPath, ".yaml") + "\n1 issues found\n",
+		},
+		{
+			name:       "disabled rules",
+			args:       []string{"-rules", "secret-value,deprecated-api", leakDir},
+			wantCode:   0,
+			wantOutput: "0 issues found\n",
+		},
+		{
+			name:     "invalid format",
+			args:     []string{"-format", "xml", cleanDir},
+			wantCode: 2,
+		},
+		{
+			name:     "unknown rule",
+			args:     []string{"-rules", "unknown", cleanDir},
+			wantCode: 2,
+		},
+		{
+			name:     "unknown flag",
+			args:     []string{"-unknown", cleanDir},
+			wantCode: 2,
+		},
+		{
+			name:     "missing directory",
+			args:     []string{filepath.Join(dir, "missing")},
+			wantCode: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stdout := &bytes.Buffer{}
+			code := run("vcr-lint", tt.args, stdout)
+
+			assert.Equal(t, tt.wantCode, code)
+			assert.Equal(t, tt.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestRun_JSON(t *testing.T) {
+	dir := t.TempDir()
+	path := writeCassette(t, dir, "leak",
+		`{"id":"11111111-1111-1111-1111-111111111111","status":"ready"}`,
+		`{"id":"11111111-1111-1111-1111-111111111111","status":"ready","secret_key":"9b8c7d6e-1234-5678-9abc-def012345678"}`,
+	)
+
+	stdout := &bytes.Buffer{}
+	code := run("vcr-lint", []string{"-format", "json", dir}, stdout)
+	assert.Equal(t, 1, code)
+
+	var findings []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &findings), stdout.String())
+	assert.Equal(t, []map[string]any{
+		{
+			"file":        path,
+			"interaction": float64(1),
+			"rule":        ruleSensitiveField,
+			"message":     "response.secret_key is not anonymized",
+		},
+	}, findings)
+
+	// No findings is an empty list, not null
+	stdout.Reset()
+	code = run("vcr-lint", []string{"-format", "json", "-rules", ruleDeprecatedAPI, dir}, stdout)
+	assert.Equal(t, 0, code)
+	assert.JSONEq(t, `[]`, stdout.String())
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+)
+
+const (
+	ruleSensitiveField   = "sensitive-field"
+	ruleSensitiveHeader  = "sensitive-header"
+	ruleSecretValue      = "secret-value"
+	ruleDeprecatedAPI    = "deprecated-api"
+	ruleDuplicatePolling = "duplicate-polling"
+)
+
+var rules = []string{ruleSensitiveField, ruleSensitiveHeader, ruleSecretValue, ruleDeprecatedAPI, ruleDuplicatePolling}
+
+// finding is an issue found in a cassette. Interaction is the id of the interaction in the cassette, if the issue is
+// not about the whole cassette.
+type finding struct {
+	File        string `json:"file"`
+	Interaction *int   `json:"interaction,omitempty"`
+	Rule        string `json:"rule"`
+	Message     string `json:"message"`
+}
+
+// placeholderRegex matches the values which were anonymized, made of x and 0 characters.
+var placeholderRegex = regexp.MustCompile(`^(?i)(bearer )?[x0-]*$`)
+
+func isPlaceholder(value string) bool {
+	return placeholderRegex.MatchString(value)
+}
+
+// secretPattern detects a secret in a body by its format.
+type secretPattern struct {
+	name    string
+	pattern *regexp.Regexp
+	// secret is the index of the submatch holding the secret, which is ignored if it was anonymized.
+	secret int
+}
+
+// matches returns true if the text contains a secret which was not anonymized.
+func (p secretPattern) matches(text string) bool {
+	match := p.pattern.FindStringSubmatch(text)
+
+	return match != nil && (p.secret == 0 || !isPlaceholder(match[p.secret]))
+}
+
+var secretPatterns = []secretPattern{
+	{name: "private key", pattern: regexp.MustCompile(`-----BEGIN ([A-Z]+ )*PRIVATE KEY-----`)},
+	{name: "kubeconfig credentials", pattern: regexp.MustCompile(`(?s)kind:\s*Config\b.*(client-key-data|token):\s*"?([^\s"]+)`), secret: 2},
+	{name: "password in URL", pattern: regexp.MustCompile(`\b[a-z][a-z0-9+.-]*://[^\s/:@"']+:([^\s/@"']+)@`), secret: 1},
+}
+
+// base64Regex matches the strings which may be encoded content, like the kubeconfig of a cluster.
+var base64Regex = regexp.MustCompile(`^[A-Za-z0-9+/]{64,}={0,2}$`)
+
+// deprecatedAPI is an API, or some of its endpoints, which should not be called anymore.
+type deprecatedAPI struct {
+	path *regexp.Regexp
+	// body restricts the check to the interactions with a matching request or response body.
+	body        *regexp.Regexp
+	replacement string
+}
+
+var deprecatedAPIs = []deprecatedAPI{
+	{path: regexp.MustCompile(`^/account/v2/`), replacement: "account/v3"},
+	{path: regexp.MustCompile(`^/billing/v2beta1/`), replacement: "billing/v2"},
+	{path: regexp.MustCompile(`^/block/v1alpha1/`), replacement: "block/v1"},
+	{path: regexp.MustCompile(`^/containers/v1beta1/`), replacement: "containers/v1"},
+	{path: regexp.MustCompile(`^/mongodb/v1alpha1/`), replacement: "mongodb/v1"},
+	{path: regexp.MustCompile(`^/k8s/v1beta\d+/`), replacement: "k8s/v1"},
+	{path: regexp.MustCompile(`^/k8s/v1/regions/[^/]+/nodes/[^/]+/replace$`), replacement: "the reboot of the node or the replacement of its pool"},
+	{path: regexp.MustCompile(`^/instance/v1/zones/[^/]+/bootscripts`), replacement: "the boot type of the server"},
+	{
+		path:        regexp.MustCompile(`^/instance/v1/zones/[^/]+/(volumes|snapshots)`),
+		body:        regexp.MustCompile(`"volume_type":\s*"b_ssd"`),
+		replacement: "block/v1 for block volumes and snapshots",
+	},
+	{path: regexp.MustCompile(`^/lb/v1/(zones|regions)/[^/]+/lbs/[^/]+/stats$`), replacement: "the Cockpit metrics of the load balancer"},
+	{path: regexp.MustCompile(`^/iot/v1/regions/[^/]+/(hubs|devices)/[^/]+/metrics$`), replacement: "the Cockpit metrics of the IoT hub"},
+}
+
+// lintInteraction checks an interaction for secrets and calls to deprecated APIs.
+func lintInteraction(file string, id int, i acctest.CassetteInteraction, enabled map[string]bool) []finding {
+	findings := []finding(nil)
+	report := func(rule, format string, args ...any) {
+		if enabled[rule] {
+			findings = append(findings, finding{File: file, Interaction: &id, Rule: rule, Message: fmt.Sprintf(format, args...)})
+		}
+	}
+
+	// Passwords sent in requests come from the test configurations
+	for _, body := range []struct {
+		name    string
+		content string
+		headers http.Header
+		fields  map[string]any
+	}{
+		{"request", i.RequestBody, i.RequestHeaders, acctest.LeakCheckFields},
+		{"response", i.ResponseBody, i.ResponseHeaders, logging.SensitiveFields},
+	} {
+		for _, name := range slices.Sorted(maps.Keys(body.headers)) {
+			placeholder, isSensitive := logging.SensitiveHeaders[strings.ToLower(name)]
+			if value := body.headers.Get(name); isSensitive && value != placeholder && !isPlaceholder(value) {
+				report(ruleSensitiveHeader, "%s header %s is not anonymized", body.name, name)
+			}
+		}
+
+		var decoded any
+		if json.Unmarshal([]byte(body.content), &decoded) == nil {
+			for _, path := range sensitiveFields(body.name, decoded, body.fields) {
+				report(ruleSensitiveField, "%s is not anonymized", path)
+			}
+		}
+
+		texts := append([]string{body.content}, decodedStrings(decoded)...)
+
+		for _, p := range secretPatterns {
+			if slices.ContainsFunc(texts, p.matches) {
+				report(ruleSecretValue, "%s body contains a %s", body.name, p.name)
+			}
+		}
+	}
+
+	if u, err := url.Parse(i.URL); err == nil {
+		for _, api := range deprecatedAPIs {
+			if !api.path.MatchString(u.Path) {
+				continue
+			}
+
+			if api.body == nil || api.body.MatchString(i.RequestBody) || api.body.MatchString(i.ResponseBody) {
+				report(ruleDeprecatedAPI, "%s %s is deprecated in favor of %s", i.Method, u.Path, api.replacement)
+			}
+		}
+	}
+
+	return findings
+}
+
+// sensitiveFields returns the paths of the fields which should be anonymized but hold a real value.
+func sensitiveFields(path string, v any, fields map[string]any) []string {
+	paths := []string(nil)
+
+	switch x := v.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(x)) {
+			placeholder, isSensitive := fields[strings.ToLower(key)]
+			if value, isString := x[key].(string); isSensitive && isString {
+				if value != "" && value != placeholder && !isPlaceholder(value) {
+					paths = append(paths, path+"."+key)
+				}
+
+				continue
+			}
+
+			paths = append(paths, sensitiveFields(path+"."+key, x[key], fields)...)
+		}
+	case []any:
+		for index, item := range x {
+			paths = append(paths, sensitiveFields(fmt.Sprintf("%s[%d]", path, index), item, fields)...)
+		}
+	}
+
+	return paths
+}
+
+// decodedStrings returns the content of the base64 encoded strings of a JSON body.
+func decodedStrings(v any) []string {
+	decoded := []string(nil)
+
+	switch x := v.(type) {
+	case string:
+		if base64Regex.MatchString(x) {
+			if content, err := base64.StdEncoding.DecodeString(x); err == nil {
+				decoded = append(decoded, string(content))
+			}
+		}
+	case map[string]any:
+		for _, value := range x {
+			decoded = append(decoded, decodedStrings(value)...)
+		}
+	case []any:
+		for _, item := range x {
+			decoded = append(decoded, decodedStrings(item)...)
+		}
+	}
+
+	return decoded
+}
+
+// lintPolling reports the polling interactions which the compressor would remove from a cassette.
+func lintPolling(file string, path string) (*finding, error) {
+	report, err := acctest.CompressCassette(path, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if report.SkippedInteraction == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &finding{
+		File:    file,
+		Rule:    ruleDuplicatePolling,
+		Message: fmt.Sprintf("%d polling interactions in a transient state can be removed with go run ./cmd/vcr-compressor %s", report.SkippedInteraction, path),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+const usage = `Usage:
+  %[1]s [flags] <cassette directory or file>...
+
+Serves the recorded cassettes as a mock of the Scaleway API.
+Resources created through the mock are kept in memory and follow the status transitions of the cassettes.
+Point the provider at it with api_url, for example http://localhost:8080.
+
+Flags:
+`
+
+func main() {
+	log.SetFlags(0)
+
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage, os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	recordings := newRecordings()
+	cassettes := 0
+
+	for _, root := range flag.Args() {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() || !strings.HasSuffix(path, ".cassette.yaml") {
+				return nil
+			}
+
+			interactions, err := acctest.LoadCassette(strings.TrimSuffix(path, ".yaml"))
+			if err != nil {
+				return fmt.Errorf("loading %s: %w", path, err)
+			}
+
+			recordings.add(interactions)
+			cassettes++
+
+			return nil
+		})
+		if err != nil {
+			log.Fatalf("Error while reading cassettes: %v\n", err)
+		}
+	}
+
+	log.Printf("Loaded %d cassettes, %d routes, listening on %s\n", cassettes, len(recordings.byRoute), *addr)
+
+	if err := http.ListenAndServe(*addr, newServer(recordings)); err != nil { //nolint:gosec // G114: local test server
+		log.Fatalf("%v\n", err)
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+// localityRegex matches the zone or region of an API path.
+var localityRegex = regexp.MustCompile(`/(zones|regions)/[^/]+`)
+
+// route identifies an API endpoint across recordings: the method and the path with localities and IDs replaced.
+func route(method, path string) string {
+	path = localityRegex.ReplaceAllString(path, "/$1/{locality}")
+
+	return method + " " + acctest.UUIDRegex.ReplaceAllString(strings.TrimSuffix(path, "/"), "{id}")
+}
+
+// resourcePath returns the path of the resource targeted by a request, which is the path up to its last ID.
+func resourcePath(path string) string {
+	ids := acctest.UUIDRegex.FindAllStringIndex(path, -1)
+	if len(ids) == 0 {
+		return ""
+	}
+
+	return path[:ids[len(ids)-1][1]]
+}
+
+// recording is a recorded response and the path it was recorded on.
+type recording struct {
+	path    string
+	code    int
+	body    string
+	headers http.Header
+}
+
+// recordings indexes the interactions of the cassettes.
+type recordings struct {
+	// byPath holds the responses recorded on a method and path, for the resources which were not created by the mock.
+	byPath map[string]recording
+	// byRoute holds the responses recorded on a route, used as templates.
+	byRoute map[string]recording
+	// effects holds the status of a resource after a request on a route, as seen by the next GET of the resource.
+	effects map[string]string
+	// transitions holds the status which followed a transient status on a GET route.
+	transitions map[string]string
+}
+
+func newRecordings() *recordings {
+	return &recordings{
+		byPath:      map[string]recording{},
+		byRoute:     map[string]recording{},
+		effects:     map[string]string{},
+		transitions: map[string]string{},
+	}
+}
+
+// effectKey identifies a request which changes the status of a resource, including the action it sends if any.
+func effectKey(method, path, body string) string {
+	key := route(method, path)
+
+	var request map[string]any
+	if json.Unmarshal([]byte(body), &request) == nil {
+		if action, isString := request["action"].(string); isString {
+			key += " " + action
+		}
+	}
+
+	return key
+}
+
+// add indexes the interactions of a cassette, in the order they were recorded.
+func (r *recordings) add(interactions []acctest.CassetteInteraction) {
+	// Requests waiting for the next GET of their resource to learn its status
+	pendingEffects := map[string]string{}
+	lastStatuses := map[string]string{}
+	// Paths which are read, to tell the creation of a resource apart from an action returning another resource
+	readPaths := map[string]bool{}
+
+	for _, i := range interactions {
+		if u, err := url.Parse(i.URL); err == nil && i.Method == http.MethodGet {
+			readPaths[strings.TrimSuffix(u.Path, "/")] = true
+		}
+	}
+
+	for _, i := range interactions {
+		u, err := url.Parse(i.URL)
+		if err != nil {
+			continue
+		}
+
+		path := strings.TrimSuffix(u.Path, "/")
+		rec := recording{path: path, code: i.Code, body: i.ResponseBody, headers: i.ResponseHeaders}
+
+		for key, index := range map[string]map[string]recording{
+			i.Method + " " + path: r.byPath,
+			route(i.Method, path): r.byRoute,
+		} {
+			// Keep the first successful response
+			if previous, exists := index[key]; !exists || (!isSuccess(previous.code) && isSuccess(i.Code)) {
+				index[key] = rec
+			}
+		}
+
+		body, _ := parseResource(i.ResponseBody)
+
+		if i.Method != http.MethodGet {
+			target := resourcePath(path)
+			if id, isString := body["id"].(string); isString && i.Method == http.MethodPost && readPaths[path+"/"+id] {
+				target = path + "/" + id
+			}
+
+			if target != "" {
+				pendingEffects[target] = effectKey(i.Method, path, i.RequestBody)
+			}
+
+			continue
+		}
+
+		status, hasStatus := resourceStatus(body)
+		if !hasStatus {
+			continue
+		}
+
+		if effect, isPending := pendingEffects[path]; isPending {
+			if _, exists := r.effects[effect]; !exists {
+				r.effects[effect] = status
+			}
+
+			delete(pendingEffects, path)
+		}
+
+		if last := lastStatuses[path]; acctest.IsTransientState(last) && last != status {
+			transition := route(i.Method, path) + " " + last
+			if _, exists := r.transitions[transition]; !exists {
+				r.transitions[transition] = status
+			}
+		}
+
+		lastStatuses[path] = status
+	}
+}
+
+// lookup returns the response recorded on the same path, or on the same route.
+func (r *recordings) lookup(method, path string) (recording, bool) {
+	if rec, exists := r.byPath[method+" "+path]; exists {
+		return rec, true
+	}
+
+	rec, exists := r.byRoute[route(method, path)]
+
+	return rec, exists
+}
+
+func isSuccess(code int) bool {
+	return code >= 200 && code < 300
+}
+
+// parseResource parses a response body and returns the resource it contains.
+// Some APIs wrap the resource in an object, like {"server": {...}}: the key of the wrapper is returned too.
+func parseResource(body string) (resource map[string]any, wrapper string) {
+	var m map[string]any
+	if json.Unmarshal([]byte(body), &m) != nil {
+		return nil, ""
+	}
+
+	if _, hasID := m["id"]; hasID || len(m) != 1 {
+		return m, ""
+	}
+
+	for key, value := range m {
+		if wrapped, isObject := value.(map[string]any); isObject {
+			if _, hasID := wrapped["id"]; hasID {
+				return wrapped, key
+			}
+		}
+	}
+
+	return m, ""
+}
+
+// statusField returns the name of the field holding the status of a resource.
+func statusField(resource map[string]any) string {
+	if _, isString := resource["status"].(string); isString {
+		return "status"
+	}
+
+	if _, isString := resource["state"].(string); isString {
+		return "state"
+	}
+
+	return ""
+}
+
+func resourceStatus(resource map[string]any) (string, bool) {
+	field := statusField(resource)
+	if field == "" {
+		return "", false
+	}
+
+	return resource[field].(string), true
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	serversURL = "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers"
+	serverID   = "11111111-1111-1111-1111-111111111111"
+	taskID     = "22222222-2222-2222-2222-222222222222"
+)
+
+// serverInteractions creates a server, powers it on and lists the servers, like a cassette of the instance service.
+func serverInteractions() []acctest.CassetteInteraction {
+	jsonHeaders := http.Header{"Content-Type": []string{"application/json"}}
+	server := func(state string) string {
+		return `{"server":{"id":"` + serverID + `","name":"recorded","state":"` + state + `","tags":[]}}`
+	}
+
+	return []acctest.CassetteInteraction{
+		{
+			Method:          http.MethodPost,
+			URL:             serversURL,
+			RequestBody:     `{"name":"recorded"}`,
+			Code:            http.StatusCreated,
+			ResponseBody:    server("stopped"),
+			ResponseHeaders: jsonHeaders,
+		},
+		{
+			Method:          http.MethodGet,
+			URL:             serversURL + "/" + serverID,
+			Code:            http.StatusOK,
+			ResponseBody:    server("stopped"),
+			ResponseHeaders: jsonHeaders,
+		},
+		{
+			Method:          http.MethodPost,
+			URL:             serversURL + "/" + serverID + "/action",
+			RequestBody:     `{"action":"poweron"}`,
+			Code:            http.StatusAccepted,
+			ResponseBody:    `{"task":{"id":"` + taskID + `","status":"pending","href_from":"/servers/` + serverID + `/action"}}`,
+			ResponseHeaders: jsonHeaders,
+		},
+		{
+			Method:          http.MethodGet,
+			URL:             serversURL + "/" + serverID,
+			Code:            http.StatusOK,
+			ResponseBody:    server("starting"),
+			ResponseHeaders: jsonHeaders,
+		},
+		{
+			Method:          http.MethodGet,
+			URL:             serversURL + "/" + serverID,
+			Code:            http.StatusOK,
+			ResponseBody:    server("running"),
+			ResponseHeaders: jsonHeaders,
+		},
+		{
+			Method:          http.MethodGet,
+			URL:             serversURL + "?page=1",
+			Code:            http.StatusOK,
+			ResponseBody:    `{"servers":[{"id":"` + serverID + `","name":"recorded","state":"running"}],"total_count":1}`,
+			ResponseHeaders: jsonHeaders,
+		},
+	}
+}
+
+func TestRoute(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{
+			method: http.MethodGet,
+			path:   "/instance/v1/zones/fr-par-1/servers",
+			want:   "GET /instance/v1/zones/{locality}/servers",
+		},
+		{
+			method: http.MethodPatch,
+			path:   "/rdb/v1/regions/nl-ams/instances/" + serverID + "/",
+			want:   "PATCH /rdb/v1/regions/{locality}/instances/{id}",
+		},
+		{
+			method: http.MethodPost,
+			path:   "/instance/v1/zones/pl-waw-2/servers/" + serverID + "/private_nics/" + taskID,
+			want:   "POST /instance/v1/zones/{locality}/servers/{id}/private_nics/{id}",
+		},
+		{
+			method: http.MethodGet,
+			path:   "/account/v3/projects",
+			want:   "GET /account/v3/projects",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, route(tt.method, tt.path))
+		})
+	}
+}
+
+func TestResourcePath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "collection",
+			path: "/instance/v1/zones/fr-par-1/servers",
+			want: "",
+		},
+		{
+			name: "resource",
+			path: "/instance/v1/zones/fr-par-1/servers/" + serverID,
+			want: "/instance/v1/zones/fr-par-1/servers/" + serverID,
+		},
+		{
+			name: "action",
+			path: "/instance/v1/zones/fr-par-1/servers/" + serverID + "/action",
+			want: "/instance/v1/zones/fr-par-1/servers/" + serverID,
+		},
+		{
+			name: "sub-resource",
+			path: "/instance/v1/zones/fr-par-1/servers/" + serverID + "/private_nics/" + taskID,
+			want: "/instance/v1/zones/fr-par-1/servers/" + serverID + "/private_nics/" + taskID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, resourcePath(tt.path))
+		})
+	}
+}
+
+func TestParseResource(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		wantResource map[string]any
+		wantWrapper  string
+	}{
+		{
+			name:         "plain",
+			body:         `{"id":"a","status":"ready"}`,
+			wantResource: map[string]any{"id": "a", "status": "ready"},
+		},
+		{
+			name:         "wrapped",
+			body:         `{"server":{"id":"a","state":"running"}}`,
+			wantResource: map[string]any{"id": "a", "state": "running"},
+			wantWrapper:  "server",
+		},
+		{
+			name:         "list",
+			body:         `{"servers":[],"total_count":0}`,
+			wantResource: map[string]any{"servers": []any{}, "total_count": float64(0)},
+		},
+		{
+			name:         "single field without id",
+			body:         `{"config":{"name":"a"}}`,
+			wantResource: map[string]any{"config": map[string]any{"name": "a"}},
+		},
+		{
+			name: "not json",
+			body: `<xml/>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resource, wrapper := parseResource(tt.body)
+			assert.Equal(t, tt.wantResource, resource)
+			assert.Equal(t, tt.wantWrapper, wrapper)
+		})
+	}
+}
+
+func TestRecordings_Add(t *testing.T) {
+	r := newRecordings()
+	r.add(serverInteractions())
+
+	assert.Equal(t, map[string]string{
+		"POST /instance/v1/zones/{locality}/servers":                     "stopped",
+		"POST /instance/v1/zones/{locality}/servers/{id}/action poweron": "starting",
+	}, r.effects)
+	assert.Equal(t, map[string]string{
+		"GET /instance/v1/zones/{locality}/servers/{id} starting": "running",
+	}, r.transitions)
+
+	rec, exists := r.lookup(http.MethodGet, "/instance/v1/zones/fr-par-1/servers/"+serverID)
+	require.True(t, exists)
+	assert.Contains(t, rec.body, `"state":"stopped"`, "the first response recorded on a path is kept")
+
+	rec, exists = r.lookup(http.MethodGet, "/instance/v1/zones/nl-ams-1/servers/"+taskID)
+	require.True(t, exists, "responses are looked up by route when the path was not recorded")
+	assert.Equal(t, "/instance/v1/zones/fr-par-1/servers/"+serverID, rec.path)
+
+	_, exists = r.lookup(http.MethodDelete, "/instance/v1/zones/fr-par-1/servers/"+serverID)
+	assert.False(t, exists)
+}
+
+func TestRecordings_AddKeepsFirstSuccess(t *testing.T) {
+	r := newRecordings()
+	r.add([]acctest.CassetteInteraction{
+		{Method: http.MethodGet, URL: serversURL + "/" + serverID, Code: http.StatusNotFound, ResponseBody: `{"type":"not_found"}`},
+		{Method: http.MethodGet, URL: serversURL + "/" + serverID, Code: http.StatusOK, ResponseBody: `{"id":"` + serverID + `"}`},
+		{Method: http.MethodGet, URL: serversURL + "/" + serverID, Code: http.StatusInternalServerError, ResponseBody: `{}`},
+	})
+
+	rec, exists := r.lookup(http.MethodGet, "/instance/v1/zones/fr-par-1/servers/"+serverID)
+	require.True(t, exists)
+	assert.Equal(t, http.StatusOK, rec.code)
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+// resource is a resource created through the mock server.
+type resource struct {
+	// route is the GET route of the resource, used to look up its status transitions.
+	route   string
+	wrapper string
+	body    map[string]any
+}
+
+// server serves the recorded responses, keeping the state of the resources which are created, updated and deleted.
+type server struct {
+	recordings *recordings
+
+	mu        sync.Mutex
+	resources map[string]*resource
+	// collections are the paths which resources were created on.
+	collections map[string]bool
+	deleted     map[string]bool
+}
+
+func newServer(recordings *recordings) *server {
+	return &server{
+		recordings:  recordings,
+		resources:   map[string]*resource{},
+		collections: map[string]bool{},
+		deleted:     map[string]bool{},
+	}
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+
+		return
+	}
+
+	urlPath := strings.TrimSuffix(r.URL.Path, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code := s.handle(w, r.Method, urlPath, string(requestBody))
+	log.Printf("%s %s %d\n", r.Method, r.URL.Path, code)
+}
+
+func (s *server) handle(w http.ResponseWriter, method, urlPath, requestBody string) int {
+	if res, exists := s.resources[urlPath]; exists {
+		switch method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, res.response())
+			s.transition(res)
+
+			return http.StatusOK
+		case http.MethodPatch, http.MethodPut:
+			res.merge(requestBody)
+			s.applyEffect(res, method, urlPath, requestBody)
+			writeJSON(w, http.StatusOK, res.response())
+
+			return http.StatusOK
+		case http.MethodDelete:
+			delete(s.resources, urlPath)
+			s.deleted[urlPath] = true
+
+			return s.replay(w, method, urlPath, http.StatusNoContent)
+		}
+	}
+
+	if s.deleted[resourcePath(urlPath)] {
+		writeError(w, http.StatusNotFound, "not_found", "resource is not found")
+
+		return http.StatusNotFound
+	}
+
+	if method == http.MethodPost {
+		if code, created := s.create(w, urlPath, requestBody); created {
+			return code
+		}
+	}
+
+	if res, exists := s.resources[resourcePath(urlPath)]; exists {
+		// Actions and sub-resources of a resource created by the mock
+		s.applyEffect(res, method, urlPath, requestBody)
+
+		return s.replay(w, method, urlPath, http.StatusOK)
+	}
+
+	if method == http.MethodGet && s.collections[urlPath] {
+		return s.list(w, urlPath)
+	}
+
+	return s.replay(w, method, urlPath, http.StatusOK)
+}
+
+// create creates a resource if a request on the same route created one in the cassettes.
+func (s *server) create(w http.ResponseWriter, urlPath, requestBody string) (int, bool) {
+	rec, exists := s.recordings.lookup(http.MethodPost, urlPath)
+	if !exists || !isSuccess(rec.code) {
+		return 0, false
+	}
+
+	template, _ := parseResource(rec.body)
+
+	// Actions can return another resource, like a task, which is not served under the path of the request
+	recordedID, isString := template["id"].(string)
+	if _, isReadable := s.recordings.byRoute[route(http.MethodGet, rec.path+"/"+recordedID)]; !isString || !isReadable {
+		return 0, false
+	}
+
+	id := uuid.NewString()
+	body, wrapper := parseResource(strings.ReplaceAll(s.rewriteIDs(rec, urlPath), recordedID, id))
+
+	res := &resource{
+		route:   route(http.MethodGet, urlPath+"/"+id),
+		wrapper: wrapper,
+		body:    body,
+	}
+	res.merge(requestBody)
+	s.applyEffect(res, http.MethodPost, urlPath, requestBody)
+
+	s.resources[urlPath+"/"+id] = res
+	s.collections[urlPath] = true
+
+	writeJSON(w, rec.code, res.response())
+
+	return rec.code, true
+}
+
+// list lists the resources created on a collection, in the format of the recorded list response.
+func (s *server) list(w http.ResponseWriter, urlPath string) int {
+	rec, exists := s.recordings.lookup(http.MethodGet, urlPath)
+	if !exists {
+		return s.replay(w, http.MethodGet, urlPath, http.StatusOK)
+	}
+
+	var response map[string]any
+	if json.Unmarshal([]byte(rec.body), &response) != nil {
+		return s.replay(w, http.MethodGet, urlPath, http.StatusOK)
+	}
+
+	items := []any{}
+
+	for _, resourcePath := range slices.Sorted(maps.Keys(s.resources)) {
+		if path.Dir(resourcePath) == urlPath {
+			items = append(items, s.resources[resourcePath].body)
+		}
+	}
+
+	for key, value := range response {
+		if _, isList := value.([]any); isList {
+			response[key] = items
+		}
+	}
+
+	response["total_count"] = len(items)
+	writeJSON(w, http.StatusOK, response)
+
+	return http.StatusOK
+}
+
+// replay writes the recorded response of a request, with the IDs of the recorded path replaced by those of the request.
+func (s *server) replay(w http.ResponseWriter, method, urlPath string, defaultCode int) int {
+	rec, exists := s.recordings.lookup(method, urlPath)
+	if !exists {
+		if method == http.MethodDelete {
+			w.WriteHeader(defaultCode)
+
+			return defaultCode
+		}
+
+		writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("no recorded interaction for %s %s", method, urlPath))
+
+		return http.StatusNotFound
+	}
+
+	if contentType := rec.headers.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	w.WriteHeader(rec.code)
+	_, _ = io.WriteString(w, s.rewriteIDs(rec, urlPath))
+
+	return rec.code
+}
+
+// rewriteIDs replaces the IDs of the recorded path in the recorded body with the IDs of the requested path.
+func (s *server) rewriteIDs(rec recording, urlPath string) string {
+	body := rec.body
+	recordedIDs := acctest.UUIDRegex.FindAllString(rec.path, -1)
+	requestedIDs := acctest.UUIDRegex.FindAllString(urlPath, -1)
+
+	for i := range min(len(recordedIDs), len(requestedIDs)) {
+		body = strings.ReplaceAll(body, recordedIDs[i], requestedIDs[i])
+	}
+
+	return body
+}
+
+// applyEffect sets the status the resource had after the same request in the cassettes.
+func (s *server) applyEffect(res *resource, method, urlPath, requestBody string) {
+	status, exists := s.recordings.effects[effectKey(method, urlPath, requestBody)]
+	if field := statusField(res.body); exists && field != "" {
+		res.body[field] = status
+	}
+}
+
+// transition moves a resource in a transient status to the status which followed it in the cassettes.
+func (s *server) transition(res *resource) {
+	status, hasStatus := resourceStatus(res.body)
+	if !hasStatus || !acctest.IsTransientState(status) {
+		return
+	}
+
+	if next, exists := s.recordings.transitions[res.route+" "+status]; exists {
+		res.body[statusField(res.body)] = next
+	}
+}
+
+// merge sets the fields of the resource which are sent in a request body with the same type.
+// Objects are not merged as their request and response formats often differ.
+func (res *resource) merge(requestBody string) {
+	var request map[string]any
+	if json.Unmarshal([]byte(requestBody), &request) != nil {
+		return
+	}
+
+	for key, value := range request {
+		current, exists := res.body[key]
+		if _, isObject := value.(map[string]any); !exists || isObject || value == nil {
+			continue
+		}
+
+		if current == nil || fmt.Sprintf("%T", current) == fmt.Sprintf("%T", value) {
+			res.body[key] = value
+		}
+	}
+}
+
+func (res *resource) response() any {
+	if res.wrapper != "" {
+		return map[string]any{res.wrapper: res.body}
+	}
+
+	return res.body
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error in the format of the Scaleway API.
+func writeError(w http.ResponseWriter, code int, errorType, message string) {
+	writeJSON(w, code, map[string]any{
+		"type":    errorType,
+		"message": message,
+	})
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const serversPath = "/instance/v1/zones/fr-par-1/servers"
+
+func TestMain(m *testing.M) {
+	// The server logs every request
+	log.SetOutput(io.Discard)
+
+	m.Run()
+}
+
+func newTestServer() *server {
+	r := newRecordings()
+	r.add(serverInteractions())
+
+	return newServer(r)
+}
+
+// serve sends a request to the mock server and returns the status code and the decoded JSON body.
+func serve(t *testing.T, s *server, method, path, body string) (int, map[string]any) {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), method, path, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	var response map[string]any
+	if w.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response), w.Body.String())
+	}
+
+	return w.Code, response
+}
+
+func serverField(t *testing.T, response map[string]any, field string) any {
+	t.Helper()
+
+	server, isObject := response["server"].(map[string]any)
+	require.True(t, isObject, "response is not a server: %v", response)
+
+	return server[field]
+}
+
+func TestServer_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer()
+
+	code, response := serve(t, s, http.MethodPost, serversPath, `{"name":"test","tags":["a"]}`)
+	require.Equal(t, http.StatusCreated, code)
+
+	id, isString := serverField(t, response, "id").(string)
+	require.True(t, isString)
+	assert.NotEqual(t, serverID, id, "created resources get a new ID")
+	assert.Equal(t, "test", serverField(t, response, "name"), "fields of the request are merged")
+	assert.Equal(t, []any{"a"}, serverField(t, response, "tags"))
+	assert.Equal(t, "stopped", serverField(t, response, "state"))
+
+	serverPath := serversPath + "/" + id
+
+	code, response = serve(t, s, http.MethodPatch, serverPath, `{"name":"updated"}`)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "updated", serverField(t, response, "name"))
+
+	code, response = serve(t, s, http.MethodPost, serverPath+"/action", `{"action":"poweron"}`)
+	require.Equal(t, http.StatusAccepted, code)
+
+	task, isObject := response["task"].(map[string]any)
+	require.True(t, isObject)
+	assert.Equal(t, "/servers/"+id+"/action", task["href_from"], "recorded IDs are replaced with the requested ones")
+
+	// The status follows the transitions of the cassette
+	for _, state := range []string{"starting", "running", "running"} {
+		code, response = serve(t, s, http.MethodGet, serverPath, "")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, state, serverField(t, response, "state"))
+	}
+
+	code, response = serve(t, s, http.MethodGet, serversPath+"?page=1", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.InDelta(t, 1, response["total_count"], 0)
+
+	servers, isList := response["servers"].([]any)
+	require.True(t, isList)
+	require.Len(t, servers, 1)
+	assert.Equal(t, id, servers[0].(map[string]any)["id"])
+
+	code, _ = serve(t, s, http.MethodDelete, serverPath, "")
+	assert.Equal(t, http.StatusNoContent, code)
+
+	code, response = serve(t, s, http.MethodGet, serverPath, "")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "not_found", response["type"])
+
+	code, _ = serve(t, s, http.MethodPost, serverPath+"/action", `{"action":"poweroff"}`)
+	assert.Equal(t, http.StatusNotFound, code, "actions on deleted resources are not found")
+}
+
+func TestServer_Replay(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		wantCode  int
+		wantState string
+		wantID    string
+	}{
+		{
+			name:      "recorded path",
+			method:    http.MethodGet,
+			path:      serversPath + "/" + serverID,
+			wantCode:  http.StatusOK,
+			wantState: "stopped",
+			wantID:    serverID,
+		},
+		{
+			name:      "recorded route",
+			method:    http.MethodGet,
+			path:      "/instance/v1/zones/nl-ams-1/servers/" + taskID,
+			wantCode:  http.StatusOK,
+			wantState: "stopped",
+			wantID:    taskID,
+		},
+		{
+			name:     "unknown route",
+			method:   http.MethodGet,
+			path:     "/instance/v1/zones/fr-par-1/volumes/" + serverID,
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "unrecorded delete",
+			method:   http.MethodDelete,
+			path:     serversPath + "/" + serverID,
+			wantCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, response := serve(t, newTestServer(), tt.method, tt.path, "")
+			assert.Equal(t, tt.wantCode, code)
+
+			if tt.wantState != "" {
+				assert.Equal(t, tt.wantState, serverField(t, response, "state"))
+				assert.Equal(t, tt.wantID, serverField(t, response, "id"))
+			}
+		})
+	}
+}
+
+func TestResource_Merge(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        map[string]any
+		requestBody string
+		want        map[string]any
+	}{
+		{
+			name:        "same type",
+			body:        map[string]any{"name": "a", "size": float64(1)},
+			requestBody: `{"name":"b","size":2}`,
+			want:        map[string]any{"name": "b", "size": float64(2)},
+		},
+		{
+			name:        "different type",
+			body:        map[string]any{"size": float64(1)},
+			requestBody: `{"size":"2"}`,
+			want:        map[string]any{"size": float64(1)},
+		},
+		{
+			name:        "unknown fields and objects",
+			body:        map[string]any{"config": map[string]any{"a": "b"}},
+			requestBody: `{"config":{"a":"c"},"unknown":true}`,
+			want:        map[string]any{"config": map[string]any{"a": "b"}},
+		},
+		{
+			name:        "null values",
+			body:        map[string]any{"name": "a", "description": nil},
+			requestBody: `{"name":null,"description":"b"}`,
+			want:        map[string]any{"name": "a", "description": "b"},
+		},
+		{
+			name:        "not json",
+			body:        map[string]any{"name": "a"},
+			requestBody: `name=b`,
+			want:        map[string]any{"name": "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &resource{body: tt.body}
+			res.merge(tt.requestBody)
+			assert.Equal(t, tt.want, res.body)
+		})
+	}
+}
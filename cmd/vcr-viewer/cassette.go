@@ -0,0 +1,50 @@
+package main
+
+import (
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// interaction is a cassette interaction with its position in the cassette, which is kept when filtering.
+type interaction struct {
+	*cassette.Interaction
+	index int
+}
+
+// loadInteractions loads a cassette and returns the interactions matching the filters.
+func loadInteractions(path string, filters *filters) []interaction {
+	data, err := cassette.Load(strings.TrimSuffix(path, ".yaml"))
+	if err != nil {
+		log.Fatalf("Error while reading file: %v\n", err)
+	}
+
+	interactions := make([]interaction, 0, len(data.Interactions))
+
+	for i, it := range data.Interactions {
+		if filters.match(it) {
+			interactions = append(interactions, interaction{Interaction: it, index: i + 1})
+		}
+	}
+
+	return interactions
+}
+
+// resourceURL returns the URL of the resource targeted by an interaction, without query and with IDs replaced by {id}.
+// It identifies the same resource across recordings of a cassette.
+func resourceURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return acctest.UUIDRegex.ReplaceAllString(rawURL, "{id}")
+	}
+
+	return u.Host + acctest.UUIDRegex.ReplaceAllString(u.Path, "{id}")
+}
+
+// key identifies the request of an interaction across recordings of a cassette.
+func (i interaction) key() string {
+	return i.Request.Method + " " + resourceURL(i.Request.URL)
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+type changeKind string
+
+const (
+	changeAdded     = changeKind("+")
+	changeRemoved   = changeKind("-")
+	changeModified  = changeKind("~")
+	changeUnchanged = changeKind(" ")
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// interactionChange is an interaction which was added, removed or modified between two cassettes.
+type interactionChange struct {
+	kind   changeKind
+	before *interaction
+	after  *interaction
+	status bool
+	fields []acctest.FieldDiff
+	// rawBody is set when a body is not a JSON object and changed.
+	rawBody []string
+}
+
+// timestampRegex matches the RFC 3339 timestamps returned by the API.
+var timestampRegex = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`)
+
+// diffCassettes aligns the interactions of two cassettes on their method and resource URL, then compares the aligned ones.
+// Unless strict is set, IDs and timestamps which changed between recordings are not reported.
+func diffCassettes(before, after []interaction, strict bool) []interactionChange {
+	// Longest common subsequence of the interaction keys
+	lcs := make([][]int32, len(before)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(after)+1)
+	}
+
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i].key() == after[j].key() {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	changes := []interactionChange(nil)
+	i, j := 0, 0
+
+	for i < len(before) || j < len(after) {
+		switch {
+		case i < len(before) && j < len(after) && before[i].key() == after[j].key():
+			changes = append(changes, compareInteractions(&before[i], &after[j], strict))
+			i++
+			j++
+		case j < len(after) && (i == len(before) || lcs[i][j+1] >= lcs[i+1][j]):
+			changes = append(changes, interactionChange{kind: changeAdded, after: &after[j]})
+			j++
+		default:
+			changes = append(changes, interactionChange{kind: changeRemoved, before: &before[i]})
+			i++
+		}
+	}
+
+	return changes
+}
+
+func compareInteractions(before, after *interaction, strict bool) interactionChange {
+	change := interactionChange{
+		kind:   changeUnchanged,
+		before: before,
+		after:  after,
+		status: before.Response.Code != after.Response.Code,
+	}
+
+	for _, body := range []struct {
+		name          string
+		before, after string
+	}{
+		{"request", before.Request.Body, after.Request.Body},
+		{"response", before.Response.Body, after.Response.Body},
+	} {
+		var beforeJSON, afterJSON map[string]any
+
+		if json.Unmarshal([]byte(body.before), &beforeJSON) == nil && json.Unmarshal([]byte(body.after), &afterJSON) == nil {
+			for _, field := range diffBodies(body.name, beforeJSON, afterJSON) {
+				if strict || !isRecordingNoise(field) {
+					change.fields = append(change.fields, field)
+				}
+			}
+		} else if body.before != body.after {
+			change.rawBody = append(change.rawBody, body.name)
+		}
+	}
+
+	if change.status || len(change.fields) > 0 || len(change.rawBody) > 0 {
+		change.kind = changeModified
+	}
+
+	return change
+}
+
+// diffBodies compares two JSON bodies, prefixing the paths of the fields with the name of the body.
+func diffBodies(name string, before, after map[string]any) []acctest.FieldDiff {
+	return acctest.DiffJSONBodies(map[string]any{name: before}, map[string]any{name: after})
+}
+
+// isRecordingNoise returns true if a field only differs by IDs or timestamps, which change with every recording.
+func isRecordingNoise(field acctest.FieldDiff) bool {
+	before, isString := field.Before.(string)
+	if !isString {
+		return false
+	}
+
+	after, isString := field.After.(string)
+	if !isString {
+		return false
+	}
+
+	normalize := func(s string) string {
+		return timestampRegex.ReplaceAllString(acctest.UUIDRegex.ReplaceAllString(s, "{id}"), "{time}")
+	}
+
+	return normalize(before) == normalize(after)
+}
+
+// printDiff prints the changes and returns true if the cassettes differ.
+func printDiff(w io.Writer, changes []interactionChange, withColor bool) bool {
+	colorize := func(kind changeKind, s string) string {
+		if !withColor {
+			return s
+		}
+
+		switch kind {
+		case changeAdded:
+			return colorGreen + s + colorReset
+		case changeRemoved:
+			return colorRed + s + colorReset
+		case changeModified:
+			return colorYellow + s + colorReset
+		default:
+			return s
+		}
+	}
+
+	counts := map[changeKind]int{}
+
+	for _, change := range changes {
+		counts[change.kind]++
+
+		switch change.kind {
+		case changeUnchanged:
+			continue
+		case changeAdded:
+			fmt.Fprintln(w, colorize(change.kind, fmt.Sprintf("+ [%d] %s %s", change.after.index, change.after.key(), change.after.Response.Status)))
+		case changeRemoved:
+			fmt.Fprintln(w, colorize(change.kind, fmt.Sprintf("- [%d] %s %s", change.before.index, change.before.key(), change.before.Response.Status)))
+		case changeModified:
+			fmt.Fprintln(w, colorize(change.kind, fmt.Sprintf("~ [%d -> %d] %s", change.before.index, change.after.index, change.after.key())))
+
+			if change.status {
+				fmt.Fprintln(w, colorize(change.kind, fmt.Sprintf("    ~ status: %s -> %s", change.before.Response.Status, change.after.Response.Status)))
+			}
+
+			for _, body := range change.rawBody {
+				fmt.Fprintln(w, colorize(change.kind, fmt.Sprintf("    ~ %s: body changed", body)))
+			}
+
+			for _, field := range change.fields {
+				switch {
+				case field.Before == nil:
+					fmt.Fprintln(w, colorize(changeAdded, fmt.Sprintf("    + %s: %s", field.Path, formatValue(field.After))))
+				case field.After == nil:
+					fmt.Fprintln(w, colorize(changeRemoved, fmt.Sprintf("    - %s: %s", field.Path, formatValue(field.Before))))
+				default:
+					fmt.Fprintln(w, colorize(changeModified, fmt.Sprintf("    ~ %s: %s -> %s", field.Path, formatValue(field.Before), formatValue(field.After))))
+				}
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "\n%d added, %d removed, %d changed, %d unchanged\n",
+		counts[changeAdded], counts[changeRemoved], counts[changeModified], counts[changeUnchanged])
+
+	return counts[changeAdded]+counts[changeRemoved]+counts[changeModified] > 0
+}
+
+func formatValue(value any) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(raw)
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	instancesURL = "https://api.scaleway.com/rdb/v1/regions/fr-par/instances"
+	beforeID     = "11111111-1111-1111-1111-111111111111"
+	afterID      = "22222222-2222-2222-2222-222222222222"
+)
+
+// newInteraction creates the index-th interaction of a cassette.
+func newInteraction(index int, method string, url string, code int, responseBody string) interaction {
+	return interaction{
+		Interaction: &cassette.Interaction{
+			Request: cassette.Request{
+				Method: method,
+				URL:    url,
+			},
+			Response: cassette.Response{
+				Body:   responseBody,
+				Status: http.StatusText(code),
+				Code:   code,
+			},
+		},
+		index: index,
+	}
+}
+
+// changeSummary returns the kind and the request key of each change.
+func changeSummary(changes []interactionChange) [][2]string {
+	summary := make([][2]string, 0, len(changes))
+
+	for _, change := range changes {
+		it := change.after
+		if it == nil {
+			it = change.before
+		}
+
+		summary = append(summary, [2]string{string(change.kind), it.key()})
+	}
+
+	return summary
+}
+
+func TestDiffCassettes_Alignment(t *testing.T) {
+	create := func(index int) interaction {
+		return newInteraction(index, http.MethodPost, instancesURL, http.StatusOK, `{"status":"provisioning"}`)
+	}
+	get := func(index int, id string) interaction {
+		return newInteraction(index, http.MethodGet, instancesURL+"/"+id, http.StatusOK, `{"status":"ready"}`)
+	}
+	deleteInstance := func(index int, id string) interaction {
+		return newInteraction(index, http.MethodDelete, instancesURL+"/"+id, http.StatusOK, `{"status":"deleting"}`)
+	}
+
+	tests := []struct {
+		name   string
+		before []interaction
+		after  []interaction
+		want   [][2]string
+	}{
+		{
+			name:   "same interactions with other IDs",
+			before: []interaction{create(1), get(2, beforeID), deleteInstance(3, beforeID)},
+			after:  []interaction{create(1), get(2, afterID), deleteInstance(3, afterID)},
+			want: [][2]string{
+				{" ", "POST api.scaleway.com/rdb/v1/regions/fr-par/instances"},
+				{" ", "GET api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+				{" ", "DELETE api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+			},
+		},
+		{
+			name:   "added polling",
+			before: []interaction{create(1), get(2, beforeID), deleteInstance(3, beforeID)},
+			after:  []interaction{create(1), get(2, afterID), get(3, afterID), get(4, afterID), deleteInstance(5, afterID)},
+			want: [][2]string{
+				{" ", "POST api.scaleway.com/rdb/v1/regions/fr-par/instances"},
+				{" ", "GET api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+				{"+", "GET api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+				{"+", "GET api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+				{" ", "DELETE api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+			},
+		},
+		{
+			name:   "removed interaction",
+			before: []interaction{create(1), get(2, beforeID), deleteInstance(3, beforeID)},
+			after:  []interaction{create(1), deleteInstance(2, afterID)},
+			want: [][2]string{
+				{" ", "POST api.scaleway.com/rdb/v1/regions/fr-par/instances"},
+				{"-", "GET api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+				{" ", "DELETE api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+			},
+		},
+		{
+			name:   "replaced interaction",
+			before: []interaction{create(1), get(2, beforeID)},
+			after:  []interaction{create(1), deleteInstance(2, afterID)},
+			want: [][2]string{
+				{" ", "POST api.scaleway.com/rdb/v1/regions/fr-par/instances"},
+				{"+", "DELETE api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+				{"-", "GET api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}"},
+			},
+		},
+		{
+			name:  "empty before",
+			after: []interaction{create(1)},
+			want: [][2]string{
+				{"+", "POST api.scaleway.com/rdb/v1/regions/fr-par/instances"},
+			},
+		},
+		{
+			name:   "empty after",
+			before: []interaction{create(1)},
+			want: [][2]string{
+				{"-", "POST api.scaleway.com/rdb/v1/regions/fr-par/instances"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, changeSummary(diffCassettes(tt.before, tt.after, false)))
+		})
+	}
+}
+
+func TestDiffCassettes_Changes(t *testing.T) {
+	tests := []struct {
+		name       string
+		before     interaction
+		after      interaction
+		strict     bool
+		wantKind   changeKind
+		wantStatus bool
+		wantFields []acctest.FieldDiff
+		wantRaw    []string
+	}{
+		{
+			name:     "recording noise",
+			before:   newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, `{"id":"`+beforeID+`","created_at":"2025-01-01T10:00:00.123Z"}`),
+			after:    newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, `{"id":"`+afterID+`","created_at":"2026-02-03T11:22:33+01:00"}`),
+			wantKind: changeUnchanged,
+		},
+		{
+			name:     "recording noise in strict mode",
+			before:   newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, `{"id":"`+beforeID+`"}`),
+			after:    newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, `{"id":"`+afterID+`"}`),
+			strict:   true,
+			wantKind: changeModified,
+			wantFields: []acctest.FieldDiff{
+				{Path: "response.id", Before: beforeID, After: afterID},
+			},
+		},
+		{
+			name:       "status",
+			before:     newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, `{}`),
+			after:      newInteraction(1, http.MethodGet, instancesURL, http.StatusNotFound, `{}`),
+			wantKind:   changeModified,
+			wantStatus: true,
+		},
+		{
+			name:     "fields",
+			before:   newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, `{"status":"ready","settings":{"engine":"PostgreSQL15"},"tags":["a"]}`),
+			after:    newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, `{"status":"ready","settings":{"engine":"PostgreSQL16"},"volume":"lssd"}`),
+			wantKind: changeModified,
+			wantFields: []acctest.FieldDiff{
+				{Path: "response.settings.engine", Before: "PostgreSQL15", After: "PostgreSQL16"},
+				{Path: "response.tags", Before: []any{"a"}},
+				{Path: "response.volume", After: "lssd"},
+			},
+		},
+		{
+			name:     "raw body",
+			before:   newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, "first"),
+			after:    newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, "second"),
+			wantKind: changeModified,
+			wantRaw:  []string{"response"},
+		},
+		{
+			name:     "same raw body",
+			before:   newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, "same"),
+			after:    newInteraction(1, http.MethodGet, instancesURL, http.StatusOK, "same"),
+			wantKind: changeUnchanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			changes := diffCassettes([]interaction{tt.before}, []interaction{tt.after}, tt.strict)
+			require.Len(t, changes, 1)
+
+			change := changes[0]
+			assert.Equal(t, tt.wantKind, change.kind)
+			assert.Equal(t, tt.wantStatus, change.status)
+			assert.Equal(t, tt.wantFields, change.fields)
+			assert.Equal(t, tt.wantRaw, change.rawBody)
+		})
+	}
+}
+
+func TestPrintDiff(t *testing.T) {
+	before := []interaction{
+		newInteraction(1, http.MethodPost, instancesURL, http.StatusOK, `{"status":"provisioning"}`),
+		newInteraction(2, http.MethodGet, instancesURL+"/"+beforeID, http.StatusOK, `{"status":"ready"}`),
+		newInteraction(3, http.MethodDelete, instancesURL+"/"+beforeID, http.StatusOK, `{}`),
+	}
+	after := []interaction{
+		newInteraction(1, http.MethodPost, instancesURL, http.StatusOK, `{"status":"provisioning"}`),
+		newInteraction(2, http.MethodGet, instancesURL+"/"+afterID, http.StatusOK, `{"status":"provisioning"}`),
+		newInteraction(3, http.MethodGet, instancesURL+"/"+afterID, http.StatusOK, `{"status":"ready"}`),
+	}
+
+	var out bytes.Buffer
+
+	differ := printDiff(&out, diffCassettes(before, after, false), false)
+	assert.True(t, differ)
+	assert.Equal(t, `~ [2 -> 2] GET api.scaleway.com/rdb/v1/regions/fr-par/instances/{id}
+    ~ response.status: "ready" -> "provisioning"
++ [3] GET api.scaleway.com/rdb/v1/regions/fr-par/instances/{id} OK
+- [3] DELETE api.scaleway.com/rdb/v1/regions/fr-par/instances/{id} OK
+
+1 added, 1 removed, 1 changed, 1 unchanged
+`, out.String())
+
+	out.Reset()
+
+	differ = printDiff(&out, diffCassettes(before, before, false), false)
+	assert.False(t, differ)
+	assert.Equal(t, "\n0 added, 0 removed, 0 changed, 3 unchanged\n", out.String())
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// filters select the interactions to inspect.
+type filters struct {
+	rawMethods  string
+	rawURL      string
+	rawStatuses string
+
+	methods  []string
+	url      *regexp.Regexp
+	statuses []string
+}
+
+func registerFilters(fs *flag.FlagSet) *filters {
+	f := &filters{}
+
+	fs.StringVar(&f.rawMethods, "method", "", "comma separated HTTP methods to keep, e.g. GET,DELETE")
+	fs.StringVar(&f.rawURL, "url", "", "regular expression the request URL must match")
+	fs.StringVar(&f.rawStatuses, "status", "", "comma separated response status codes or classes to keep, e.g. 404,5xx")
+
+	return f
+}
+
+// compile validates the raw flag values.
+func (f *filters) compile() error {
+	for method := range strings.SplitSeq(f.rawMethods, ",") {
+		if method = strings.TrimSpace(method); method != "" {
+			f.methods = append(f.methods, strings.ToUpper(method))
+		}
+	}
+
+	if f.rawURL != "" {
+		re, err := regexp.Compile(f.rawURL)
+		if err != nil {
+			return fmt.Errorf("url: %w", err)
+		}
+
+		f.url = re
+	}
+
+	for status := range strings.SplitSeq(f.rawStatuses, ",") {
+		status = strings.ToLower(strings.TrimSpace(status))
+		if status == "" {
+			continue
+		}
+
+		if _, err := strconv.Atoi(strings.ReplaceAll(status, "x", "0")); err != nil || len(status) != 3 {
+			return fmt.Errorf("status: %q is neither a status code nor a class like 4xx", status)
+		}
+
+		f.statuses = append(f.statuses, status)
+	}
+
+	return nil
+}
+
+func (f *filters) match(i *cassette.Interaction) bool {
+	if len(f.methods) > 0 && !slices.Contains(f.methods, i.Request.Method) {
+		return false
+	}
+
+	if f.url != nil && !f.url.MatchString(i.Request.URL) {
+		return false
+	}
+
+	if len(f.statuses) > 0 && !slices.ContainsFunc(f.statuses, func(status string) bool { return matchStatus(status, i.Response.Code) }) {
+		return false
+	}
+
+	return true
+}
+
+// matchStatus returns true if code matches a status code or a class where x matches any digit.
+func matchStatus(status string, code int) bool {
+	codeString := strconv.Itoa(code)
+	if len(codeString) != len(status) {
+		return false
+	}
+
+	for i := range status {
+		if status[i] != 'x' && status[i] != codeString[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseFilters registers the filters on a new flag set and parses the given arguments.
+func parseFilters(t *testing.T, args ...string) (*filters, error) {
+	t.Helper()
+
+	fs := flag.NewFlagSet("vcr-viewer", flag.ContinueOnError)
+	f := registerFilters(fs)
+	require.NoError(t, fs.Parse(args))
+
+	return f, f.compile()
+}
+
+func TestFilters_Compile(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		wantMethods  []string
+		wantStatuses []string
+		wantErr      string
+	}{
+		{
+			name: "no filter",
+		},
+		{
+			name:         "methods and statuses",
+			args:         []string{"-method", "get, delete,", "-status", "404,5XX"},
+			wantMethods:  []string{"GET", "DELETE"},
+			wantStatuses: []string{"404", "5xx"},
+		},
+		{
+			name:    "invalid url",
+			args:    []string{"-url", "servers/("},
+			wantErr: "url: ",
+		},
+		{
+			name:    "invalid status",
+			args:    []string{"-status", "4x"},
+			wantErr: `status: "4x" is neither a status code nor a class like 4xx`,
+		},
+		{
+			name:    "invalid status class",
+			args:    []string{"-status", "error"},
+			wantErr: `status: "error" is neither a status code nor a class like 4xx`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := parseFilters(t, tt.args...)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMethods, f.methods)
+			assert.Equal(t, tt.wantStatuses, f.statuses)
+		})
+	}
+}
+
+func TestFilters_Match(t *testing.T) {
+	deleteServer := newInteraction(1, http.MethodDelete, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/"+beforeID, http.StatusNotFound, "{}")
+	listServers := newInteraction(2, http.MethodGet, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?page=1", http.StatusOK, "{}")
+
+	tests := []struct {
+		name string
+		args []string
+		// want lists the indexes of the kept interactions
+		want []int
+	}{
+		{
+			name: "no filter",
+			want: []int{1, 2},
+		},
+		{
+			name: "method",
+			args: []string{"-method", "delete"},
+			want: []int{1},
+		},
+		{
+			name: "url",
+			args: []string{"-url", `servers\?`},
+			want: []int{2},
+		},
+		{
+			name: "status class",
+			args: []string{"-status", "4xx"},
+			want: []int{1},
+		},
+		{
+			name: "status code",
+			args: []string{"-status", "500,200"},
+			want: []int{2},
+		},
+		{
+			name: "all filters must match",
+			args: []string{"-method", "GET", "-status", "404"},
+			want: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := parseFilters(t, tt.args...)
+			require.NoError(t, err)
+
+			kept := []int{}
+
+			for _, it := range []interaction{deleteServer, listServers} {
+				if f.match(it.Interaction) {
+					kept = append(kept, it.index)
+				}
+			}
+
+			assert.Equal(t, tt.want, kept)
+		})
+	}
+}
+
+func TestMatchStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		code   int
+		want   bool
+	}{
+		{status: "404", code: http.StatusNotFound, want: true},
+		{status: "404", code: http.StatusConflict, want: false},
+		{status: "4xx", code: http.StatusConflict, want: true},
+		{status: "4xx", code: http.StatusInternalServerError, want: false},
+		{status: "xxx", code: http.StatusOK, want: true},
+		{status: "20x", code: http.StatusNoContent, want: true},
+		{status: "2xx", code: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status+"/"+http.StatusText(tt.code), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, matchStatus(tt.status, tt.code))
+		})
+	}
+}
@@ -1,55 +1,78 @@
 package main
 
 import (
-	"encoding/json"
+	"flag"
+	"fmt"
 	"log"
 	"os"
-
-	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
+const usage = `Usage:
+  %[1]s [show] [filters] <cassette>          print the interactions of a cassette
+  %[1]s summary [filters] <cassette>         print the interactions per method and resource URL
+  %[1]s diff [filters] <before> <after>      compare the interactions of two cassettes
+
+Cassettes can be given with or without the .yaml extension.
+diff exits with code 1 when the cassettes differ.
+
+Filters:
+`
+
 func main() {
-	if len(os.Args) < 2 {
-		log.Fatalf("Usage: %s <cassette_file_name_without_yaml>\n", os.Args[0])
+	log.SetFlags(0)
+
+	args := os.Args[1:]
+	command := "show"
+
+	if len(args) > 0 {
+		switch args[0] {
+		case "show", "summary", "diff":
+			command, args = args[0], args[1:]
+		}
 	}
 
-	path := os.Args[1]
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	filters := registerFilters(fs)
+	noBody := fs.Bool("no-body", false, "do not print the request and response bodies (show only)")
+	noColor := fs.Bool("no-color", os.Getenv("NO_COLOR") != "", "disable the colors of the diff output")
+	strict := fs.Bool("strict", false, "report IDs and timestamps which changed between recordings (diff only)")
 
-	data, err := cassette.Load(path)
-	if err != nil {
-		log.Fatalf("Error while reading file: %v\n", err)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), usage, os.Args[0])
+		fs.PrintDefaults()
 	}
 
-	for i := range len(data.Interactions) {
-		interaction := data.Interactions[i]
+	_ = fs.Parse(args)
 
-		log.Println("--------------")
-		log.Printf("Interaction %d:\n", i+1)
-		log.Printf("  Request:\n")
-		log.Printf("    Method: %s\n", interaction.Request.Method)
-		log.Printf("    URL: %s\n", interaction.Request.URL)
+	if err := filters.compile(); err != nil {
+		log.Fatalf("Invalid filter: %v\n", err)
+	}
 
-		if interaction.Request.Body != "" {
-			log.Printf("    Body: %s\n", interaction.Request.Body)
+	switch command {
+	case "diff":
+		if fs.NArg() != 2 {
+			fs.Usage()
+			os.Exit(2)
 		}
 
-		log.Printf("  Response:\n")
-		log.Printf("    Status: %s\n", interaction.Response.Status)
-		log.Printf("    Body: %s\n", interaction.Response.Body)
-
-		var m map[string]any
+		before := loadInteractions(fs.Arg(0), filters)
+		after := loadInteractions(fs.Arg(1), filters)
 
-		err := json.Unmarshal([]byte(interaction.Response.Body), &m)
-		if err != nil {
-			continue
+		if printDiff(os.Stdout, diffCassettes(before, after, *strict), !*noColor) {
+			os.Exit(1)
 		}
-
-		if m["status"] != nil {
-			log.Println("++++++++++++++++")
-			log.Printf("status: %s\n", m["status"])
-			log.Println("++++++++++++++++")
+	default:
+		if fs.NArg() != 1 {
+			fs.Usage()
+			os.Exit(2)
 		}
 
-		log.Println("--------------")
+		interactions := loadInteractions(fs.Arg(0), filters)
+
+		if command == "summary" {
+			printSummary(os.Stdout, interactions)
+		} else {
+			printInteractions(os.Stdout, interactions, !*noBody)
+		}
 	}
 }
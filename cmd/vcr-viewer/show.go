@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+func printInteractions(w io.Writer, interactions []interaction, withBody bool) {
+	for _, i := range interactions {
+		fmt.Fprintln(w, "--------------")
+		fmt.Fprintf(w, "Interaction %d:\n", i.index)
+		fmt.Fprintf(w, "  Request:\n")
+		fmt.Fprintf(w, "    Method: %s\n", i.Request.Method)
+		fmt.Fprintf(w, "    URL: %s\n", i.Request.URL)
+
+		if withBody && i.Request.Body != "" {
+			fmt.Fprintf(w, "    Body: %s\n", i.Request.Body)
+		}
+
+		fmt.Fprintf(w, "  Response:\n")
+		fmt.Fprintf(w, "    Status: %s\n", i.Response.Status)
+
+		if withBody {
+			fmt.Fprintf(w, "    Body: %s\n", i.Response.Body)
+		}
+
+		var m map[string]any
+
+		if json.Unmarshal([]byte(i.Response.Body), &m) == nil && m["status"] != nil {
+			fmt.Fprintln(w, "++++++++++++++++")
+			fmt.Fprintf(w, "status: %s\n", m["status"])
+			fmt.Fprintln(w, "++++++++++++++++")
+		}
+	}
+
+	fmt.Fprintf(w, "--------------\n%d interactions\n", len(interactions))
+}
+
+// resourceSummary counts the interactions on a resource URL with a method.
+type resourceSummary struct {
+	key      string
+	first    int
+	count    int
+	statuses map[int]int
+}
+
+func printSummary(w io.Writer, interactions []interaction) {
+	summaries := map[string]*resourceSummary{}
+
+	var keys []string
+
+	for _, i := range interactions {
+		summary, exists := summaries[i.key()]
+		if !exists {
+			summary = &resourceSummary{key: i.key(), first: i.index, statuses: map[int]int{}}
+			summaries[i.key()] = summary
+			keys = append(keys, i.key())
+		}
+
+		summary.count++
+		summary.statuses[i.Response.Code]++
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FIRST\tCOUNT\tMETHOD\tRESOURCE\tSTATUSES")
+
+	for _, key := range keys {
+		summary := summaries[key]
+		method, resource, _ := strings.Cut(summary.key, " ")
+
+		statuses := make([]string, 0, len(summary.statuses))
+		for _, code := range slices.Sorted(maps.Keys(summary.statuses)) {
+			statuses = append(statuses, fmt.Sprintf("%d (%d)", code, summary.statuses[code]))
+		}
+
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\n", summary.first, summary.count, method, resource, strings.Join(statuses, ", "))
+	}
+
+	_ = tw.Flush()
+
+	fmt.Fprintf(w, "\n%d interactions on %d resources\n", len(interactions), len(keys))
+}
@@ -0,0 +1,8 @@
+# Build a regional ID from a region and an ID
+variable "secret_id" {
+  type = string
+}
+
+data "scaleway_secret" "main" {
+  secret_id = provider::scaleway::build_regional_id("nl-ams", var.secret_id)
+}
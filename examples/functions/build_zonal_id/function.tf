@@ -0,0 +1,8 @@
+# Build a zonal ID from a zone and an ID
+variable "server_id" {
+  type = string
+}
+
+data "scaleway_instance_server" "main" {
+  server_id = provider::scaleway::build_zonal_id("fr-par-2", var.server_id)
+}
@@ -0,0 +1,26 @@
+# Check that a static IP belongs to the subnet of a Private Network
+variable "static_ip" {
+  type    = string
+  default = "172.16.32.10"
+}
+
+resource "scaleway_vpc_private_network" "main" {
+  ipv4_subnet {
+    subnet = "172.16.32.0/22"
+  }
+}
+
+resource "scaleway_ipam_ip" "main" {
+  address = var.static_ip
+
+  source {
+    private_network_id = scaleway_vpc_private_network.main.id
+  }
+
+  lifecycle {
+    precondition {
+      condition     = provider::scaleway::cidr_contains(scaleway_vpc_private_network.main.ipv4_subnet[0].subnet, var.static_ip)
+      error_message = "The static IP must be within the Private Network subnet."
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# Check that the subnets of the Private Networks do not overlap
+locals {
+  subnets = ["172.16.0.0/22", "172.16.4.0/22", "192.168.0.0/24"]
+}
+
+resource "scaleway_vpc_private_network" "main" {
+  count = length(local.subnets)
+
+  ipv4_subnet {
+    subnet = local.subnets[count.index]
+  }
+
+  lifecycle {
+    precondition {
+      condition     = provider::scaleway::cidr_subnets_non_overlapping(local.subnets)
+      error_message = "The Private Network subnets must not overlap."
+    }
+  }
+}
@@ -0,0 +1,11 @@
+# Extract the ID without zone from a zonal ID
+resource "scaleway_instance_ip" "main" {}
+
+output "ip_id" {
+  value = provider::scaleway::id_from_zonal_id(scaleway_instance_ip.main.id, null)
+}
+
+# Extract ID with validation disabled (for non-Scaleway zones)
+output "custom_id" {
+  value = provider::scaleway::id_from_zonal_id("my-zone/12345678-1234-1234-1234-123456789012", true)
+}
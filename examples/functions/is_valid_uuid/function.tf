@@ -0,0 +1,9 @@
+# Check whether a value is a UUID without locality
+variable "image" {
+  type    = string
+  default = "ubuntu_noble"
+}
+
+output "image_is_uuid" {
+  value = provider::scaleway::is_valid_uuid(var.image)
+}
@@ -0,0 +1,15 @@
+# Extract the region from a regional ID
+resource "scaleway_secret" "main" {
+  name = "my-secret"
+}
+
+output "secret_locality" {
+  value = provider::scaleway::locality_from_id(scaleway_secret.main.id)
+}
+
+# Extract the zone from a zonal ID
+resource "scaleway_instance_ip" "main" {}
+
+output "ip_locality" {
+  value = provider::scaleway::locality_from_id(scaleway_instance_ip.main.id)
+}
@@ -0,0 +1,12 @@
+# Parse a Scaleway Resource Name
+locals {
+  srn = provider::scaleway::parse_srn("srn://key-manager.scw.eu/regions/fr-par/keys/12345678-1234-1234-1234-123456789012")
+}
+
+output "key_service" {
+  value = local.srn.service # key-manager
+}
+
+output "key_id" {
+  value = provider::scaleway::build_regional_id(local.srn.region, local.srn.id)
+}
@@ -0,0 +1,5 @@
+# List the IPs which cannot be assigned in a Private Network subnet
+output "reserved_ips" {
+  # ["172.16.32.0", "172.16.32.1", "172.16.35.255"]
+  value = provider::scaleway::vpc_reserved_ips("172.16.32.0/22")
+}
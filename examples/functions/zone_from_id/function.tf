@@ -0,0 +1,11 @@
+# Extract the zone from a resource ID
+resource "scaleway_instance_ip" "main" {}
+
+output "ip_zone" {
+  value = provider::scaleway::zone_from_id(scaleway_instance_ip.main.id, null)
+}
+
+# Extract zone with validation disabled (for non-Scaleway zones)
+output "custom_zone" {
+  value = provider::scaleway::zone_from_id("my-zone/12345678-1234-1234-1234-123456789012", true)
+}
@@ -0,0 +1,10 @@
+# Get the region of a zone
+variable "zone" {
+  type    = string
+  default = "fr-par-2"
+}
+
+resource "scaleway_secret" "main" {
+  name   = "my-secret"
+  region = provider::scaleway::zone_to_region(var.zone)
+}
@@ -0,0 +1,8 @@
+# List the containers of a namespace
+list "scaleway_container" "by_namespace" {
+  provider = scaleway
+
+  config {
+    namespace_id = "fr-par/11111111-1111-1111-1111-111111111111"
+  }
+}
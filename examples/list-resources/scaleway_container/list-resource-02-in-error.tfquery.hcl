@@ -0,0 +1,10 @@
+# List containers in error across all regions and all projects
+list "scaleway_container" "in_error" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+    statuses    = ["error"]
+  }
+}
@@ -0,0 +1,8 @@
+# List the crons of a container
+list "scaleway_container_cron" "by_container" {
+  provider = scaleway
+
+  config {
+    container_id = "fr-par/11111111-1111-1111-1111-111111111111"
+  }
+}
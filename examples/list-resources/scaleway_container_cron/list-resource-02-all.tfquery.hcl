@@ -0,0 +1,9 @@
+# List container crons across all regions and all projects
+list "scaleway_container_cron" "all" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+  }
+}
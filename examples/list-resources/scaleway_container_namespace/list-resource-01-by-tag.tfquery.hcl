@@ -0,0 +1,8 @@
+# List container namespaces filtered by tag
+list "scaleway_container_namespace" "by_tag" {
+  provider = scaleway
+
+  config {
+    tags = ["production"]
+  }
+}
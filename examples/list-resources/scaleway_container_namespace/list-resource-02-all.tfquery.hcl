@@ -0,0 +1,9 @@
+# List container namespaces across all regions and all projects
+list "scaleway_container_namespace" "all" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+  }
+}
@@ -0,0 +1,10 @@
+# List container triggers in error across all regions and all projects
+list "scaleway_container_trigger" "in_error" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+    statuses    = ["error"]
+  }
+}
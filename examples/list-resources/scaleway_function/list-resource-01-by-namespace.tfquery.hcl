@@ -0,0 +1,8 @@
+# List the functions of a namespace
+list "scaleway_function" "by_namespace" {
+  provider = scaleway
+
+  config {
+    namespace_id = "fr-par/11111111-1111-1111-1111-111111111111"
+  }
+}
@@ -0,0 +1,10 @@
+# List functions in error across all regions and all projects
+list "scaleway_function" "in_error" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+    statuses    = ["error"]
+  }
+}
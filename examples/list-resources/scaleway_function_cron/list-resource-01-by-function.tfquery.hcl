@@ -0,0 +1,8 @@
+# List the crons of a function
+list "scaleway_function_cron" "by_function" {
+  provider = scaleway
+
+  config {
+    function_id = "fr-par/11111111-1111-1111-1111-111111111111"
+  }
+}
@@ -0,0 +1,9 @@
+# List function crons across all regions and all projects
+list "scaleway_function_cron" "all" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+  }
+}
@@ -0,0 +1,8 @@
+# List function namespaces filtered by tag
+list "scaleway_function_namespace" "by_tag" {
+  provider = scaleway
+
+  config {
+    tags = ["production"]
+  }
+}
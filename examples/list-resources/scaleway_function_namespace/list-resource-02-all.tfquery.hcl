@@ -0,0 +1,9 @@
+# List function namespaces across all regions and all projects
+list "scaleway_function_namespace" "all" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+  }
+}
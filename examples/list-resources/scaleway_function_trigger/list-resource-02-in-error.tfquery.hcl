@@ -0,0 +1,10 @@
+# List function triggers in error across all regions and all projects
+list "scaleway_function_trigger" "in_error" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+    statuses    = ["error"]
+  }
+}
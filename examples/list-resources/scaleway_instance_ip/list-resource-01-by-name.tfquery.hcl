@@ -0,0 +1,9 @@
+# List instance flexible ips filtered by name
+list "scaleway_instance_ip" "by_name" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    name  = "my-ip"
+  }
+}
@@ -0,0 +1,9 @@
+# List instance flexible ips filtered by tag
+list "scaleway_instance_ip" "by_tag" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    tags  = ["production"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance flexible ips in a given state
+list "scaleway_instance_ip" "by_state" {
+  provider = scaleway
+
+  config {
+    zones  = ["fr-par-1"]
+    states = ["attached"]
+  }
+}
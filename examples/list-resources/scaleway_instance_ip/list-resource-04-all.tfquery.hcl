@@ -0,0 +1,9 @@
+# List instance flexible ips across all zones and all projects
+list "scaleway_instance_ip" "all" {
+  provider = scaleway
+
+  config {
+    zones       = ["*"]
+    project_ids = ["*"]
+  }
+}
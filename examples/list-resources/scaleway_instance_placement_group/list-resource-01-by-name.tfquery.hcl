@@ -0,0 +1,9 @@
+# List instance placement groups filtered by name
+list "scaleway_instance_placement_group" "by_name" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    name  = "my-placement-group"
+  }
+}
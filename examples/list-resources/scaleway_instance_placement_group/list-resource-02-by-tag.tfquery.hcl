@@ -0,0 +1,9 @@
+# List instance placement groups filtered by tag
+list "scaleway_instance_placement_group" "by_tag" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    tags  = ["production"]
+  }
+}
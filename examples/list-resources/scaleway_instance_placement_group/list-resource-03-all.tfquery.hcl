@@ -0,0 +1,9 @@
+# List instance placement groups across all zones and all projects
+list "scaleway_instance_placement_group" "all" {
+  provider = scaleway
+
+  config {
+    zones       = ["*"]
+    project_ids = ["*"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance security groups filtered by name
+list "scaleway_instance_security_group" "by_name" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    name  = "my-security-group"
+  }
+}
@@ -0,0 +1,9 @@
+# List instance security groups filtered by tag
+list "scaleway_instance_security_group" "by_tag" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    tags  = ["production"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance security groups in a given state
+list "scaleway_instance_security_group" "by_state" {
+  provider = scaleway
+
+  config {
+    zones  = ["fr-par-1"]
+    states = ["available"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance security groups across all zones and all projects
+list "scaleway_instance_security_group" "all" {
+  provider = scaleway
+
+  config {
+    zones       = ["*"]
+    project_ids = ["*"]
+  }
+}
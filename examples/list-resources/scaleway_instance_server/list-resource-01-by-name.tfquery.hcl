@@ -0,0 +1,9 @@
+# List instance servers filtered by name
+list "scaleway_instance_server" "by_name" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    name  = "my-server"
+  }
+}
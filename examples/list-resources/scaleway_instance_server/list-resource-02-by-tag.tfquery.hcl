@@ -0,0 +1,9 @@
+# List instance servers filtered by tag
+list "scaleway_instance_server" "by_tag" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    tags  = ["production"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance servers in a given state
+list "scaleway_instance_server" "by_state" {
+  provider = scaleway
+
+  config {
+    zones  = ["fr-par-1"]
+    states = ["running"]
+  }
+}
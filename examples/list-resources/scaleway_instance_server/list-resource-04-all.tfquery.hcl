@@ -0,0 +1,9 @@
+# List instance servers across all zones and all projects
+list "scaleway_instance_server" "all" {
+  provider = scaleway
+
+  config {
+    zones       = ["*"]
+    project_ids = ["*"]
+  }
+}
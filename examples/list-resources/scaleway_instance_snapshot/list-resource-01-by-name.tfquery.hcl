@@ -0,0 +1,9 @@
+# List instance snapshots filtered by name
+list "scaleway_instance_snapshot" "by_name" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    name  = "my-snapshot"
+  }
+}
@@ -0,0 +1,9 @@
+# List instance snapshots filtered by tag
+list "scaleway_instance_snapshot" "by_tag" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    tags  = ["production"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance snapshots in a given state
+list "scaleway_instance_snapshot" "by_state" {
+  provider = scaleway
+
+  config {
+    zones  = ["fr-par-1"]
+    states = ["available"]
+  }
+}
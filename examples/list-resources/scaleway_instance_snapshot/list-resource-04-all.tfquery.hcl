@@ -0,0 +1,9 @@
+# List instance snapshots across all zones and all projects
+list "scaleway_instance_snapshot" "all" {
+  provider = scaleway
+
+  config {
+    zones       = ["*"]
+    project_ids = ["*"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance volumes filtered by name
+list "scaleway_instance_volume" "by_name" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    name  = "my-volume"
+  }
+}
@@ -0,0 +1,9 @@
+# List instance volumes filtered by tag
+list "scaleway_instance_volume" "by_tag" {
+  provider = scaleway
+
+  config {
+    zones = ["*"]
+    tags  = ["production"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance volumes in a given state
+list "scaleway_instance_volume" "by_state" {
+  provider = scaleway
+
+  config {
+    zones  = ["fr-par-1"]
+    states = ["available"]
+  }
+}
@@ -0,0 +1,9 @@
+# List instance volumes across all zones and all projects
+list "scaleway_instance_volume" "all" {
+  provider = scaleway
+
+  config {
+    zones       = ["*"]
+    project_ids = ["*"]
+  }
+}
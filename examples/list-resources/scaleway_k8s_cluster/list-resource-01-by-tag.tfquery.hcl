@@ -0,0 +1,9 @@
+# List Kubernetes clusters filtered by tag
+list "scaleway_k8s_cluster" "by_tag" {
+  provider = scaleway
+
+  config {
+    regions = ["*"]
+    tags    = ["team-data"]
+  }
+}
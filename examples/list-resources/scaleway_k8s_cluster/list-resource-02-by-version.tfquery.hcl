@@ -0,0 +1,9 @@
+# List Kapsule clusters of a Kubernetes minor version
+list "scaleway_k8s_cluster" "by_version" {
+  provider = scaleway
+
+  config {
+    types    = ["kapsule"]
+    versions = ["1.32"]
+  }
+}
@@ -0,0 +1,9 @@
+# List Kubernetes clusters across all regions and all projects
+list "scaleway_k8s_cluster" "all" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+  }
+}
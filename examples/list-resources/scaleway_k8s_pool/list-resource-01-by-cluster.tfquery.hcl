@@ -0,0 +1,8 @@
+# List the pools of a Kubernetes cluster
+list "scaleway_k8s_pool" "by_cluster" {
+  provider = scaleway
+
+  config {
+    cluster_id = "fr-par/11111111-1111-1111-1111-111111111111"
+  }
+}
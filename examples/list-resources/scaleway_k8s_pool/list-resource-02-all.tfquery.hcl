@@ -0,0 +1,9 @@
+# List the pools of every Kubernetes cluster across all regions and all projects
+list "scaleway_k8s_pool" "all" {
+  provider = scaleway
+
+  config {
+    regions     = ["*"]
+    project_ids = ["*"]
+  }
+}
@@ -121,9 +121,12 @@ func NewTestTools(t *testing.T) *TestTools {
 		cleanup    func()
 	)
 
-	if FolderUsesVCRv4(folder) {
+	switch {
+	case FolderUsesVCRv4(folder) && *HybridCassettes && !*UpdateCassettes:
+		httpClient, cleanup, err = newHybridRecordedClient(t, folder)
+	case FolderUsesVCRv4(folder):
 		httpClient, cleanup, err = NewRecordedClient(t, folder, *UpdateCassettes)
-	} else {
+	default:
 		httpClient, cleanup, err = getHTTPRecoder(t, folder, *UpdateCassettes)
 	}
 
@@ -137,7 +140,7 @@ func NewTestTools(t *testing.T) *TestTools {
 	})
 	require.NoError(t, err)
 
-	if !*UpdateCassettes {
+	if !*UpdateCassettes && !*HybridCassettes {
 		// If no recording is happening, the delay to retry interactions should be 0
 		transport.DefaultWaitRetryInterval = new(0 * time.Second)
 	} else if os.Getenv(env.RetryDelay) != "" {
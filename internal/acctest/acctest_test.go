@@ -498,3 +498,40 @@ func TestCassetteMatcher(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffJSONBodies(t *testing.T) {
+	before := map[string]any{
+		"name":   "tf-srv-nostalgic-wozniak",
+		"state":  "starting",
+		"tags":   []any{"foo", "bar"},
+		"volume": map[string]any{"size": float64(10), "type": "sbs_volume"},
+		"ipv6":   nil,
+		"legacy": true,
+	}
+	after := map[string]any{
+		"name":   "tf-srv-festive-hopper",
+		"state":  "running",
+		"tags":   []any{"bar", "foo"},
+		"volume": map[string]any{"size": float64(20), "type": "sbs_volume"},
+		"zone":   "fr-par-1",
+	}
+
+	diffs := acctest.DiffJSONBodies(before, after)
+
+	expected := []acctest.FieldDiff{
+		{Path: "legacy", Before: true},
+		{Path: "state", Before: "starting", After: "running"},
+		{Path: "volume.size", Before: float64(10), After: float64(20)},
+		{Path: "zone", After: "fr-par-1"},
+	}
+
+	if len(diffs) != len(expected) {
+		t.Fatalf("expected %d diffs, got %d: %v", len(expected), len(diffs), diffs)
+	}
+
+	for i := range expected {
+		if diffs[i] != expected[i] {
+			t.Errorf("diff %d: expected %v, got %v", i, expected[i], diffs[i])
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package acctest
 
 import (
+	"fmt"
 	"net/url"
 	"reflect"
 	"slices"
@@ -238,3 +239,70 @@ func compareSlices(request, cassette []any) bool {
 		return reflect.DeepEqual(request, cassette)
 	}
 }
+
+// FieldDiff is a field which differs between two JSON bodies.
+// Before is nil if the field was added and After is nil if it was removed.
+type FieldDiff struct {
+	Path   string
+	Before any
+	After  any
+}
+
+// DiffJSONBodies returns the fields which differ between two JSON bodies, sorted by path.
+// Values are compared with the same rules as the cassette matcher, e.g. generated names with different suffixes are equal.
+func DiffJSONBodies(before, after map[string]any) []FieldDiff {
+	return diffJSONBodies("", before, after)
+}
+
+func diffJSONBodies(prefix string, before, after map[string]any) []FieldDiff {
+	keys := make([]string, 0, len(before)+len(after))
+	for key := range before {
+		keys = append(keys, key)
+	}
+
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	var diffs []FieldDiff
+
+	for _, key := range keys {
+		path := key
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, key)
+		}
+
+		beforeValue, inBefore := before[key]
+		afterValue, inAfter := after[key]
+
+		switch {
+		case !inAfter:
+			if beforeValue != nil {
+				diffs = append(diffs, FieldDiff{Path: path, Before: beforeValue})
+			}
+		case !inBefore:
+			if afterValue != nil {
+				diffs = append(diffs, FieldDiff{Path: path, After: afterValue})
+			}
+		case reflect.TypeOf(beforeValue) != reflect.TypeOf(afterValue):
+			diffs = append(diffs, FieldDiff{Path: path, Before: beforeValue, After: afterValue})
+		default:
+			beforeMap, isMap := beforeValue.(map[string]any)
+			if isMap {
+				diffs = append(diffs, diffJSONBodies(path, beforeMap, afterValue.(map[string]any))...)
+
+				continue
+			}
+
+			if !compareJSONFields(beforeValue, afterValue, true) {
+				diffs = append(diffs, FieldDiff{Path: path, Before: beforeValue, After: afterValue})
+			}
+		}
+	}
+
+	return diffs
+}
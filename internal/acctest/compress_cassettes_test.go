@@ -1,12 +1,18 @@
 package acctest_test
 
 import (
+	"net/http"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
 	"golang.org/x/sync/errgroup"
+	cassetteV3 "gopkg.in/dnaeon/go-vcr.v3/cassette"
+	cassetteV4 "gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
 )
 
 func TestAccCassettes_IsCompressed(t *testing.T) {
@@ -72,3 +78,146 @@ func TestAccCassettes_Compress(t *testing.T) {
 		t.Errorf("error: %s", err)
 	}
 }
+
+func TestLoadCassette(t *testing.T) {
+	const (
+		url          = "https://api.scaleway.com/rdb/v1/regions/fr-par/instances"
+		requestBody  = `{"name":"test"}`
+		responseBody = `{"id":"11111111-1111-1111-1111-111111111111","status":"provisioning"}`
+	)
+
+	headers := http.Header{"Content-Type": []string{"application/json"}}
+
+	// The cassettes are saved in the folder of a service using the other go-vcr version,
+	// the version is detected from the content of the cassette.
+	tests := []struct {
+		name   string
+		folder string
+		save   func(path string) error
+	}{
+		{
+			name:   "go-vcr.v3",
+			folder: "instance",
+			save: func(path string) error {
+				c := cassetteV3.New(path)
+				c.AddInteraction(&cassetteV3.Interaction{
+					Request:  cassetteV3.Request{Method: http.MethodPost, URL: url, Body: requestBody, Headers: headers},
+					Response: cassetteV3.Response{Code: http.StatusOK, Status: "200 OK", Body: responseBody, Headers: headers},
+				})
+
+				return c.Save()
+			},
+		},
+		{
+			name:   "go-vcr.v4",
+			folder: "rdb",
+			save: func(path string) error {
+				c := cassetteV4.New(path)
+				c.MarshalFunc = yaml.Marshal
+				c.AddInteraction(&cassetteV4.Interaction{
+					Request:  cassetteV4.Request{Method: http.MethodPost, URL: url, Body: requestBody, Headers: headers},
+					Response: cassetteV4.Response{Code: http.StatusOK, Status: "200 OK", Body: responseBody, Headers: headers},
+				})
+
+				return c.Save()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tt.folder, "testdata", "load.cassette")
+			require.NoError(t, tt.save(path))
+
+			interactions, err := acctest.LoadCassette(path)
+			require.NoError(t, err)
+			require.Len(t, interactions, 1)
+
+			assert.Equal(t, acctest.CassetteInteraction{
+				Method:          http.MethodPost,
+				URL:             url,
+				RequestBody:     requestBody,
+				RequestHeaders:  headers,
+				Code:            http.StatusOK,
+				ResponseBody:    responseBody,
+				ResponseHeaders: headers,
+			}, interactions[0])
+		})
+	}
+}
+
+func TestCompressCassette(t *testing.T) {
+	const url = "https://api.scaleway.com/rdb/v1/regions/fr-par/instances/11111111-1111-1111-1111-111111111111"
+
+	statuses := []string{"provisioning", "provisioning", "provisioning", "ready"}
+
+	// The cassettes are saved in the folder of a service using the other go-vcr version,
+	// the version is detected from the content of the cassette.
+	tests := []struct {
+		name   string
+		folder string
+		save   func(path string) error
+	}{
+		{
+			name:   "go-vcr.v3",
+			folder: "instance",
+			save: func(path string) error {
+				c := cassetteV3.New(path)
+				for _, status := range statuses {
+					c.AddInteraction(&cassetteV3.Interaction{
+						Request:  cassetteV3.Request{Method: http.MethodGet, URL: url},
+						Response: cassetteV3.Response{Code: http.StatusOK, Status: "200 OK", Body: `{"status":"` + status + `"}`},
+					})
+				}
+
+				return c.Save()
+			},
+		},
+		{
+			name:   "go-vcr.v4",
+			folder: "rdb",
+			save: func(path string) error {
+				c := cassetteV4.New(path)
+				c.MarshalFunc = yaml.Marshal
+				for _, status := range statuses {
+					c.AddInteraction(&cassetteV4.Interaction{
+						Request:  cassetteV4.Request{Method: http.MethodGet, URL: url},
+						Response: cassetteV4.Response{Code: http.StatusOK, Status: "200 OK", Body: `{"status":"` + status + `"}`},
+					})
+				}
+
+				return c.Save()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tt.folder, "testdata", "compress.cassette")
+			require.NoError(t, tt.save(path))
+
+			report, err := acctest.CompressCassette(path, false)
+			require.NoError(t, err)
+			assert.Equal(t, 2, report.SkippedInteraction)
+		})
+	}
+
+	t.Run("missing cassette", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "missing.cassette")
+
+		_, err := acctest.CompressCassette(path, false)
+		require.Error(t, err)
+
+		_, err = acctest.CompressCassetteV3(path, false)
+		require.Error(t, err)
+
+		_, err = acctest.CompressCassetteV4(path, false)
+		require.Error(t, err)
+	})
+}
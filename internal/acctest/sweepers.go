@@ -2,17 +2,279 @@ package acctest
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/workerpool"
 )
 
+var flagSweepParallelism = flag.Int("sweep-parallelism", 8, "Number of sweepers, and of localities within a sweeper, run concurrently")
+
+// sweepers are the sweepers registered with AddTestSweepers, by name.
+var sweepers = map[string]*resource.Sweeper{}
+
+// AddTestSweepers registers a sweeper run by TestMain when the -sweep flag is used.
+// The sweepers listed in its dependencies are run before it, like the private networks before their VPC.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, exists := sweepers[name]; exists {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+}
+
+// TestMain runs the registered sweepers when the -sweep flag is used and the tests otherwise.
+// Sweepers are run concurrently, each one waiting for the sweepers it depends on.
+func TestMain(m interface {
+	Run() int
+},
+) {
+	flag.Parse()
+
+	sweep := flag.Lookup("sweep").Value.String()
+	if sweep == "" {
+		os.Exit(m.Run())
+	}
+
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+	selected := filterSweepers(flag.Lookup("sweep-run").Value.String(), sweepers)
+
+	failed := false
+
+	for _, region := range strings.Split(sweep, ",") {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[INFO] Running %d sweepers for region (%s)", len(selected), region)
+
+		results, err := runSweepers(region, selected, *flagSweepParallelism, allowFailures)
+		if err != nil {
+			log.Printf("[ERROR] Cannot run sweepers for region (%s): %s", region, err)
+
+			failed = true
+
+			continue
+		}
+
+		log.Printf("[INFO] Completed sweepers for region (%s) in %s", region, time.Since(start).Round(time.Millisecond))
+
+		for _, result := range results {
+			log.Printf("\t- %s", result)
+
+			if result.err != nil || result.skipped {
+				failed = true
+			}
+		}
+	}
+
+	if *flagSweepDryRun {
+		printDryRunReport(os.Stdout)
+	}
+
+	if failed && !allowFailures {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+type sweeperResult struct {
+	name     string
+	err      error
+	skipped  bool
+	duration time.Duration
+}
+
+func (r sweeperResult) String() string {
+	switch {
+	case r.skipped:
+		return r.name + ": skipped, a dependency failed"
+	case r.err != nil:
+		return fmt.Sprintf("%s: failed in %s: %s", r.name, r.duration.Round(time.Millisecond), r.err)
+	default:
+		return fmt.Sprintf("%s: ok in %s", r.name, r.duration.Round(time.Millisecond))
+	}
+}
+
+// filterSweepers returns the sweepers whose name contains one of the comma separated filters, with all their dependencies.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	selected := map[string]*resource.Sweeper{}
+
+	var add func(name string)
+	add = func(name string) {
+		if _, done := selected[name]; done {
+			return
+		}
+
+		s, exists := source[name]
+		if !exists {
+			return
+		}
+
+		selected[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for f := range strings.SplitSeq(strings.ToLower(filter), ",") {
+			if f != "" && strings.Contains(strings.ToLower(name), f) {
+				add(name)
+			}
+		}
+	}
+
+	return selected
+}
+
+// sweeperDependencies returns, for each sweeper, the sweepers depending on it and the number of dependencies it waits for.
+// Dependencies on sweepers which are not registered are ignored, and an error is returned if the dependencies form a cycle.
+func sweeperDependencies(toRun map[string]*resource.Sweeper) (map[string][]string, map[string]int, error) {
+	dependents := map[string][]string{}
+	waiting := map[string]int{}
+
+	for name, s := range toRun {
+		waiting[name] = 0
+
+		for _, dependency := range s.Dependencies {
+			if _, exists := toRun[dependency]; !exists {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+
+				continue
+			}
+
+			dependents[dependency] = append(dependents[dependency], name)
+			waiting[name]++
+		}
+	}
+
+	// Check that every sweeper can be scheduled before running any of them
+	remaining := make(map[string]int, len(waiting))
+	ready := []string(nil)
+
+	for name, count := range waiting {
+		remaining[name] = count
+		if count == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	for len(ready) > 0 {
+		name := ready[0]
+		ready = ready[1:]
+
+		delete(remaining, name)
+
+		for _, dependent := range dependents[name] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(remaining) > 0 {
+		cycle := make([]string, 0, len(remaining))
+		for name := range remaining {
+			cycle = append(cycle, name)
+		}
+
+		slices.Sort(cycle)
+
+		return nil, nil, fmt.Errorf("dependency cycle between sweepers %s", strings.Join(cycle, ", "))
+	}
+
+	return dependents, waiting, nil
+}
+
+// runSweepers runs the sweepers concurrently, each one starting once all its dependencies are done.
+// The dependents of a failed sweeper are skipped unless failures are allowed.
+// Results are sorted by sweeper name.
+func runSweepers(region string, toRun map[string]*resource.Sweeper, parallelism int, allowFailures bool) ([]sweeperResult, error) {
+	dependents, waiting, err := sweeperDependencies(toRun)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := workerpool.NewWorkerPool(max(parallelism, 1))
+	done := make(chan sweeperResult, len(toRun))
+	blocked := map[string]bool{}
+
+	start := func(name string) {
+		if blocked[name] {
+			done <- sweeperResult{name: name, skipped: true}
+
+			return
+		}
+
+		pool.AddTask(func() error {
+			started := time.Now()
+			log.Printf("[DEBUG] Running sweeper (%s) for region (%s)", name, region)
+
+			err := toRun[name].F(region)
+			done <- sweeperResult{name: name, err: err, duration: time.Since(started)}
+
+			return nil
+		})
+	}
+
+	for name, count := range waiting {
+		if count == 0 {
+			start(name)
+		}
+	}
+
+	results := make([]sweeperResult, 0, len(toRun))
+
+	for range toRun {
+		result := <-done
+		results = append(results, result)
+
+		for _, dependent := range dependents[result.name] {
+			if (result.err != nil || result.skipped) && !allowFailures {
+				blocked[dependent] = true
+			}
+
+			waiting[dependent]--
+			if waiting[dependent] == 0 {
+				start(dependent)
+			}
+		}
+	}
+
+	pool.CloseAndWait()
+
+	slices.SortFunc(results, func(a, b sweeperResult) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	return results, nil
+}
+
 func Sweep(f func(scwClient *scw.Client) error) error {
 	ctx := context.Background()
 
 	m, err := meta.NewMeta(ctx, &meta.Config{
 		TerraformVersion: "terraform-tests",
+		HTTPClient:       sweeperHTTPClient(),
 	})
 	if err != nil {
 		return err
@@ -21,20 +283,28 @@ func Sweep(f func(scwClient *scw.Client) error) error {
 	return f(m.ScwClient())
 }
 
+// SweepZones runs a sweeper function concurrently in each zone.
+// The errors of the sweeper function are logged and ignored so that the other zones and sweepers are still run.
 func SweepZones(zones []scw.Zone, f func(scwClient *scw.Client, zone scw.Zone) error) error {
+	pool := workerpool.NewWorkerPool(max(*flagSweepParallelism, 1))
+
 	for _, zone := range zones {
-		client, err := sharedClientForZone(zone)
-		if err != nil {
-			return err
-		}
+		pool.AddTask(func() error {
+			client, err := sharedClientForZone(zone)
+			if err != nil {
+				return fmt.Errorf("zone %s: %w", zone, err)
+			}
 
-		err = f(client, zone)
-		if err != nil {
-			logging.L.Warningf("error running sweepZones, ignoring: %s", err)
-		}
+			err = f(client, zone)
+			if err != nil {
+				logging.L.Warningf("error running sweepZones in zone %s, ignoring: %s", zone, err)
+			}
+
+			return nil
+		})
 	}
 
-	return nil
+	return errors.Join(pool.CloseAndWait()...)
 }
 
 func SweepRegions(regions []scw.Region, f func(scwClient *scw.Client, region scw.Region) error) error {
@@ -58,6 +328,7 @@ func sharedClientForZone(zone scw.Zone) (*scw.Client, error) {
 	m, err := meta.NewMeta(ctx, &meta.Config{
 		TerraformVersion: "terraform-tests",
 		ForceZone:        zone,
+		HTTPClient:       sweeperHTTPClient(),
 	})
 	if err != nil {
 		return nil, err
@@ -65,3 +336,15 @@ func sharedClientForZone(zone scw.Zone) (*scw.Client, error) {
 
 	return m.ScwClient(), nil
 }
+
+// sweeperHTTPClient returns the HTTP client of the sweepers, filtering the listed resources when the age or tag filters
+// or the dry-run mode are used.
+func sweeperHTTPClient() *http.Client {
+	rt := transport.NewRetryableTransport(http.DefaultTransport)
+
+	if sweepFiltersEnabled() {
+		rt = &sweeperTransport{next: rt}
+	}
+
+	return &http.Client{Transport: rt}
+}
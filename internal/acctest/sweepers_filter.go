@@ -0,0 +1,398 @@
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+var (
+	flagSweepDryRun    = flag.Bool("sweep-dry-run", false, "List the resources the sweepers would delete without deleting them")
+	flagSweepOlderThan = flag.Duration("sweep-older-than", 0, "Only sweep the resources created more than this duration ago, like 24h")
+	flagSweepTags      = flag.String("sweep-tags", "", "Comma separated list of tags, only sweep the resources with at least one of them")
+)
+
+// sweepMaxPages bounds the number of pages fetched by the sweeper transport for a single list.
+const sweepMaxPages = 1000
+
+// dryRunReport is the list of what the sweepers would have done if the dry-run mode was not enabled.
+var dryRunReport = struct {
+	sync.Mutex
+	resources []sweepCandidate
+	requests  []string
+}{}
+
+type sweepCandidate struct {
+	kind      string
+	locality  string
+	id        string
+	name      string
+	createdAt string
+}
+
+func sweepFiltersEnabled() bool {
+	return *flagSweepDryRun || *flagSweepOlderThan > 0 || *flagSweepTags != ""
+}
+
+// ShouldSweep tells if a sweeper should delete a resource listed without the Scaleway client, like a S3 bucket.
+// It applies the age and tag filters and returns false in dry-run mode, after adding the resource to the report.
+// createdAt is nil if the creation date of the resource is unknown.
+func ShouldSweep(kind string, locality string, name string, createdAt *time.Time, tags []string) bool {
+	created := ""
+	if createdAt != nil {
+		created = createdAt.Format(time.RFC3339)
+	}
+
+	if !matchesSweepFilters(created, tags) {
+		return false
+	}
+
+	if *flagSweepDryRun {
+		addDryRunResource(sweepCandidate{kind: kind, locality: locality, id: name, name: name, createdAt: created})
+
+		return false
+	}
+
+	return true
+}
+
+// matchesSweepFilters tells if a resource passes the age and tag filters.
+// A resource without creation date is never swept when the age filter is used.
+func matchesSweepFilters(createdAt string, tags []string) bool {
+	if *flagSweepOlderThan > 0 {
+		created, err := time.Parse(time.RFC3339, createdAt)
+		if err != nil || time.Since(created) < *flagSweepOlderThan {
+			return false
+		}
+	}
+
+	if *flagSweepTags != "" {
+		for tag := range strings.SplitSeq(*flagSweepTags, ",") {
+			if slices.Contains(tags, strings.TrimSpace(tag)) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func addDryRunResource(candidate sweepCandidate) {
+	dryRunReport.Lock()
+	defer dryRunReport.Unlock()
+
+	dryRunReport.resources = append(dryRunReport.resources, candidate)
+}
+
+func printDryRunReport(w io.Writer) {
+	dryRunReport.Lock()
+	defer dryRunReport.Unlock()
+
+	slices.SortFunc(dryRunReport.resources, func(a, b sweepCandidate) int {
+		return strings.Compare(a.kind+a.locality+a.name+a.id, b.kind+b.locality+b.name+b.id)
+	})
+
+	_, _ = fmt.Fprintf(w, "\nSweeper dry-run: %d resources would be deleted\n\n", len(dryRunReport.resources))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TYPE\tLOCALITY\tID\tNAME\tCREATED AT")
+
+	for _, r := range dryRunReport.resources {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", r.kind, r.locality, r.id, r.name, r.createdAt)
+	}
+
+	_ = tw.Flush()
+
+	if len(dryRunReport.requests) > 0 {
+		_, _ = fmt.Fprintf(w, "\nRequests not sent:\n")
+
+		for _, r := range dryRunReport.requests {
+			_, _ = fmt.Fprintf(w, "\t- %s\n", r)
+		}
+	}
+}
+
+// sweeperTransport filters the list responses of the Scaleway APIs so the sweepers only see the resources matching the
+// age and tag filters. All the pages of a list are fetched and returned as a single page.
+// Only the lists of top-level resources are filtered, the children of a resource are deleted with it.
+// In dry-run mode, the matching resources are added to the report and lists are returned empty, other requests than GET
+// are not sent.
+type sweeperTransport struct {
+	next http.RoundTripper
+}
+
+func (t *sweeperTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		if *flagSweepDryRun {
+			dryRunReport.Lock()
+			dryRunReport.requests = append(dryRunReport.requests, req.Method+" "+req.URL.Path)
+			dryRunReport.Unlock()
+
+			return nil, fmt.Errorf("sweeper dry-run: %s %s not sent", req.Method, req.URL.Path)
+		}
+
+		return t.next.RoundTrip(req)
+	}
+
+	if !isTopLevelList(req.URL.Path) {
+		return t.next.RoundTrip(req)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	page, err := readListPage(resp)
+	if err != nil || page == nil {
+		return resp, err
+	}
+
+	items := page.items
+
+	// Pages after the first one are already returned with the first page
+	if p, _ := strconv.Atoi(req.URL.Query().Get("page")); p > 1 {
+		items = nil
+	} else {
+		items, err = t.fetchNextPages(req, page)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	kept := []json.RawMessage{}
+
+	for _, raw := range items {
+		var item listItem
+		if err := json.Unmarshal(raw, &item); err != nil || !matchesSweepFilters(item.createdAt(), item.tags()) {
+			continue
+		}
+
+		if *flagSweepDryRun {
+			addDryRunResource(sweepCandidate{
+				kind:      listKind(req.URL.Path),
+				locality:  listLocality(req.URL.Path, item),
+				id:        item.ID,
+				name:      item.Name,
+				createdAt: item.createdAt(),
+			})
+
+			continue
+		}
+
+		kept = append(kept, raw)
+	}
+
+	return page.response(resp, kept)
+}
+
+// fetchNextPages returns the items of the given page and of the pages after it.
+func (t *sweeperTransport) fetchNextPages(req *http.Request, first *listPage) ([]json.RawMessage, error) {
+	items := first.items
+
+	for p := 2; len(items) < first.totalCount && p <= sweepMaxPages; p++ {
+		next := req.Clone(req.Context())
+		query := next.URL.Query()
+		query.Set("page", strconv.Itoa(p))
+		next.URL.RawQuery = query.Encode()
+
+		resp, err := t.next.RoundTrip(next)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			_ = resp.Body.Close()
+
+			return nil, fmt.Errorf("sweeper: listing page %d of %s: unexpected status %s", p, req.URL.Path, resp.Status)
+		}
+
+		page, err := readListPage(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil || len(page.items) == 0 {
+			break
+		}
+
+		items = append(items, page.items...)
+	}
+
+	return items, nil
+}
+
+type listItem struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+	// CreationDate is the creation date of the instance servers, volumes, snapshots and images.
+	CreationDate string          `json:"creation_date"`
+	Tags         json.RawMessage `json:"tags"`
+	Region       string          `json:"region"`
+	Zone         string          `json:"zone"`
+}
+
+// createdAt returns the creation date of a listed item, or an empty string if it is unknown.
+func (i listItem) createdAt() string {
+	if i.CreatedAt != "" {
+		return i.CreatedAt
+	}
+
+	return i.CreationDate
+}
+
+// tags returns the tags of a listed item, or nil if they are not a list of strings.
+func (i listItem) tags() []string {
+	var tags []string
+
+	_ = json.Unmarshal(i.Tags, &tags)
+
+	return tags
+}
+
+// listPage is a page of a list response: a JSON object with a single array of objects with an ID.
+type listPage struct {
+	fields     map[string]json.RawMessage
+	key        string
+	items      []json.RawMessage
+	totalCount int
+}
+
+// readListPage reads the body of a response and returns the page it contains, or nil if it is not a list response.
+// The body of the response is restored so it can still be read.
+func readListPage(resp *http.Response) (*listPage, error) {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, nil //nolint:nilerr // not a JSON object, so not a list
+	}
+
+	page := &listPage{fields: fields}
+
+	for key, value := range fields {
+		var items []json.RawMessage
+		if err := json.Unmarshal(value, &items); err != nil || len(items) == 0 {
+			continue
+		}
+
+		var item map[string]json.RawMessage
+		if err := json.Unmarshal(items[0], &item); err != nil || item["id"] == nil {
+			continue
+		}
+
+		if page.key != "" {
+			return nil, nil
+		}
+
+		page.key = key
+		page.items = items
+	}
+
+	if page.key == "" {
+		return nil, nil
+	}
+
+	page.totalCount = len(page.items)
+
+	if raw, ok := fields["total_count"]; ok {
+		_ = json.Unmarshal(raw, &page.totalCount)
+	} else if header := resp.Header.Get("X-Total-Count"); header != "" {
+		page.totalCount, _ = strconv.Atoi(header)
+	}
+
+	return page, nil
+}
+
+// response replaces the items of the page in the response with the given ones.
+func (p *listPage) response(resp *http.Response, items []json.RawMessage) (*http.Response, error) {
+	rawItems, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	p.fields[p.key] = rawItems
+
+	if _, ok := p.fields["total_count"]; ok {
+		p.fields["total_count"] = json.RawMessage(strconv.Itoa(len(items)))
+	}
+
+	body, err := json.Marshal(p.fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Header.Get("X-Total-Count") != "" {
+		resp.Header.Set("X-Total-Count", strconv.Itoa(len(items)))
+	}
+
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	resp.ContentLength = int64(len(body))
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+// isTopLevelList tells if a path lists top-level resources, like /vpc/v2/regions/fr-par/private-networks, and not the
+// children of a resource, like /instance/v1/zones/fr-par-1/security_groups/{id}/rules.
+func isTopLevelList(path string) bool {
+	// Skip the API name and version, then the locality
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	segments = segments[min(2, len(segments)):]
+
+	if len(segments) > 2 && (segments[0] == "zones" || segments[0] == "regions") {
+		segments = segments[2:]
+	}
+
+	return len(segments) == 1
+}
+
+// listKind returns the API and the type of resource of a list path, like "vpc private-networks" for
+// /vpc/v2/regions/fr-par/private-networks.
+func listKind(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return path
+	}
+
+	return segments[0] + " " + segments[len(segments)-1]
+}
+
+// listLocality returns the locality of a listed item, or the one of the list path if the item does not have one.
+func listLocality(path string, item listItem) string {
+	if item.Zone != "" {
+		return item.Zone
+	}
+
+	if item.Region != "" {
+		return item.Region
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if (segment == "zones" || segment == "regions") && i+1 < len(segments) {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
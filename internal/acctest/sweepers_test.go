@@ -0,0 +1,221 @@
+//nolint:testpackage // Tests need access to the unexported sweeper runner and transport.
+package acctest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunSweepers(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		ran []string
+	)
+
+	sweeper := func(name string, err error, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(_ string) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				ran = append(ran, name)
+
+				return err
+			},
+		}
+	}
+
+	toRun := map[string]*resource.Sweeper{
+		"vpc":             sweeper("vpc", nil, "private_network", "connector"),
+		"private_network": sweeper("private_network", nil, "ipam_ip"),
+		"ipam_ip":         sweeper("ipam_ip", nil),
+		"connector":       sweeper("connector", errors.New("boom")),
+		"unknown_dep":     sweeper("unknown_dep", nil, "not_registered"),
+	}
+
+	results, err := runSweepers("all_regions", toRun, 4, false)
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	assert.Equal(t, "connector", results[0].name)
+	require.Error(t, results[0].err)
+	assert.Equal(t, "vpc", results[4].name)
+	assert.True(t, results[4].skipped)
+
+	assert.NotContains(t, ran, "vpc")
+	assert.Contains(t, ran, "unknown_dep")
+	assert.Less(t, slices.Index(ran, "ipam_ip"), slices.Index(ran, "private_network"))
+
+	ran = nil
+
+	results, err = runSweepers("all_regions", toRun, 4, true)
+	require.NoError(t, err)
+	assert.False(t, results[4].skipped)
+	assert.Equal(t, "vpc", ran[len(ran)-1])
+}
+
+func TestRunSweepersCycle(t *testing.T) {
+	noop := func(_ string) error { return nil }
+
+	_, err := runSweepers("all_regions", map[string]*resource.Sweeper{
+		"a": {Name: "a", F: noop, Dependencies: []string{"b"}},
+		"b": {Name: "b", F: noop, Dependencies: []string{"a"}},
+		"c": {Name: "c", F: noop},
+	}, 2, false)
+	require.ErrorContains(t, err, "dependency cycle between sweepers a, b")
+}
+
+func TestFilterSweepers(t *testing.T) {
+	source := map[string]*resource.Sweeper{
+		"scaleway_vpc":                 {Name: "scaleway_vpc", Dependencies: []string{"scaleway_vpc_private_network"}},
+		"scaleway_vpc_private_network": {Name: "scaleway_vpc_private_network", Dependencies: []string{"scaleway_ipam_ip"}},
+		"scaleway_ipam_ip":             {Name: "scaleway_ipam_ip"},
+		"scaleway_instance_server":     {Name: "scaleway_instance_server"},
+	}
+
+	assert.Len(t, filterSweepers("", source), 4)
+	assert.Len(t, filterSweepers("vpc_private", source), 2)
+	assert.Len(t, filterSweepers("instance,ipam", source), 2)
+}
+
+func TestSweeperTransport(t *testing.T) {
+	now := time.Now()
+	servers := make([]map[string]any, 0, 5)
+
+	for i := range 5 {
+		server := map[string]any{
+			"id":   strconv.Itoa(i),
+			"name": fmt.Sprintf("tf-test-%d", i),
+			"tags": []string{"terraform-test", fmt.Sprintf("tag-%d", i%2)},
+		}
+
+		createdAt := now.Add(-time.Duration(i) * 24 * time.Hour)
+		if i%2 == 0 {
+			server["created_at"] = createdAt.Format(time.RFC3339)
+		} else {
+			// Like the instance servers, volumes, snapshots and images
+			server["creation_date"] = createdAt.UTC().Format("2006-01-02T15:04:05.000000+00:00")
+		}
+
+		servers = append(servers, server)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+
+		start := min((page-1)*2, len(servers))
+		end := min(start+2, len(servers))
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"servers":     servers[start:end],
+			"total_count": len(servers),
+		})
+	}))
+	defer server.Close()
+
+	setFlags := func(dryRun bool, olderThan time.Duration, tags string) {
+		*flagSweepDryRun, *flagSweepOlderThan, *flagSweepTags = dryRun, olderThan, tags
+		dryRunReport.resources, dryRunReport.requests = nil, nil
+	}
+	defer setFlags(false, 0, "")
+
+	list := func(t *testing.T, path string) []string {
+		t.Helper()
+
+		client := &http.Client{Transport: &sweeperTransport{next: http.DefaultTransport}}
+
+		resp, err := client.Get(server.URL + path)
+		require.NoError(t, err)
+
+		defer resp.Body.Close()
+
+		body := struct {
+			Servers []struct {
+				ID string `json:"id"`
+			} `json:"servers"`
+			TotalCount int `json:"total_count"`
+		}{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+
+		ids := make([]string, 0, len(body.Servers))
+		for _, s := range body.Servers {
+			ids = append(ids, s.ID)
+		}
+
+		if isTopLevelList(resp.Request.URL.Path) {
+			assert.Equal(t, len(ids), body.TotalCount)
+		}
+
+		return ids
+	}
+
+	const serversPath = "/instance/v1/zones/fr-par-1/servers?page=1"
+
+	setFlags(false, 0, "")
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, list(t, serversPath))
+
+	setFlags(false, 36*time.Hour, "")
+	assert.Equal(t, []string{"2", "3", "4"}, list(t, serversPath))
+
+	setFlags(false, 36*time.Hour, "tag-1,other")
+	assert.Equal(t, []string{"3"}, list(t, serversPath))
+
+	setFlags(true, 36*time.Hour, "")
+	assert.Empty(t, list(t, serversPath))
+	require.Len(t, dryRunReport.resources, 3)
+	assert.Equal(t, sweepCandidate{
+		kind:      "instance servers",
+		locality:  "fr-par-1",
+		id:        "2",
+		name:      "tf-test-2",
+		createdAt: servers[2]["created_at"].(string),
+	}, dryRunReport.resources[0])
+	assert.Equal(t, servers[3]["creation_date"], dryRunReport.resources[1].createdAt)
+
+	// The children of a resource are not filtered
+	setFlags(true, 36*time.Hour, "")
+	assert.Equal(t, []string{"0", "1"}, list(t, "/instance/v1/zones/fr-par-1/servers/2/private_nics"))
+	assert.Empty(t, dryRunReport.resources)
+
+	req, err := http.NewRequest(http.MethodDelete, server.URL+"/instance/v1/zones/fr-par-1/servers/2", nil)
+	require.NoError(t, err)
+
+	_, err = (&sweeperTransport{next: http.DefaultTransport}).RoundTrip(req) //nolint:bodyclose // no response in dry-run mode
+	require.ErrorContains(t, err, "sweeper dry-run")
+}
+
+func TestIsTopLevelList(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/instance/v1/zones/fr-par-1/servers", true},
+		{"/vpc/v2/regions/fr-par/private-networks/", true},
+		{"/account/v3/projects", true},
+		{"/instance/v1/zones/fr-par-1/security_groups/11111111-1111-1111-1111-111111111111/rules", false},
+		{"/domain/v2beta1/dns-zones/example.com/records", false},
+		{"/k8s/v1/regions/fr-par/clusters/11111111-1111-1111-1111-111111111111/pools", false},
+		{"/instance/v1/zones/fr-par-1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTopLevelList(tt.path))
+		})
+	}
+}
@@ -27,6 +27,9 @@ import (
 // UpdateCassettes will update all cassettes of a given test
 var UpdateCassettes = flag.Bool("cassettes", os.Getenv(env.UpdateCassettes) == "true", "Record Cassettes")
 
+// HybridCassettes will replay the interactions matching the cassettes of a given test and record the others
+var HybridCassettes = flag.Bool("cassettes-hybrid", os.Getenv(env.HybridCassettes) == "true", "Replay matching interactions and record the others")
+
 // QueryMatcherIgnore contains the list of query value that should be ignored when matching requests with cassettes
 var QueryMatcherIgnore = []string{
 	"organization_id",
@@ -217,12 +220,25 @@ func CassetteMatcher(request *http.Request, cassette cassette.Request) bool {
 }
 
 func cassetteSensitiveFieldsAnonymizer(i *cassette.Interaction) error {
+	anonymizedBody, err := anonymizeSensitiveFields(i.Response.Body)
+	if err != nil {
+		return err
+	}
+
+	i.Response.Body = anonymizedBody
+
+	return nil
+}
+
+// anonymizeSensitiveFields replaces the values of the sensitive fields of a JSON response body with fixed values.
+// Bodies which are not JSON objects are returned as is.
+func anonymizeSensitiveFields(body string) (string, error) {
 	var jsonBody map[string]any
 
-	err := json.Unmarshal([]byte(i.Response.Body), &jsonBody)
+	err := json.Unmarshal([]byte(body), &jsonBody)
 	if err != nil {
 		//nolint:nilerr
-		return nil
+		return body, nil
 	}
 
 	for key, value := range SensitiveFields {
@@ -233,12 +249,10 @@ func cassetteSensitiveFieldsAnonymizer(i *cassette.Interaction) error {
 
 	anonymizedBody, err := json.Marshal(jsonBody)
 	if err != nil {
-		return fmt.Errorf("failed to marshal anonymized body: %w", err)
+		return "", fmt.Errorf("failed to marshal anonymized body: %w", err)
 	}
 
-	i.Response.Body = string(anonymizedBody)
-
-	return nil
+	return string(anonymizedBody), nil
 }
 
 // getHTTPRecoder creates a new httpClient that records all HTTP requests in a cassette.
@@ -259,6 +273,21 @@ func getHTTPRecoder(t *testing.T, pkgFolder string, update bool) (client *http.C
 	_, errorCassette := os.Stat(cassetteFilePath + ".yaml")
 	logging.L.Debugf("using %s.yaml", cassetteFilePath)
 
+	// In hybrid mode, the recorder records both the interactions replayed from the previous cassette and the new ones
+	var hybrid *hybridTransport
+
+	realTransport := http.DefaultTransport
+
+	if !update && *HybridCassettes {
+		hybrid, err = loadHybridTransport(cassetteFilePath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		realTransport = hybrid
+		recorderMode = recorder.ModeRecordOnly
+	}
+
 	// If in record mode we check that the cassette exists
 	if recorderMode == recorder.ModeReplayOnly && errorCassette != nil {
 		return nil, nil, fmt.Errorf("cannot stat file %s.yaml while in replay mode", cassetteFilePath)
@@ -268,6 +297,7 @@ func getHTTPRecoder(t *testing.T, pkgFolder string, update bool) (client *http.C
 	r, err := recorder.NewWithOptions(&recorder.Options{
 		CassetteName:       getTestFilePath(t, pkgFolder, ".cassette"),
 		Mode:               recorderMode,
+		RealTransport:      realTransport,
 		SkipRequestLatency: true,
 	})
 	if err != nil {
@@ -294,11 +324,15 @@ func getHTTPRecoder(t *testing.T, pkgFolder string, update bool) (client *http.C
 	r.AddHook(cassetteSensitiveFieldsAnonymizer, recorder.BeforeSaveHook)
 
 	retryOptions := transport.RetryableTransportOptions{}
-	if !*UpdateCassettes {
+	if !*UpdateCassettes && !*HybridCassettes {
 		retryOptions.RetryWaitMax = new(time.Duration(0))
 	}
 
 	return &http.Client{Transport: transport.NewRetryableTransportWithOptions(r, retryOptions)}, func() {
 		require.NoError(t, r.Stop()) // Make sure recorder is stopped once done with it
+
+		if hybrid != nil {
+			t.Logf("hybrid cassette %s.yaml: %s", cassetteFilePath, hybrid.report())
+		}
 	}, nil
 }
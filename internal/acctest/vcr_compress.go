@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
 
 	applesilicon "github.com/scaleway/scaleway-sdk-go/api/applesilicon/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
@@ -117,6 +119,104 @@ var transientStates = map[string]bool{
 	tem.DomainStatusPending.String(): true,
 }
 
+// IsTransientState returns true if a resource status is a transition that waiters poll until it ends.
+func IsTransientState(status string) bool {
+	return transientStates[status]
+}
+
+// CassetteInteraction is an interaction of a go-vcr.v3 or go-vcr.v4 cassette.
+type CassetteInteraction struct {
+	Method          string
+	URL             string
+	RequestBody     string
+	RequestHeaders  http.Header
+	Code            int
+	ResponseBody    string
+	ResponseHeaders http.Header
+}
+
+// cassetteRequestKeys holds the keys of the requests of a cassette.
+type cassetteRequestKeys struct {
+	Interactions []struct {
+		Request map[string]any `yaml:"request"`
+	} `yaml:"interactions"`
+}
+
+// cassetteUsesVCRv4 tells from its content whether a cassette was written by go-vcr.v4.
+// go-vcr.v3 writes all the fields of the requests while go-vcr.v4 omits the empty ones, like request_uri.
+func cassetteUsesVCRv4(content []byte) (bool, error) {
+	keys := cassetteRequestKeys{}
+
+	err := yaml.Unmarshal(content, &keys)
+	if err != nil {
+		return false, err
+	}
+
+	for _, i := range keys.Interactions {
+		if _, hasRequestURI := i.Request["request_uri"]; hasRequestURI {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// LoadCassette reads the cassette at the given path, without the .yaml extension.
+// It uses the version of go-vcr which wrote the cassette, whatever the service folder of the cassette is.
+func LoadCassette(path string) ([]CassetteInteraction, error) {
+	content, err := os.ReadFile(path + ".yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	usesVCRv4, err := cassetteUsesVCRv4(content)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s.yaml: %w", path, err)
+	}
+
+	if usesVCRv4 {
+		c, err := cassetteV4.Load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		interactions := make([]CassetteInteraction, 0, len(c.Interactions))
+		for _, i := range c.Interactions {
+			interactions = append(interactions, CassetteInteraction{
+				Method:          i.Request.Method,
+				URL:             i.Request.URL,
+				RequestBody:     i.Request.Body,
+				RequestHeaders:  i.Request.Headers,
+				Code:            i.Response.Code,
+				ResponseBody:    i.Response.Body,
+				ResponseHeaders: i.Response.Headers,
+			})
+		}
+
+		return interactions, nil
+	}
+
+	c, err := cassetteV3.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	interactions := make([]CassetteInteraction, 0, len(c.Interactions))
+	for _, i := range c.Interactions {
+		interactions = append(interactions, CassetteInteraction{
+			Method:          i.Request.Method,
+			URL:             i.Request.URL,
+			RequestBody:     i.Request.Body,
+			RequestHeaders:  i.Request.Headers,
+			Code:            i.Response.Code,
+			ResponseBody:    i.Response.Body,
+			ResponseHeaders: i.Response.Headers,
+		})
+	}
+
+	return interactions, nil
+}
+
 type CompressReport struct {
 	Path               string
 	Logs               []string
@@ -141,13 +241,33 @@ func (report *CompressReport) Print() {
 	}
 }
 
+// CompressCassette compresses the cassette at the given path, without the .yaml extension, like CompressCassetteV3 and
+// CompressCassetteV4. It uses the version of go-vcr which wrote the cassette, whatever the service folder of the cassette is.
+func CompressCassette(path string, saveCompressed bool) (CompressReport, error) {
+	content, err := os.ReadFile(path + ".yaml")
+	if err != nil {
+		return CompressReport{}, fmt.Errorf("error while reading file: %w", err)
+	}
+
+	usesVCRv4, err := cassetteUsesVCRv4(content)
+	if err != nil {
+		return CompressReport{}, fmt.Errorf("cannot parse %s.yaml: %w", path, err)
+	}
+
+	if usesVCRv4 {
+		return CompressCassetteV4(path, saveCompressed)
+	}
+
+	return CompressCassetteV3(path, saveCompressed)
+}
+
 // CompressCassetteV3 reads the input go-vcr.v3 cassette at the given path and looks for skippable interactions.
 // It writes a compression report and a new compressed cassette. If saveCompressed is set to true, the new cassette will
 // be saved at the same path, therefore modifying the input file.
 func CompressCassetteV3(path string, saveCompressed bool) (CompressReport, error) {
 	inputCassette, err := cassetteV3.Load(path)
 	if err != nil {
-		log.Fatalf("Error while reading file : %v\n", err)
+		return CompressReport{}, fmt.Errorf("error while reading file: %w", err)
 	}
 
 	outputCassette := cassetteV3.New(path)
@@ -240,7 +360,7 @@ func CompressCassetteV3(path string, saveCompressed bool) (CompressReport, error
 func CompressCassetteV4(path string, saveCompressed bool) (CompressReport, error) {
 	inputCassette, err := cassetteV4.Load(path)
 	if err != nil {
-		log.Fatalf("Error while reading file : %v\n", err)
+		return CompressReport{}, fmt.Errorf("error while reading file: %w", err)
 	}
 
 	outputCassette := cassetteV4.New(path)
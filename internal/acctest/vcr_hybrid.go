@@ -0,0 +1,239 @@
+package acctest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/env"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	cassettev4 "gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	recorderv4 "gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// hybridTransport replays the interactions of an existing cassette which match the requests
+// and sends the other requests to the network, or to the endpoint when it is set.
+// It is used as the real transport of a recorder, so the new cassette contains both.
+type hybridTransport struct {
+	previous *cassette.Cassette
+	endpoint *url.URL
+	network  http.RoundTripper
+
+	mu sync.Mutex
+	// replayed is true for the interactions of the previous cassette which were replayed.
+	replayed []bool
+	// requests lists the requests in the order they were sent, with the index of the replayed interaction or -1.
+	requests []hybridRequest
+}
+
+type hybridRequest struct {
+	previousIndex int
+	method        string
+	url           string
+	status        string
+}
+
+func newHybridTransport(previous *cassette.Cassette, endpoint *url.URL) *hybridTransport {
+	return &hybridTransport{
+		previous: previous,
+		endpoint: endpoint,
+		network:  http.DefaultTransport,
+		replayed: make([]bool, len(previous.Interactions)),
+	}
+}
+
+func (h *hybridTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if i := h.match(req); i != nil {
+		return h.replay(req, i), nil
+	}
+
+	forwarded := req.Clone(req.Context())
+	if h.endpoint != nil {
+		// Keep the original host so that the mock server knows which API is called
+		forwarded.Host = req.URL.Host
+		forwarded.URL.Scheme = h.endpoint.Scheme
+		forwarded.URL.Host = h.endpoint.Host
+	}
+
+	resp, err := h.network.RoundTrip(forwarded)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	h.requests = append(h.requests, hybridRequest{
+		previousIndex: -1,
+		method:        req.Method,
+		url:           req.URL.String(),
+		status:        resp.Status,
+	})
+	h.mu.Unlock()
+
+	return resp, nil
+}
+
+// match returns the first interaction of the previous cassette which was not replayed yet and matches the request.
+func (h *hybridTransport) match(req *http.Request) *cassette.Interaction {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for index, i := range h.previous.Interactions {
+		// CassetteMatcher removes the ignored query values from the request URL
+		if h.replayed[index] || !CassetteMatcher(req.Clone(req.Context()), i.Request) {
+			continue
+		}
+
+		h.replayed[index] = true
+		h.requests = append(h.requests, hybridRequest{
+			previousIndex: index,
+			method:        req.Method,
+			url:           req.URL.String(),
+			status:        i.Response.Status,
+		})
+
+		return i
+	}
+
+	return nil
+}
+
+func (h *hybridTransport) replay(req *http.Request, i *cassette.Interaction) *http.Response {
+	// The recorder captures the request body while it is read
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		_ = req.Body.Close()
+	}
+
+	return &http.Response{
+		Status:           i.Response.Status,
+		StatusCode:       i.Response.Code,
+		Proto:            i.Response.Proto,
+		ProtoMajor:       i.Response.ProtoMajor,
+		ProtoMinor:       i.Response.ProtoMinor,
+		TransferEncoding: i.Response.TransferEncoding,
+		Trailer:          i.Response.Trailer,
+		ContentLength:    i.Response.ContentLength,
+		Uncompressed:     i.Response.Uncompressed,
+		Header:           i.Response.Headers.Clone(),
+		Body:             io.NopCloser(bytes.NewBufferString(i.Response.Body)),
+		Request:          req,
+	}
+}
+
+// report describes the interactions which were added to or dropped from the cassette.
+func (h *hybridTransport) report() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	added := []string(nil)
+	replayed := 0
+
+	for index, req := range h.requests {
+		if req.previousIndex >= 0 {
+			replayed++
+
+			continue
+		}
+
+		added = append(added, fmt.Sprintf("+ [%d] %s %s %s", index+1, req.method, req.url, req.status))
+	}
+
+	dropped := []string(nil)
+
+	for index, i := range h.previous.Interactions {
+		if !h.replayed[index] {
+			dropped = append(dropped, fmt.Sprintf("- [%d] %s %s %s", index+1, i.Request.Method, i.Request.URL, i.Response.Status))
+		}
+	}
+
+	report := fmt.Sprintf("%d replayed, %d added, %d dropped", replayed, len(added), len(dropped))
+	for _, line := range append(added, dropped...) {
+		report += "\n" + line
+	}
+
+	return report
+}
+
+// hybridEndpoint returns the endpoint receiving the requests which do not match the cassette, if it is configured.
+func hybridEndpoint() (*url.URL, error) {
+	rawEndpoint := os.Getenv(env.HybridCassettesEndpoint)
+	if rawEndpoint == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	endpoint, err := url.Parse(rawEndpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid %s %q: expected a URL like http://localhost:8080", env.HybridCassettesEndpoint, rawEndpoint)
+	}
+
+	return endpoint, nil
+}
+
+// loadHybridTransport loads the cassette which is replayed in hybrid mode.
+// go-vcr v3 and v4 share the same cassette format, so the cassettes of both versions are loaded with v3.
+func loadHybridTransport(cassetteFilePath string) (*hybridTransport, error) {
+	previous, err := cassette.Load(cassetteFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load %s.yaml while in hybrid mode, record the test first: %w", cassetteFilePath, err)
+	}
+
+	endpoint, err := hybridEndpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	return newHybridTransport(previous, endpoint), nil
+}
+
+// newHybridRecordedClient is the hybrid mode of NewRecordedClient, for the folders using go-vcr v4.
+func newHybridRecordedClient(t *testing.T, pkgFolder string) (client *http.Client, cleanup func(), err error) {
+	t.Helper()
+
+	cassetteFilePath := getTestFilePath(t, pkgFolder, ".cassette")
+
+	hybrid, err := loadHybridTransport(cassetteFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r, err := recorderv4.New(cassetteFilePath,
+		recorderv4.WithMode(recorderv4.ModeRecordOnly),
+		recorderv4.WithRealTransport(hybrid),
+		recorderv4.WithSkipRequestLatency(true),
+		recorderv4.WithHook(s3Encoder, recorderv4.AfterCaptureHook),
+		recorderv4.WithHook(cassetteV4Anonymizer, recorderv4.BeforeSaveHook),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &http.Client{Transport: transport.NewRetryableTransport(r)}, func() {
+		require.NoError(t, r.Stop()) // Make sure recorder is stopped once done with it
+
+		t.Logf("hybrid cassette %s.yaml: %s", cassetteFilePath, hybrid.report())
+	}, nil
+}
+
+// cassetteV4Anonymizer removes the authentication headers and the values of the sensitive fields from go-vcr v4 interactions.
+func cassetteV4Anonymizer(i *cassettev4.Interaction) error {
+	i.Request.Headers = i.Request.Headers.Clone()
+	delete(i.Request.Headers, "x-auth-token")
+	delete(i.Request.Headers, "X-Auth-Token")
+	delete(i.Request.Headers, "Authorization")
+
+	anonymizedBody, err := anonymizeSensitiveFields(i.Response.Body)
+	if err != nil {
+		return err
+	}
+
+	i.Response.Body = anonymizedBody
+
+	return nil
+}
@@ -0,0 +1,236 @@
+//nolint:testpackage // Tests need access to the unexported hybrid transport.
+package acctest
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func hybridInteraction(method string, rawURL string, body string, code int) *cassette.Interaction {
+	return &cassette.Interaction{
+		Request: cassette.Request{
+			Method: method,
+			URL:    rawURL,
+			Body:   body,
+		},
+		Response: cassette.Response{
+			Status:  http.StatusText(code),
+			Code:    code,
+			Headers: http.Header{"Content-Type": []string{"application/json"}},
+			Body:    `{"id":"` + method + `"}`,
+		},
+	}
+}
+
+func newHybridTestRequest(t *testing.T, method string, rawURL string, body string) *http.Request {
+	t.Helper()
+
+	var bodyReader io.Reader
+	if body != "" {
+		bodyReader = strings.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), method, rawURL, bodyReader)
+	require.NoError(t, err)
+
+	return req
+}
+
+func TestHybridTransport_Match(t *testing.T) {
+	type request struct {
+		method string
+		url    string
+		body   string
+	}
+
+	tests := []struct {
+		name         string
+		interactions []*cassette.Interaction
+		requests     []request
+		// expected lists the index of the interaction matched by each request, or -1
+		expected []int
+	}{
+		{
+			name: "same method and url",
+			interactions: []*cassette.Interaction{
+				hybridInteraction(http.MethodGet, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers", "", http.StatusOK),
+			},
+			requests: []request{
+				{method: http.MethodGet, url: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers"},
+			},
+			expected: []int{0},
+		},
+		{
+			name: "ignored query values",
+			interactions: []*cassette.Interaction{
+				hybridInteraction(http.MethodGet, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?organization_id=recorded", "", http.StatusOK),
+			},
+			requests: []request{
+				{method: http.MethodGet, url: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?organization_id=current"},
+			},
+			expected: []int{0},
+		},
+		{
+			name: "different method",
+			interactions: []*cassette.Interaction{
+				hybridInteraction(http.MethodGet, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers", "", http.StatusOK),
+			},
+			requests: []request{
+				{method: http.MethodDelete, url: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers"},
+			},
+			expected: []int{-1},
+		},
+		{
+			name: "different body",
+			interactions: []*cassette.Interaction{
+				hybridInteraction(http.MethodPost, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers", `{"name":"recorded"}`, http.StatusCreated),
+			},
+			requests: []request{
+				{method: http.MethodPost, url: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers", body: `{"name":"current"}`},
+			},
+			expected: []int{-1},
+		},
+		{
+			name: "interactions are replayed once",
+			interactions: []*cassette.Interaction{
+				hybridInteraction(http.MethodGet, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1", "", http.StatusOK),
+				hybridInteraction(http.MethodGet, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1", "", http.StatusNotFound),
+			},
+			requests: []request{
+				{method: http.MethodGet, url: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1"},
+				{method: http.MethodGet, url: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1"},
+				{method: http.MethodGet, url: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1"},
+			},
+			expected: []int{0, 1, -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := newHybridTransport(&cassette.Cassette{Interactions: tt.interactions}, nil)
+
+			for index, r := range tt.requests {
+				matched := h.match(newHybridTestRequest(t, r.method, r.url, r.body))
+				if tt.expected[index] < 0 {
+					assert.Nil(t, matched, "request %d", index)
+
+					continue
+				}
+
+				assert.Same(t, tt.interactions[tt.expected[index]], matched, "request %d", index)
+			}
+		})
+	}
+}
+
+func TestHybridTransport_Replay(t *testing.T) {
+	t.Parallel()
+
+	i := hybridInteraction(http.MethodPost, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers", `{"name":"recorded"}`, http.StatusCreated)
+	h := newHybridTransport(&cassette.Cassette{Interactions: []*cassette.Interaction{i}}, nil)
+	h.network = roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		t.Fatal("matching requests must not be sent to the network")
+
+		return nil, nil
+	})
+
+	body := &readCounter{Reader: strings.NewReader(`{"name":"recorded"}`)}
+	req := newHybridTestRequest(t, http.MethodPost, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers", `{"name":"recorded"}`)
+	req.Body = body
+
+	resp, err := h.RoundTrip(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, http.StatusText(http.StatusCreated), resp.Status)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Same(t, req, resp.Request)
+
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"POST"}`, string(respBody))
+
+	// The request body is drained so that the recorder captures it
+	assert.Equal(t, len(`{"name":"recorded"}`), body.read)
+
+	// The replayed response headers are copied, not shared with the cassette
+	resp.Header.Set("Content-Type", "text/plain")
+	assert.Equal(t, "application/json", i.Response.Headers.Get("Content-Type"))
+}
+
+func TestHybridTransport_Report(t *testing.T) {
+	t.Parallel()
+
+	h := newHybridTransport(&cassette.Cassette{Interactions: []*cassette.Interaction{
+		hybridInteraction(http.MethodGet, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1", "", http.StatusOK),
+		hybridInteraction(http.MethodDelete, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1", "", http.StatusNoContent),
+	}}, &url.URL{Scheme: "http", Host: "localhost:8080"})
+
+	var forwarded *http.Request
+
+	h.network = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		forwarded = req
+
+		return &http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Request:    req,
+		}, nil
+	})
+
+	for _, req := range []*http.Request{
+		newHybridTestRequest(t, http.MethodGet, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1", ""),
+		newHybridTestRequest(t, http.MethodPost, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers", `{"name":"new"}`),
+	} {
+		resp, err := h.RoundTrip(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	// Unmatched requests are sent to the endpoint with the original host
+	require.NotNil(t, forwarded)
+	assert.Equal(t, "http://localhost:8080/instance/v1/zones/fr-par-1/servers", forwarded.URL.String())
+	assert.Equal(t, "api.scaleway.com", forwarded.Host)
+
+	assert.Equal(t, strings.Join([]string{
+		"1 replayed, 1 added, 1 dropped",
+		"+ [2] POST https://api.scaleway.com/instance/v1/zones/fr-par-1/servers 201 Created",
+		"- [2] DELETE https://api.scaleway.com/instance/v1/zones/fr-par-1/servers/1 No Content",
+	}, "\n"), h.report())
+}
+
+type readCounter struct {
+	io.Reader
+
+	read int
+}
+
+func (r *readCounter) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += n
+
+	return n, err
+}
+
+func (r *readCounter) Close() error {
+	return nil
+}
@@ -6,12 +6,18 @@ const (
 	RetryDelay = "TF_RETRY_DELAY"
 	// UpdateCassettes if set to "true" will trigger the cassettes to be recorded
 	UpdateCassettes = "TF_UPDATE_CASSETTES"
+	// HybridCassettes if set to "true" will replay the interactions matching the cassettes and record the others
+	HybridCassettes = "TF_HYBRID_CASSETTES"
+	// HybridCassettesEndpoint is the URL of a server receiving the requests which do not match the cassettes in hybrid mode, instead of the API
+	HybridCassettesEndpoint = "TF_HYBRID_CASSETTES_ENDPOINT"
 	// TestDomain is the DNS domain used during our tests
 	TestDomain = "TF_TEST_DOMAIN"
 	// TestDomainZone is the DNS zone used during our tests
 	TestDomainZone = "TF_TEST_DOMAIN_ZONE"
 	// AppendUserAgent is appended to the user agent of the underlying SDK go
 	AppendUserAgent = "TF_APPEND_USER_AGENT"
+	// HTTPLogFile is the path of a file where every request sent to the API is written as a JSON line, with secrets redacted
+	HTTPLogFile = "SCW_TF_HTTP_LOG_FILE"
 	// AccDomainRegistration if set to "true" will trigger acceptance test for domain registration
 	AccDomainRegistration = "TF_ACC_DOMAIN_REGISTRATION"
 	// AccRunningOpenTofu is set to "true" in the CI to document that we are using OpenTofu. It can be helpful to skip
@@ -0,0 +1,82 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+)
+
+var _ function.Function = &BuildRegionalID{}
+
+type BuildRegionalID struct{}
+
+func NewBuildRegionalID() function.Function {
+	return &BuildRegionalID{}
+}
+
+func (f *BuildRegionalID) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_regional_id"
+}
+
+func (f *BuildRegionalID) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a regional ID from a region and an ID",
+		Description: "Given a region and an ID without locality, returns the regional ID formatted as region/id.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "region",
+				Description: "region of the resource",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "id of the resource, without region",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildRegionalID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region, id types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &region, &id))
+
+	if region.IsNull() || region.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, region))
+
+		return
+	}
+
+	if id.IsNull() || id.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+
+		return
+	}
+
+	parsedRegion, err := scw.ParseRegion(region.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(0, ErrInvalidRegion, region.ValueString()))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	switch {
+	case id.ValueString() == "":
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(1, ErrEmptyID, id.ValueString()))
+	case isLocalizedID(id.ValueString()):
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(1, ErrAlreadyLocalizedID, id.ValueString()))
+	}
+
+	if resp.Error != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(regional.NewIDString(parsedRegion, id.ValueString()))))
+}
@@ -0,0 +1,112 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+)
+
+func TestBuildRegionalIDFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected function.RunResponse
+		request  function.RunRequest
+	}{
+		"null-region": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringNull(), types.StringValue("11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+			},
+		},
+		"unknown-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par"), types.StringUnknown()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"valid": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par"), types.StringValue("11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("fr-par/11111111-1111-1111-1111-111111111111")),
+			},
+		},
+		"invalid-region": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par-1"), types.StringValue("11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad region format, expected format is: xx-yyy, got: "fr-par-1"`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"empty-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par"), types.StringValue("")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(1, `ID must not be empty, got: ""`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"already-localized-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par"), types.StringValue("fr-par/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(1, `ID is already localized, expected an ID without zone or region, got: "fr-par/11111111-1111-1111-1111-111111111111"`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			functions.NewBuildRegionalID().Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderFunction_Build_Regional_ID(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "id" {
+					value = provider::scaleway::build_regional_id("pl-waw", "11111111-1111-1111-1111-111111111111")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("id", "pl-waw/11111111-1111-1111-1111-111111111111"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+)
+
+var _ function.Function = &BuildZonalID{}
+
+type BuildZonalID struct{}
+
+func NewBuildZonalID() function.Function {
+	return &BuildZonalID{}
+}
+
+func (f *BuildZonalID) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_zonal_id"
+}
+
+func (f *BuildZonalID) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a zonal ID from a zone and an ID",
+		Description: "Given a zone and an ID without locality, returns the zonal ID formatted as zone/id.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zone",
+				Description: "zone of the resource",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "id of the resource, without zone",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildZonalID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zone, id types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &zone, &id))
+
+	if zone.IsNull() || zone.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, zone))
+
+		return
+	}
+
+	if id.IsNull() || id.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+
+		return
+	}
+
+	parsedZone, err := scw.ParseZone(zone.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(0, ErrInvalidZone, zone.ValueString()))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	switch {
+	case id.ValueString() == "":
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(1, ErrEmptyID, id.ValueString()))
+	case isLocalizedID(id.ValueString()):
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(1, ErrAlreadyLocalizedID, id.ValueString()))
+	}
+
+	if resp.Error != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(zonal.NewIDString(parsedZone, id.ValueString()))))
+}
@@ -0,0 +1,84 @@
+package functions
+
+import (
+	"errors"
+	"math/big"
+	"net"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+// validate runs a SDKv2 validator on value and returns its first error.
+func validate(validator schema.SchemaValidateDiagFunc, value string) error {
+	for _, d := range validator(value, cty.Path{}) {
+		return errors.New(d.Summary)
+	}
+
+	return nil
+}
+
+// parsePrivateNetworkCIDR parses a CIDR which must be usable as a Private Network subnet.
+func parsePrivateNetworkCIDR(cidr string) (*net.IPNet, error) {
+	if err := validate(verify.IsPrivateNetworkCIDR(), cidr); err != nil {
+		return nil, err
+	}
+
+	return parseIPOrCIDR(cidr)
+}
+
+// parseIPOrCIDR parses a standalone IP, as a single address range, or a CIDR.
+func parseIPOrCIDR(value string) (*net.IPNet, error) {
+	if err := validate(verify.IsStandaloneIPorCIDR(), value); err != nil {
+		return nil, err
+	}
+
+	ipNet, err := types.ExpandIPNet(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &net.IPNet{IP: ipNet.IP.Mask(ipNet.Mask), Mask: ipNet.Mask}, nil
+}
+
+// cidrOverlaps returns true if the two ranges share at least one address.
+func cidrOverlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// cidrContains returns true if inner is entirely within outer.
+func cidrContains(outer, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+// addToIP returns the address offset from ip by n, which may be negative.
+func addToIP(ip net.IP, n int64) net.IP {
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+
+	value := new(big.Int).SetBytes(ip)
+	value.Add(value, big.NewInt(n))
+
+	result := make(net.IP, len(ip))
+	value.FillBytes(result)
+
+	return result
+}
+
+// lastIP returns the last address of a range.
+func lastIP(ipNet *net.IPNet) net.IP {
+	ip := ipNet.IP.Mask(ipNet.Mask)
+	last := make(net.IP, len(ip))
+
+	for i := range ip {
+		last[i] = ip[i] | ^ipNet.Mask[i]
+	}
+
+	return last
+}
@@ -0,0 +1,74 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &CIDRContains{}
+
+type CIDRContains struct{}
+
+func NewCIDRContains() function.Function {
+	return &CIDRContains{}
+}
+
+func (f *CIDRContains) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f *CIDRContains) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether an IP or a subnet is within a CIDR",
+		Description: "Given a CIDR and an IP or a CIDR, returns true if the IP or every address of the CIDR is within the first CIDR.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "range to check against, in CIDR notation",
+			},
+			function.StringParameter{
+				Name:        "ip",
+				Description: "IP or range in CIDR notation to look for",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *CIDRContains) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr, ip types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidr, &ip))
+
+	if cidr.IsNull() || ip.IsNull() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolNull()))
+
+		return
+	}
+
+	if cidr.IsUnknown() || ip.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolUnknown()))
+
+		return
+	}
+
+	outer, err := parseIPOrCIDR(cidr.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+	}
+
+	inner, err := parseIPOrCIDR(ip.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+	}
+
+	if resp.Error != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolUnknown()))
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolValue(cidrContains(outer, inner))))
+}
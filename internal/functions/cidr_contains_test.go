@@ -0,0 +1,134 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+)
+
+func TestCIDRContainsFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected function.RunResponse
+		request  function.RunRequest
+	}{
+		"null": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0/22"), types.StringNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolNull()),
+			},
+		},
+		"unknown": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringUnknown(), types.StringValue("172.16.32.4")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			},
+		},
+		"ip-inside": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0/22"), types.StringValue("172.16.35.12")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		"ip-outside": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0/22"), types.StringValue("172.16.36.1")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+		"subnet-inside": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0/22"), types.StringValue("172.16.34.0/24")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		"subnet-larger": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0/22"), types.StringValue("172.16.0.0/16")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+		"ipv6-inside": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fd46:78ab:30b8:c949::/64"), types.StringValue("fd46:78ab:30b8:c949:5be0:b132:2578:20ea")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		"mixed-families": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0/22"), types.StringValue("fd46:78ab:30b8:c949::1")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+		"invalid-ip": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0/22"), types.StringValue("172.16.32")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(1, "neither a valid IP address or CIDR notation: 172.16.32"),
+				Result: function.NewResultData(types.BoolUnknown()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			}
+
+			functions.NewCIDRContains().Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderFunction_CIDR_Contains(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "contains" {
+					value = provider::scaleway::cidr_contains("172.16.32.0/22", "172.16.33.10")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("contains", "true"),
+				),
+			},
+		},
+	})
+}
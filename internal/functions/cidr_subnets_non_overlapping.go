@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &CIDRSubnetsNonOverlapping{}
+
+type CIDRSubnetsNonOverlapping struct{}
+
+func NewCIDRSubnetsNonOverlapping() function.Function {
+	return &CIDRSubnetsNonOverlapping{}
+}
+
+func (f *CIDRSubnetsNonOverlapping) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_non_overlapping"
+}
+
+func (f *CIDRSubnetsNonOverlapping) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check that Private Network subnets do not overlap",
+		Description: "Given a list of CIDRs, returns true if none of them overlap. " +
+			"Each CIDR must be usable by a Private Network: an RFC 1918 IPv4 range between /20 and /28, or a unique local IPv6 /64 range.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "cidrs",
+				Description: "subnets to check, in CIDR notation",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *CIDRSubnetsNonOverlapping) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+
+	subnets := make([]*net.IPNet, 0, len(cidrs))
+
+	for i, cidr := range cidrs {
+		if cidr.IsUnknown() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolUnknown()))
+
+			return
+		}
+
+		subnet, err := parsePrivateNetworkCIDR(cidr.ValueString())
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("cidrs[%d]: %s", i, err)))
+			resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolUnknown()))
+
+			return
+		}
+
+		subnets = append(subnets, subnet)
+	}
+
+	for i := range subnets {
+		for j := i + 1; j < len(subnets); j++ {
+			if cidrOverlaps(subnets[i], subnets[j]) {
+				resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolValue(false)))
+
+				return
+			}
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolValue(true)))
+}
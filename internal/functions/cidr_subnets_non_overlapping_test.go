@@ -0,0 +1,123 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+)
+
+func cidrList(cidrs ...attr.Value) types.List {
+	return types.ListValueMust(types.StringType, cidrs)
+}
+
+func TestCIDRSubnetsNonOverlappingFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected function.RunResponse
+		request  function.RunRequest
+	}{
+		"unknown-element": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{cidrList(types.StringValue("172.16.0.0/22"), types.StringUnknown())}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			},
+		},
+		"non-overlapping": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{cidrList(
+					types.StringValue("172.16.0.0/22"),
+					types.StringValue("172.16.4.0/24"),
+					types.StringValue("192.168.0.0/24"),
+					types.StringValue("fd46:78ab:30b8:c949::/64"),
+				)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		"overlapping": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{cidrList(
+					types.StringValue("172.16.0.0/22"),
+					types.StringValue("172.16.2.0/24"),
+				)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+		"public-range": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{cidrList(
+					types.StringValue("172.16.0.0/22"),
+					types.StringValue("51.15.0.0/24"),
+				)}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, "cidrs[1]: 51.15.0.0/24 cannot be used by a Private Network, IPv4 subnets must be RFC 1918 ranges (10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16) between /20 and /28"),
+				Result: function.NewResultData(types.BoolUnknown()),
+			},
+		},
+		"not-a-network-address": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{cidrList(types.StringValue("172.16.0.1/22"))}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, "cidrs[0]: 172.16.0.1/22 is not a network address, expected 172.16.0.0/22"),
+				Result: function.NewResultData(types.BoolUnknown()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			}
+
+			functions.NewCIDRSubnetsNonOverlapping().Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderFunction_CIDR_Subnets_Non_Overlapping(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "non_overlapping" {
+					value = provider::scaleway::cidr_subnets_non_overlapping(["172.16.0.0/22", "172.16.4.0/22"])
+				}
+
+				output "overlapping" {
+					value = provider::scaleway::cidr_subnets_non_overlapping(["172.16.0.0/22", "172.16.0.0/24"])
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("non_overlapping", "true"),
+					resource.TestCheckOutput("overlapping", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	// ErrInvalidZonalID is returned when an ID is not formatted as zone/id
+	ErrInvalidZonalID = errors.New("bad zonal ID format, expected format is: zone/id")
+	// ErrInvalidLocalizedID is returned when an ID is not prefixed by a zone or a region
+	ErrInvalidLocalizedID = errors.New("bad localized ID format, expected format is: region/id or zone/id")
+	// ErrInvalidZone is returned when a zone is not formatted as xx-yyy-n
+	ErrInvalidZone = errors.New("bad zone format, expected format is: xx-yyy-n")
+	// ErrInvalidRegion is returned when a region is not formatted as xx-yyy
+	ErrInvalidRegion = errors.New("bad region format, expected format is: xx-yyy")
+	// ErrAlreadyLocalizedID is returned when building a localized ID from an ID which already has a locality
+	ErrAlreadyLocalizedID = errors.New("ID is already localized, expected an ID without zone or region")
+	// ErrEmptyID is returned when building a localized ID from an empty ID
+	ErrEmptyID = errors.New("ID must not be empty")
+	// ErrInvalidSRN is returned when a Scaleway Resource Name cannot be parsed
+	ErrInvalidSRN = errors.New("bad SRN format, expected format is: srn://service.scw.eu/regions/region/resource_type/id or srn://service.scw.eu/zones/zone/resource_type/id")
+)
+
+// newArgumentError returns a function error on the argument at position, reporting err for the given value.
+func newArgumentError(position int64, err error, value string) *function.FuncError {
+	return function.NewArgumentFuncError(position, fmt.Sprintf("%s, got: %q", err, value))
+}
@@ -0,0 +1,73 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+var _ function.Function = &IDFromZonalID{}
+
+type IDFromZonalID struct{}
+
+func NewIDFromZonalID() function.Function {
+	return &IDFromZonalID{}
+}
+
+func (f *IDFromZonalID) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "id_from_zonal_id"
+}
+
+func (f *IDFromZonalID) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Extract the ID without zone from a zonal ID",
+		Description: "Given a zonal ID string value, returns the ID without the zone prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zonal_id",
+				Description: "zonal ID to extract the ID from",
+			},
+			function.BoolParameter{
+				Name:           "skip_zone_validation",
+				Description:    "If true, will skip zone validation with the zone format known by the Scaleway SDK.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *IDFromZonalID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		zonalID            types.String
+		skipZoneValidation types.Bool
+	)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &zonalID, &skipZoneValidation))
+
+	if zonalID.IsNull() || zonalID.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, zonalID))
+
+		return
+	}
+
+	rawZone, id, err := splitLocalizedID(zonalID.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(0, ErrInvalidZonalID, zonalID.ValueString()))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	if _, err := scw.ParseZone(rawZone); err != nil && !skipZoneValidation.ValueBool() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(0, ErrInvalidZone, rawZone))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(id)))
+}
@@ -0,0 +1,128 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+)
+
+func TestIDFromZonalIDFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected function.RunResponse
+		request  function.RunRequest
+	}{
+		"null": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringNull(), types.BoolNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+			},
+		},
+		"unknown": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringUnknown(), types.BoolNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"valid-id-format": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par-1/11111111-1111-1111-1111-111111111111"), types.BoolNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("11111111-1111-1111-1111-111111111111")),
+			},
+		},
+		"valid-nested-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par-1/11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222"), types.BoolNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("11111111-1111-1111-1111-111111111111/22222222-2222-2222-2222-222222222222")),
+			},
+		},
+		"skip-zone-validation": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("my-zone/11111111-1111-1111-1111-111111111111"), types.BoolValue(true)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("11111111-1111-1111-1111-111111111111")),
+			},
+		},
+		"regional-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par/11111111-1111-1111-1111-111111111111"), types.BoolNull()}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad zone format, expected format is: xx-yyy-n, got: "fr-par"`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"missing-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par-1/"), types.BoolNull()}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad zonal ID format, expected format is: zone/id, got: "fr-par-1/"`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"malformed-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("invalid-format"), types.BoolNull()}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad zonal ID format, expected format is: zone/id, got: "invalid-format"`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			functions.NewIDFromZonalID().Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderFunction_ID_From_Zonal_ID(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "id" {
+					value = provider::scaleway::id_from_zonal_id("fr-par-2/11111111-1111-1111-1111-111111111111", null)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchOutput("id", acctest.UUIDRegex),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+)
+
+var _ function.Function = &IsValidUUID{}
+
+type IsValidUUID struct{}
+
+func NewIsValidUUID() function.Function {
+	return &IsValidUUID{}
+}
+
+func (f *IsValidUUID) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_uuid"
+}
+
+func (f *IsValidUUID) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether a value is a UUID",
+		Description: "Given a string value, returns true if it is a UUID, without zone or region.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "value to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsValidUUID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+
+	if value.IsNull() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolNull()))
+
+		return
+	}
+
+	if value.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolUnknown()))
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.BoolValue(validation.IsUUID(value.ValueString()))))
+}
@@ -0,0 +1,106 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+)
+
+func TestIsValidUUIDFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected function.RunResponse
+		request  function.RunRequest
+	}{
+		"null": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolNull()),
+			},
+		},
+		"unknown": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringUnknown()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			},
+		},
+		"uuid": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		"localized-uuid": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+		"not-uuid": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("my-resource")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			}
+
+			functions.NewIsValidUUID().Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderFunction_Is_Valid_UUID(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "valid" {
+					value = provider::scaleway::is_valid_uuid("11111111-1111-1111-1111-111111111111")
+				}
+
+				output "invalid" {
+					value = provider::scaleway::is_valid_uuid("fr-par/11111111-1111-1111-1111-111111111111")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("valid", "true"),
+					resource.TestCheckOutput("invalid", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package functions
+
+import (
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+)
+
+// splitLocalizedID splits a localized ID, nested or not, into its locality and the ID following it.
+func splitLocalizedID(localizedID string) (string, string, error) {
+	loc, id, err := locality.ParseLocalizedID(localizedID)
+	if err != nil {
+		var innerID, outerID string
+
+		loc, innerID, outerID, err = locality.ParseLocalizedNestedID(localizedID)
+		if err != nil {
+			return "", "", err
+		}
+
+		id = innerID + "/" + outerID
+	}
+
+	if loc == "" || id == "" {
+		return "", "", ErrInvalidLocalizedID
+	}
+
+	return loc, id, nil
+}
+
+// isLocalizedID returns true if id starts with a zone or a region.
+func isLocalizedID(id string) bool {
+	loc, _, found := strings.Cut(id, "/")
+
+	return found && (validation.IsZone(loc) || validation.IsRegion(loc))
+}
@@ -0,0 +1,58 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+)
+
+var _ function.Function = &LocalityFromID{}
+
+type LocalityFromID struct{}
+
+func NewLocalityFromID() function.Function {
+	return &LocalityFromID{}
+}
+
+func (f *LocalityFromID) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "locality_from_id"
+}
+
+func (f *LocalityFromID) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Extract a locality from the ID",
+		Description: "Given a regional or zonal ID string value, returns the region or the zone contained in the ID.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "id to extract the locality from",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LocalityFromID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+
+	if input.IsNull() || input.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, input))
+
+		return
+	}
+
+	loc, _, err := splitLocalizedID(input.ValueString())
+	if err != nil || !(validation.IsZone(loc) || validation.IsRegion(loc)) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(0, ErrInvalidLocalizedID, input.ValueString()))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(loc)))
+}
@@ -0,0 +1,124 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+)
+
+func TestLocalityFromIDFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected function.RunResponse
+		request  function.RunRequest
+	}{
+		"null": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+			},
+		},
+		"unknown": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringUnknown()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"regional-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fr-par/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("fr-par")),
+			},
+		},
+		"zonal-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("pl-waw-3/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("pl-waw-3")),
+			},
+		},
+		"nested-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("nl-ams/foo/bar")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("nl-ams")),
+			},
+		},
+		"invalid-locality": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("foo/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad localized ID format, expected format is: region/id or zone/id, got: "foo/11111111-1111-1111-1111-111111111111"`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"id-without-locality": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad localized ID format, expected format is: region/id or zone/id, got: "11111111-1111-1111-1111-111111111111"`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			functions.NewLocalityFromID().Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderFunction_Locality_From_ID(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "region" {
+					value = provider::scaleway::locality_from_id("nl-ams/11111111-1111-1111-1111-111111111111")
+				}
+
+				output "zone" {
+					value = provider::scaleway::locality_from_id("nl-ams-1/11111111-1111-1111-1111-111111111111")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("region", "nl-ams"),
+					resource.TestCheckOutput("zone", "nl-ams-1"),
+				),
+			},
+		},
+	})
+}
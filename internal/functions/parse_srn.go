@@ -0,0 +1,134 @@
+package functions
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+var _ function.Function = &ParseSRN{}
+
+const srnScheme = "srn://"
+
+// srnAttributeTypes are the attributes of the object returned by parse_srn.
+var srnAttributeTypes = map[string]attr.Type{
+	"service":       types.StringType,
+	"locality":      types.StringType,
+	"region":        types.StringType,
+	"zone":          types.StringType,
+	"resource_type": types.StringType,
+	"id":            types.StringType,
+}
+
+type ParseSRN struct{}
+
+func NewParseSRN() function.Function {
+	return &ParseSRN{}
+}
+
+func (f *ParseSRN) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_srn"
+}
+
+func (f *ParseSRN) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a Scaleway Resource Name",
+		Description: "Given a Scaleway Resource Name (SRN) string value, returns an object with its service, locality, region, zone, resource_type and id. " +
+			"The zone is null for regional resources.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "srn",
+				Description: "Scaleway Resource Name to parse, e.g. srn://key-manager.scw.eu/regions/fr-par/keys/11111111-1111-1111-1111-111111111111",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: srnAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseSRN) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var srn types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &srn))
+
+	if srn.IsNull() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.ObjectNull(srnAttributeTypes)))
+
+		return
+	}
+
+	if srn.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.ObjectUnknown(srnAttributeTypes)))
+
+		return
+	}
+
+	attributes, err := parseSRN(srn.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(0, err, srn.ValueString()))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.ObjectUnknown(srnAttributeTypes)))
+
+		return
+	}
+
+	result, diags := types.ObjectValue(srnAttributeTypes, attributes)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// parseSRN parses a SRN formatted as srn://service.domain/regions/region/resource_type/id or srn://service.domain/zones/zone/resource_type/id.
+func parseSRN(srn string) (map[string]attr.Value, error) {
+	path, found := strings.CutPrefix(srn, srnScheme)
+	if !found {
+		return nil, ErrInvalidSRN
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) < 5 || slices.Contains(parts, "") {
+		return nil, ErrInvalidSRN
+	}
+
+	service, _, _ := strings.Cut(parts[0], ".")
+	localityType, rawLocality, resourceType, id := parts[1], parts[2], parts[3], strings.Join(parts[4:], "/")
+
+	attributes := map[string]attr.Value{
+		"service":       types.StringValue(service),
+		"locality":      types.StringValue(rawLocality),
+		"resource_type": types.StringValue(resourceType),
+		"id":            types.StringValue(id),
+	}
+
+	switch localityType {
+	case "regions":
+		region, err := scw.ParseRegion(rawLocality)
+		if err != nil {
+			return nil, ErrInvalidRegion
+		}
+
+		attributes["region"] = types.StringValue(region.String())
+		attributes["zone"] = types.StringNull()
+	case "zones":
+		zone, err := scw.ParseZone(rawLocality)
+		if err != nil {
+			return nil, ErrInvalidZone
+		}
+
+		region, err := zone.Region()
+		if err != nil {
+			return nil, ErrInvalidZone
+		}
+
+		attributes["region"] = types.StringValue(region.String())
+		attributes["zone"] = types.StringValue(zone.String())
+	default:
+		return nil, ErrInvalidSRN
+	}
+
+	return attributes, nil
+}
@@ -0,0 +1,157 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+)
+
+var srnAttributeTypes = map[string]attr.Type{
+	"service":       types.StringType,
+	"locality":      types.StringType,
+	"region":        types.StringType,
+	"zone":          types.StringType,
+	"resource_type": types.StringType,
+	"id":            types.StringType,
+}
+
+func TestParseSRNFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected function.RunResponse
+		request  function.RunRequest
+	}{
+		"null": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectNull(srnAttributeTypes)),
+			},
+		},
+		"unknown": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringUnknown()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(srnAttributeTypes)),
+			},
+		},
+		"regional-srn": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("srn://key-manager.scw.eu/regions/fr-par/keys/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectValueMust(srnAttributeTypes, map[string]attr.Value{
+					"service":       types.StringValue("key-manager"),
+					"locality":      types.StringValue("fr-par"),
+					"region":        types.StringValue("fr-par"),
+					"zone":          types.StringNull(),
+					"resource_type": types.StringValue("keys"),
+					"id":            types.StringValue("11111111-1111-1111-1111-111111111111"),
+				})),
+			},
+		},
+		"zonal-srn": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("srn://instance.scw.eu/zones/nl-ams-1/servers/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectValueMust(srnAttributeTypes, map[string]attr.Value{
+					"service":       types.StringValue("instance"),
+					"locality":      types.StringValue("nl-ams-1"),
+					"region":        types.StringValue("nl-ams"),
+					"zone":          types.StringValue("nl-ams-1"),
+					"resource_type": types.StringValue("servers"),
+					"id":            types.StringValue("11111111-1111-1111-1111-111111111111"),
+				})),
+			},
+		},
+		"missing-scheme": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("key-manager.scw.eu/regions/fr-par/keys/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad SRN format, expected format is: srn://service.scw.eu/regions/region/resource_type/id or srn://service.scw.eu/zones/zone/resource_type/id, got: "key-manager.scw.eu/regions/fr-par/keys/11111111-1111-1111-1111-111111111111"`),
+				Result: function.NewResultData(types.ObjectUnknown(srnAttributeTypes)),
+			},
+		},
+		"missing-id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("srn://key-manager.scw.eu/regions/fr-par/keys/")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad SRN format, expected format is: srn://service.scw.eu/regions/region/resource_type/id or srn://service.scw.eu/zones/zone/resource_type/id, got: "srn://key-manager.scw.eu/regions/fr-par/keys/"`),
+				Result: function.NewResultData(types.ObjectUnknown(srnAttributeTypes)),
+			},
+		},
+		"zone-in-regions": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("srn://key-manager.scw.eu/regions/fr-par-1/keys/11111111-1111-1111-1111-111111111111")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `bad region format, expected format is: xx-yyy, got: "srn://key-manager.scw.eu/regions/fr-par-1/keys/11111111-1111-1111-1111-111111111111"`),
+				Result: function.NewResultData(types.ObjectUnknown(srnAttributeTypes)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(srnAttributeTypes)),
+			}
+
+			functions.NewParseSRN().Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderFunction_Parse_SRN(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					srn = provider::scaleway::parse_srn("srn://key-manager.scw.eu/regions/fr-par/keys/11111111-1111-1111-1111-111111111111")
+				}
+
+				output "service" {
+					value = local.srn.service
+				}
+
+				output "region" {
+					value = local.srn.region
+				}
+
+				output "id" {
+					value = local.srn.id
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("service", "key-manager"),
+					resource.TestCheckOutput("region", "fr-par"),
+					resource.TestMatchOutput("id", acctest.UUIDRegex),
+				),
+			},
+		},
+	})
+}
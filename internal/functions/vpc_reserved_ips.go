@@ -0,0 +1,74 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &VPCReservedIPs{}
+
+type VPCReservedIPs struct{}
+
+func NewVPCReservedIPs() function.Function {
+	return &VPCReservedIPs{}
+}
+
+func (f *VPCReservedIPs) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_reserved_ips"
+}
+
+func (f *VPCReservedIPs) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "List the IPs reserved by Scaleway in a Private Network subnet",
+		Description: "Given a Private Network subnet in CIDR notation, returns the IPs which cannot be assigned to resources: " +
+			"the network address, the gateway address and, for IPv4 subnets, the broadcast address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "subnet of the Private Network, in CIDR notation",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *VPCReservedIPs) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidr))
+
+	if cidr.IsNull() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.ListNull(types.StringType)))
+
+		return
+	}
+
+	if cidr.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.ListUnknown(types.StringType)))
+
+		return
+	}
+
+	subnet, err := parsePrivateNetworkCIDR(cidr.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.ListUnknown(types.StringType)))
+
+		return
+	}
+
+	reservedIPs := []string{
+		subnet.IP.String(),
+		addToIP(subnet.IP, 1).String(),
+	}
+
+	if subnet.IP.To4() != nil {
+		reservedIPs = append(reservedIPs, lastIP(subnet).String())
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, reservedIPs))
+}
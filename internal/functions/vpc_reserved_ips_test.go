@@ -0,0 +1,118 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+)
+
+func TestVPCReservedIPsFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected function.RunResponse
+		request  function.RunRequest
+	}{
+		"null": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringNull()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListNull(types.StringType)),
+			},
+		},
+		"unknown": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringUnknown()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			},
+		},
+		"ipv4": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0/22")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("172.16.32.0"),
+					types.StringValue("172.16.32.1"),
+					types.StringValue("172.16.35.255"),
+				})),
+			},
+		},
+		"ipv6": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("fd46:78ab:30b8:c949::/64")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("fd46:78ab:30b8:c949::"),
+					types.StringValue("fd46:78ab:30b8:c949::1"),
+				})),
+			},
+		},
+		"prefix-too-short": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/16")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, "10.0.0.0/16 cannot be used by a Private Network, IPv4 subnets must be RFC 1918 ranges (10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16) between /20 and /28"),
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			},
+		},
+		"not-a-cidr": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("172.16.32.0")}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, "expected a valid CIDR, got 172.16.32.0"),
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			}
+
+			functions.NewVPCReservedIPs().Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderFunction_VPC_Reserved_IPs(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "gateway" {
+					value = provider::scaleway::vpc_reserved_ips("192.168.0.0/24")[1]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("gateway", "192.168.0.1"),
+				),
+			},
+		},
+	})
+}
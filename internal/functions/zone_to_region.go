@@ -0,0 +1,66 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+var _ function.Function = &ZoneToRegion{}
+
+type ZoneToRegion struct{}
+
+func NewZoneToRegion() function.Function {
+	return &ZoneToRegion{}
+}
+
+func (f *ZoneToRegion) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "zone_to_region"
+}
+
+func (f *ZoneToRegion) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Get the region of a zone",
+		Description: "Given a zone string value, returns the region the zone belongs to.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zone",
+				Description: "zone to get the region of",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ZoneToRegion) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zone types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &zone))
+
+	if zone.IsNull() || zone.IsUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, zone))
+
+		return
+	}
+
+	parsedZone, err := scw.ParseZone(zone.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(0, ErrInvalidZone, zone.ValueString()))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	region, err := parsedZone.Region()
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, newArgumentError(0, ErrInvalidZone, zone.ValueString()))
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, basetypes.NewStringUnknown()))
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(region.String())))
+}
@@ -82,3 +82,26 @@ func NameAttribute(description string) schema.StringAttribute {
 		Optional:    true,
 	}
 }
+
+// StatesAttribute returns an attribute filtering on the states of a resource, validated against the values of the state enum T.
+func StatesAttribute[T verify.EnumValues[T]](description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: description,
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(verify.ValidateEnumFramework[T]()),
+		},
+	}
+}
+
+// RegionalIDAttribute returns an attribute filtering on the ID of a parent resource, given with or without its region.
+func RegionalIDAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.String{
+			verify.IsStringUUIDOrUUIDWithLocality(),
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 )
 
@@ -52,6 +53,21 @@ func ExtractRegions(ctx context.Context, model RegionalModel, meta *meta.Meta) (
 	return res, nil
 }
 
+// ExtractRegionalID returns the ID of a filter set by RegionalIDAttribute, nil when the filter is not set.
+// The regions are restricted to the region of the ID when it has one.
+func ExtractRegionalID(id types.String, regions []scw.Region) (*string, []scw.Region) {
+	if id.IsNull() || id.IsUnknown() {
+		return nil, regions
+	}
+
+	region, parsedID, err := regional.ParseID(id.ValueString())
+	if err != nil {
+		return id.ValueStringPointer(), regions
+	}
+
+	return &parsedID, []scw.Region{region}
+}
+
 type RegionalFetchTarget struct {
 	Region    scw.Region
 	ProjectID string
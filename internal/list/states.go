@@ -0,0 +1,36 @@
+package list
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StatesModel interface {
+	GetStates() types.List
+}
+
+func ExtractStates(ctx context.Context, data StatesModel) ([]string, diag.Diagnostics) {
+	return ExtractStrings(ctx, data.GetStates())
+}
+
+// ExtractStrings returns the elements of a list of strings filter, nil when the filter is not set.
+func ExtractStrings(ctx context.Context, values types.List) ([]string, diag.Diagnostics) {
+	var res []string
+
+	if !values.IsNull() && !values.IsUnknown() {
+		diags := values.ElementsAs(ctx, &res, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return res, nil
+}
+
+// MatchStates reports whether state is one of states. Every state matches when states is empty.
+func MatchStates(states []string, state string) bool {
+	return len(states) == 0 || slices.Contains(states, state)
+}
@@ -2,6 +2,7 @@ package list
 
 import (
 	"context"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,3 +25,14 @@ func ExtractTags(ctx context.Context, data TagsModel) ([]string, diag.Diagnostic
 
 	return tags, nil
 }
+
+// MatchTags reports whether tags contains every tag of filter, for the APIs which cannot filter on tags.
+func MatchTags(filter []string, tags []string) bool {
+	for _, tag := range filter {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
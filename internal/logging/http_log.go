@@ -0,0 +1,87 @@
+package logging
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	httpLogsMu sync.Mutex
+	httpLogs   = map[string]*HTTPLog{}
+)
+
+// HTTPLogEntry is a line of the HTTP log, describing a request sent to the API and its response.
+type HTTPLogEntry struct {
+	Time         time.Time `json:"time"`
+	Method       string    `json:"method"`
+	URL          string    `json:"url"`
+	Status       int       `json:"status,omitempty"`
+	DurationMs   int64     `json:"duration_ms"`
+	RequestID    string    `json:"request_id,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	RequestBody  any       `json:"request_body,omitempty"`
+	ResponseBody any       `json:"response_body,omitempty"`
+}
+
+// HTTPLog writes HTTP log entries as JSON lines to a file.
+type HTTPLog struct {
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// OpenHTTPLog returns the HTTP log appending to the file at path, creating the file if needed.
+// The log is shared by every client of the process writing to the same path.
+func OpenHTTPLog(path string) (*HTTPLog, error) {
+	httpLogsMu.Lock()
+	defer httpLogsMu.Unlock()
+
+	if httpLog, exists := httpLogs[path]; exists {
+		return httpLog, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint: gosec // G304: path is set by the user to choose where to write the log
+	if err != nil {
+		return nil, err
+	}
+
+	httpLog := &HTTPLog{
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}
+	httpLogs[path] = httpLog
+
+	return httpLog, nil
+}
+
+// Write appends the entry to the log. Errors are logged but not returned, the HTTP log must not fail requests.
+func (l *HTTPLog) Write(entry *HTTPLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.encoder.Encode(entry); err != nil {
+		L.Warningf("cannot write HTTP log entry: %s", err)
+	}
+}
+
+// CloseHTTPLogs closes the files of every HTTP log opened by the process, once the provider is shut down.
+func CloseHTTPLogs() {
+	httpLogsMu.Lock()
+	defer httpLogsMu.Unlock()
+
+	for path, httpLog := range httpLogs {
+		httpLog.mu.Lock()
+
+		if err := httpLog.file.Close(); err != nil {
+			L.Warningf("cannot close HTTP log %s: %s", path, err)
+		}
+
+		httpLog.mu.Unlock()
+
+		delete(httpLogs, path)
+	}
+}
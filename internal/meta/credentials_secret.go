@@ -0,0 +1,163 @@
+package meta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+)
+
+const defaultCredentialsSecretRevision = "latest_enabled"
+
+// CredentialsSecretConfig contains the provider credentials_secret block.
+type CredentialsSecretConfig struct {
+	// SecretID is the ID of the secret, with or without its region
+	SecretID string
+	// Revision is the revision of the secret version, "latest_enabled" if empty
+	Revision string
+}
+
+// credentialsSecretCache holds the profiles loaded from credentials secrets. The SDKv2 and framework providers are both configured
+// with the same credentials_secret block, the secret version is only accessed by the first one.
+var credentialsSecretCache = struct {
+	sync.Mutex
+
+	profiles map[credentialsSecretCacheKey]*scw.Profile
+}{profiles: map[credentialsSecretCacheKey]*scw.Profile{}}
+
+// credentialsSecretCacheKey identifies a secret version and the credentials used to access it
+type credentialsSecretCacheKey struct {
+	secretID  string
+	revision  string
+	accessKey string
+	apiURL    string
+}
+
+// credentialsSecretPayload is the JSON payload expected in the credentials secret version.
+type credentialsSecretPayload struct {
+	AccessKey      string `json:"access_key"`
+	SecretKey      string `json:"secret_key"`
+	ProjectID      string `json:"project_id"`
+	OrganizationID string `json:"organization_id"`
+}
+
+// expandCredentialsSecret reads the provider credentials_secret block
+func expandCredentialsSecret(d *schema.ResourceData) *CredentialsSecretConfig {
+	if d == nil {
+		return nil
+	}
+
+	secretID, exist := d.GetOk("credentials_secret.0.secret_id")
+	if !exist {
+		return nil
+	}
+
+	return &CredentialsSecretConfig{
+		SecretID: secretID.(string),
+		Revision: d.Get("credentials_secret.0.revision").(string),
+	}
+}
+
+// loadCredentialsSecretProfile reads the credentials stored in a Secret Manager secret version, once per provider process.
+// The secret version is accessed with the credentials of the given profile, through the given HTTP client when set.
+func loadCredentialsSecretProfile(ctx context.Context, profile *scw.Profile, config *CredentialsSecretConfig, httpClient *http.Client) (*scw.Profile, error) {
+	key := credentialsSecretCacheKey{
+		secretID: config.SecretID,
+		revision: config.Revision,
+	}
+
+	if profile.AccessKey != nil {
+		key.accessKey = *profile.AccessKey
+	}
+
+	if profile.APIURL != nil {
+		key.apiURL = *profile.APIURL
+	}
+
+	credentialsSecretCache.Lock()
+	defer credentialsSecretCache.Unlock()
+
+	if secretProfile, cached := credentialsSecretCache.profiles[key]; cached {
+		profileCopy := *secretProfile
+
+		return &profileCopy, nil
+	}
+
+	secretProfile, err := accessCredentialsSecret(ctx, profile, config, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	profileCopy := *secretProfile
+	credentialsSecretCache.profiles[key] = &profileCopy
+
+	return secretProfile, nil
+}
+
+// accessCredentialsSecret accesses the secret version of the credentials secret and decodes its payload
+func accessCredentialsSecret(ctx context.Context, profile *scw.Profile, config *CredentialsSecretConfig, httpClient *http.Client) (*scw.Profile, error) {
+	opts := []scw.ClientOption{scw.WithProfile(profile)}
+	if httpClient != nil {
+		opts = append(opts, scw.WithHTTPClient(httpClient))
+	}
+
+	client, err := scw.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	region, secretID, err := regional.ParseID(config.SecretID)
+	if err != nil {
+		region, _ = client.GetDefaultRegion()
+		secretID = config.SecretID
+	}
+
+	revision := config.Revision
+	if revision == "" {
+		revision = defaultCredentialsSecretRevision
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("loading credentials from secret %s/%s revision %s", region, secretID, revision))
+
+	version, err := secret.NewAPI(client).AccessSecretVersion(&secret.AccessSecretVersionRequest{
+		Region:   region,
+		SecretID: secretID,
+		Revision: revision,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("cannot access credentials secret %s: %w", config.SecretID, err)
+	}
+
+	payload := credentialsSecretPayload{}
+
+	err = json.Unmarshal(version.Data, &payload)
+	if err != nil {
+		return nil, fmt.Errorf("credentials secret %s must contain a JSON object: %w", config.SecretID, err)
+	}
+
+	if payload.AccessKey == "" || payload.SecretKey == "" {
+		return nil, fmt.Errorf("credentials secret %s must contain both access_key and secret_key", config.SecretID)
+	}
+
+	secretProfile := &scw.Profile{
+		AccessKey: &payload.AccessKey,
+		SecretKey: &payload.SecretKey,
+	}
+
+	if payload.ProjectID != "" {
+		secretProfile.DefaultProjectID = &payload.ProjectID
+	}
+
+	if payload.OrganizationID != "" {
+		secretProfile.DefaultOrganizationID = &payload.OrganizationID
+	}
+
+	return secretProfile, nil
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,7 @@ const (
 	CredentialsSourceActiveProfile   = "Active Profile in config.yaml"
 	CredentialsSourceProviderProfile = "Profile defined in provider{} block"
 	CredentialsSourceInferred        = "CredentialsSourceInferred from default zone"
+	CredentialsSourceSecretManager   = "Secret Manager secret version in provider{} block"
 )
 
 type CredentialsSource struct {
@@ -48,14 +50,83 @@ type Meta struct {
 	httpClient *http.Client
 	// credentialsSource stores information about the source (env, profile, etc.) of each credential
 	credentialsSource *CredentialsSource
+	// defaultTags are the tags from the provider default_tags block, merged into the tags of every taggable resource
+	defaultTags []string
+	// ignoreTags are the tag keys and key prefixes from the provider ignore_tags block, filtered out of the tags read from the API
+	ignoreTags *IgnoreTagsConfig
+	// defaultTimeouts are the timeouts from the provider default_timeouts block, used unless a resource sets its own
+	defaultTimeouts DefaultTimeouts
+}
+
+// DefaultTimeoutsConfig contains the provider default_timeouts block, as durations strings.
+type DefaultTimeoutsConfig struct {
+	Create       string
+	Read         string
+	Update       string
+	Delete       string
+	Default      string
+	PollInterval string
+}
+
+// DefaultTimeouts are the timeouts of the provider default_timeouts block, nil when not set.
+type DefaultTimeouts struct {
+	Create  *time.Duration
+	Read    *time.Duration
+	Update  *time.Duration
+	Delete  *time.Duration
+	Default *time.Duration
+	// PollInterval is the interval of the waits, passed to the waiters through the context of the requests
+	PollInterval *time.Duration
+}
+
+// TimeoutOr returns the timeout, or fallback when it is not set.
+func TimeoutOr(timeout *time.Duration, fallback time.Duration) time.Duration {
+	if timeout == nil {
+		return fallback
+	}
+
+	return *timeout
+}
+
+// RetryConfig contains the provider retry block.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first one
+	MaxAttempts   int
+	MinBackoff    string
+	MaxBackoff    string
+	RetryOnStatus []int
+}
+
+// IgnoreTagsConfig contains the provider ignore_tags block.
+type IgnoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
 }
 
 // NewMeta creates the Meta object containing the SDK client.
 func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
+	httpClient := config.HTTPClient
+	profileHTTPClient := config.HTTPClient
+
+	if httpClient == nil {
+		var err error
+
+		httpClient, err = newHTTPClient(expandRetryConfig(config.ProviderSchema), expandMaxRequestsPerSecond(config.ProviderSchema), expandHTTPLogFile(config.ProviderSchema))
+		if err != nil {
+			return nil, err
+		}
+
+		// The credentials secret is accessed without the HTTP log, as its response holds the credentials of the provider
+		profileHTTPClient, err = newHTTPClient(expandRetryConfig(config.ProviderSchema), expandMaxRequestsPerSecond(config.ProviderSchema), "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	////
 	// Load Profile
 	////
-	profile, credentialsSource, err := LoadProfile(ctx, config.ProviderSchema)
+	profile, credentialsSource, err := LoadProfile(ctx, config.ProviderSchema, profileHTTPClient)
 	if err != nil {
 		return nil, err
 	}
@@ -92,17 +163,105 @@ func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
 	// Return scaleway client
 	////
 
-	return NewMetaFromProfile(ctx, profile, credentialsSource, config.TerraformVersion, config.HTTPClient)
+	m, err := NewMetaFromProfile(ctx, profile, credentialsSource, config.TerraformVersion, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	m.defaultTags = expandDefaultTags(config.ProviderSchema)
+	m.ignoreTags = expandIgnoreTags(config.ProviderSchema)
+
+	m.defaultTimeouts, err = parseDefaultTimeouts(expandDefaultTimeouts(config.ProviderSchema))
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
 }
 
 // NewMetaFromFrameworkConfig creates a Meta object from FrameworkProviderConfig
 func NewMetaFromFrameworkConfig(ctx context.Context, config *FrameworkProviderConfig, terraformVersion string) (*Meta, error) {
-	profile, credentialsSource, err := LoadProfileFromFrameworkConfig(ctx, config)
+	httpClient, err := newHTTPClient(config.Retry, config.MaxRequestsPerSecond, httpLogFile(config.HTTPLogFile))
+	if err != nil {
+		return nil, err
+	}
+
+	// The credentials secret is accessed without the HTTP log, as its response holds the credentials of the provider
+	profileHTTPClient, err := newHTTPClient(config.Retry, config.MaxRequestsPerSecond, "")
+	if err != nil {
+		return nil, err
+	}
+
+	profile, credentialsSource, err := LoadProfileFromFrameworkConfig(ctx, config, profileHTTPClient)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := NewMetaFromProfile(ctx, profile, credentialsSource, terraformVersion, httpClient)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewMetaFromProfile(ctx, profile, credentialsSource, terraformVersion, nil)
+	m.defaultTags = config.DefaultTags
+	m.ignoreTags = config.IgnoreTags
+
+	m.defaultTimeouts, err = parseDefaultTimeouts(config.DefaultTimeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// newHTTPClient creates the HTTP client shared by resources, retrying, rate limiting and logging requests as configured in the provider block.
+func newHTTPClient(retry *RetryConfig, maxRequestsPerSecond float64, httpLogPath string) (*http.Client, error) {
+	retryOptions := transport.RetryableTransportOptions{}
+
+	if retry != nil {
+		if retry.MaxAttempts > 0 {
+			retryOptions.RetryMax = new(retry.MaxAttempts - 1)
+		}
+
+		if retry.MinBackoff != "" {
+			minBackoff, err := time.ParseDuration(retry.MinBackoff)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retry min_backoff: %w", err)
+			}
+
+			retryOptions.RetryWaitMin = &minBackoff
+		}
+
+		if retry.MaxBackoff != "" {
+			maxBackoff, err := time.ParseDuration(retry.MaxBackoff)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retry max_backoff: %w", err)
+			}
+
+			retryOptions.RetryWaitMax = &maxBackoff
+		}
+
+		if retryOptions.RetryWaitMin != nil && retryOptions.RetryWaitMax != nil && *retryOptions.RetryWaitMin > *retryOptions.RetryWaitMax {
+			return nil, fmt.Errorf("retry min_backoff (%s) must not be greater than max_backoff (%s)", retry.MinBackoff, retry.MaxBackoff)
+		}
+
+		retryOptions.RetryOnStatus = retry.RetryOnStatus
+	}
+
+	if maxRequestsPerSecond < 0 {
+		return nil, fmt.Errorf("max_requests_per_second must not be negative, got %v", maxRequestsPerSecond)
+	}
+
+	httpLogTransport, err := transport.NewHTTPLogTransport(http.DefaultTransport, httpLogPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open HTTP log file: %w", err)
+	}
+
+	return &http.Client{
+		Transport: transport.NewRetryableTransportWithOptions(
+			transport.NewRateLimitedTransport(httpLogTransport, maxRequestsPerSecond),
+			retryOptions,
+		),
+	}, nil
 }
 
 func NewMetaFromProfile(ctx context.Context, profile *scw.Profile, credentialsSource *CredentialsSource, terraformVersion string, httpClient *http.Client) (*Meta, error) {
@@ -136,6 +295,26 @@ func (m Meta) HTTPClient() *http.Client {
 	return m.httpClient
 }
 
+// DefaultTags returns the tags configured in the provider default_tags block.
+func (m Meta) DefaultTags() []string {
+	return m.defaultTags
+}
+
+// DefaultTimeouts returns the timeouts configured in the provider default_timeouts block.
+func (m Meta) DefaultTimeouts() DefaultTimeouts {
+	return m.defaultTimeouts
+}
+
+// IgnoreTags returns the tag keys and key prefixes configured in the provider ignore_tags block.
+// It returns an empty config when the block is not set.
+func (m Meta) IgnoreTags() *IgnoreTagsConfig {
+	if m.ignoreTags == nil {
+		return &IgnoreTagsConfig{}
+	}
+
+	return m.ignoreTags
+}
+
 func (m Meta) AccessKeySource() string {
 	return m.credentialsSource.AccessKey
 }
@@ -190,6 +369,11 @@ func (m Meta) HasMultipleVariableSources() (bool, string, error) {
 	for _, variable := range variables {
 		values, ok := m.credentialsSource.Variables[variable]
 		if ok {
+			// Accessing the credentials secret requires other credentials, which are expected to be overridden
+			if values[len(values)-1] == CredentialsSourceSecretManager {
+				continue
+			}
+
 			if len(values) > 1 {
 				_, err := fmt.Fprintf(w, "%s\t%s\t%s\n", variable, strings.Join(values, ", "), values[len(values)-1])
 				if err != nil {
@@ -238,9 +422,20 @@ type FrameworkProviderConfig struct {
 	Region         string
 	Zone           string
 	APIURL         string
+	DefaultTags    []string
+	IgnoreTags     *IgnoreTagsConfig
+	Retry          *RetryConfig
+	// CredentialsSecret is the secret version containing the credentials to use instead of the other sources
+	CredentialsSecret *CredentialsSecretConfig
+	// DefaultTimeouts are the timeouts used by resources and actions which do not set their own
+	DefaultTimeouts *DefaultTimeoutsConfig
+	// HTTPLogFile is the path of the file where requests are logged, empty to only use SCW_TF_HTTP_LOG_FILE
+	HTTPLogFile string
+	// MaxRequestsPerSecond limits the rate of requests sent to the API, 0 means no limit
+	MaxRequestsPerSecond float64
 }
 
-func LoadProfileFromFrameworkConfig(ctx context.Context, config *FrameworkProviderConfig) (*scw.Profile, *CredentialsSource, error) {
+func LoadProfileFromFrameworkConfig(ctx context.Context, config *FrameworkProviderConfig, httpClient *http.Client) (*scw.Profile, *CredentialsSource, error) {
 	scwConfig, err := scw.LoadConfig()
 	// If the config file do not exist, don't return an error as we may find config in ENV or flags.
 	if _, ok := errors.AsType[*scw.ConfigFileNotFoundError](err); ok {
@@ -316,11 +511,16 @@ func LoadProfileFromFrameworkConfig(ctx context.Context, config *FrameworkProvid
 		}
 	}
 
+	profile, err = applyCredentialsSecret(ctx, profile, credentialsSource, config.CredentialsSecret, httpClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return profile, credentialsSource, nil
 }
 
 //gocyclo:ignore
-func LoadProfile(ctx context.Context, d *schema.ResourceData) (*scw.Profile, *CredentialsSource, error) {
+func LoadProfile(ctx context.Context, d *schema.ResourceData, httpClient *http.Client) (*scw.Profile, *CredentialsSource, error) {
 	config, err := scw.LoadConfig()
 	// If the config file do not exist, don't return an error as we may find config in ENV or flags.
 	if _, ok := errors.AsType[*scw.ConfigFileNotFoundError](err); ok {
@@ -400,9 +600,163 @@ func LoadProfile(ctx context.Context, d *schema.ResourceData) (*scw.Profile, *Cr
 		}
 	}
 
+	profile, err = applyCredentialsSecret(ctx, profile, credentialsSource, expandCredentialsSecret(d), httpClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return profile, credentialsSource, nil
 }
 
+// expandDefaultTags reads the tags of the provider default_tags block
+func expandDefaultTags(d *schema.ResourceData) []string {
+	if d == nil {
+		return nil
+	}
+
+	rawTags, exist := d.GetOk("default_tags.0.tags")
+	if !exist {
+		return nil
+	}
+
+	return expandNonEmptyStrings(rawTags)
+}
+
+// expandIgnoreTags reads the tag keys and key prefixes of the provider ignore_tags block
+func expandIgnoreTags(d *schema.ResourceData) *IgnoreTagsConfig {
+	if d == nil {
+		return nil
+	}
+
+	if _, exist := d.GetOk("ignore_tags.0"); !exist {
+		return nil
+	}
+
+	return &IgnoreTagsConfig{
+		Keys:        expandNonEmptyStrings(d.Get("ignore_tags.0.keys")),
+		KeyPrefixes: expandNonEmptyStrings(d.Get("ignore_tags.0.key_prefixes")),
+	}
+}
+
+// expandRetryConfig reads the provider retry block
+func expandRetryConfig(d *schema.ResourceData) *RetryConfig {
+	if d == nil {
+		return nil
+	}
+
+	if _, exist := d.GetOk("retry.0"); !exist {
+		return nil
+	}
+
+	retry := &RetryConfig{
+		MaxAttempts: d.Get("retry.0.max_attempts").(int),
+		MinBackoff:  d.Get("retry.0.min_backoff").(string),
+		MaxBackoff:  d.Get("retry.0.max_backoff").(string),
+	}
+
+	for _, status := range d.Get("retry.0.retry_on_status").([]any) {
+		retry.RetryOnStatus = append(retry.RetryOnStatus, status.(int))
+	}
+
+	return retry
+}
+
+// expandMaxRequestsPerSecond reads the provider max_requests_per_second argument
+func expandMaxRequestsPerSecond(d *schema.ResourceData) float64 {
+	if d == nil {
+		return 0
+	}
+
+	return d.Get("max_requests_per_second").(float64)
+}
+
+// expandHTTPLogFile reads the provider http_log_file argument, defaulting to the SCW_TF_HTTP_LOG_FILE environment variable
+func expandHTTPLogFile(d *schema.ResourceData) string {
+	if d == nil {
+		return httpLogFile("")
+	}
+
+	return httpLogFile(d.Get("http_log_file").(string))
+}
+
+// httpLogFile returns the path of the HTTP log file from the provider configuration or the SCW_TF_HTTP_LOG_FILE environment variable
+func httpLogFile(configured string) string {
+	if configured != "" {
+		return configured
+	}
+
+	return os.Getenv(env.HTTPLogFile)
+}
+
+// expandDefaultTimeouts reads the provider default_timeouts block
+func expandDefaultTimeouts(d *schema.ResourceData) *DefaultTimeoutsConfig {
+	if d == nil {
+		return nil
+	}
+
+	if _, exist := d.GetOk("default_timeouts.0"); !exist {
+		return nil
+	}
+
+	return &DefaultTimeoutsConfig{
+		Create:       d.Get("default_timeouts.0.create").(string),
+		Read:         d.Get("default_timeouts.0.read").(string),
+		Update:       d.Get("default_timeouts.0.update").(string),
+		Delete:       d.Get("default_timeouts.0.delete").(string),
+		Default:      d.Get("default_timeouts.0.default").(string),
+		PollInterval: d.Get("default_timeouts.0.poll_interval").(string),
+	}
+}
+
+// parseDefaultTimeouts parses the durations of the provider default_timeouts block.
+func parseDefaultTimeouts(config *DefaultTimeoutsConfig) (DefaultTimeouts, error) {
+	timeouts := DefaultTimeouts{}
+
+	if config == nil {
+		return timeouts, nil
+	}
+
+	for _, timeout := range []struct {
+		name     string
+		value    string
+		duration **time.Duration
+	}{
+		{"create", config.Create, &timeouts.Create},
+		{"read", config.Read, &timeouts.Read},
+		{"update", config.Update, &timeouts.Update},
+		{"delete", config.Delete, &timeouts.Delete},
+		{"default", config.Default, &timeouts.Default},
+		{"poll_interval", config.PollInterval, &timeouts.PollInterval},
+	} {
+		if timeout.value == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(timeout.value)
+		if err != nil {
+			return timeouts, fmt.Errorf("invalid default_timeouts %s: %w", timeout.name, err)
+		}
+
+		*timeout.duration = &duration
+	}
+
+	return timeouts, nil
+}
+
+// expandNonEmptyStrings converts a list of strings from the provider schema, skipping empty values
+func expandNonEmptyStrings(raw any) []string {
+	rawList, _ := raw.([]any)
+	values := make([]string, 0, len(rawList))
+
+	for _, value := range rawList {
+		if value, ok := value.(string); ok && value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
 // GetCredentialsSource infers the source of the credentials based on the priority order of the different profiles
 func GetCredentialsSource(defaultZoneProfile, activeProfile, providerProfile, envProfile *scw.Profile) *CredentialsSource {
 	type SourceProfilePair struct {
@@ -432,43 +786,62 @@ func GetCredentialsSource(defaultZoneProfile, activeProfile, providerProfile, en
 	credentialsSource.Variables = map[string][]string{}
 
 	for _, pair := range profilesInOrder {
-		source := pair.Source
-		profile := pair.Profile
+		credentialsSource.add(pair.Source, pair.Profile)
+	}
 
-		if profile.AccessKey != nil {
-			credentialsSource.AccessKey = source
-			credentialsSource.Variables[scw.ScwAccessKeyEnv] = append(credentialsSource.Variables[scw.ScwAccessKeyEnv], source)
-		}
+	return credentialsSource
+}
 
-		if profile.SecretKey != nil {
-			credentialsSource.SecretKey = source
-			credentialsSource.Variables[scw.ScwSecretKeyEnv] = append(credentialsSource.Variables[scw.ScwSecretKeyEnv], source)
-		}
+// add records source as the source of every variable set in profile, the last added source being the one in use
+func (c *CredentialsSource) add(source string, profile *scw.Profile) {
+	if profile.AccessKey != nil {
+		c.AccessKey = source
+		c.Variables[scw.ScwAccessKeyEnv] = append(c.Variables[scw.ScwAccessKeyEnv], source)
+	}
 
-		if profile.DefaultProjectID != nil {
-			credentialsSource.ProjectID = source
-			credentialsSource.Variables[scw.ScwDefaultProjectIDEnv] = append(credentialsSource.Variables[scw.ScwDefaultProjectIDEnv], source)
-		}
+	if profile.SecretKey != nil {
+		c.SecretKey = source
+		c.Variables[scw.ScwSecretKeyEnv] = append(c.Variables[scw.ScwSecretKeyEnv], source)
+	}
 
-		if profile.DefaultOrganizationID != nil {
-			credentialsSource.OrganizationID = source
-			credentialsSource.Variables[scw.ScwDefaultOrganizationIDEnv] = append(credentialsSource.Variables[scw.ScwDefaultOrganizationIDEnv], source)
-		}
+	if profile.DefaultProjectID != nil {
+		c.ProjectID = source
+		c.Variables[scw.ScwDefaultProjectIDEnv] = append(c.Variables[scw.ScwDefaultProjectIDEnv], source)
+	}
 
-		if profile.DefaultRegion != nil {
-			credentialsSource.DefaultRegion = source
-			if source != CredentialsSourceDefault {
-				credentialsSource.Variables[scw.ScwDefaultRegionEnv] = append(credentialsSource.Variables[scw.ScwDefaultRegionEnv], source)
-			}
+	if profile.DefaultOrganizationID != nil {
+		c.OrganizationID = source
+		c.Variables[scw.ScwDefaultOrganizationIDEnv] = append(c.Variables[scw.ScwDefaultOrganizationIDEnv], source)
+	}
+
+	if profile.DefaultRegion != nil {
+		c.DefaultRegion = source
+		if source != CredentialsSourceDefault {
+			c.Variables[scw.ScwDefaultRegionEnv] = append(c.Variables[scw.ScwDefaultRegionEnv], source)
 		}
+	}
 
-		if profile.DefaultZone != nil {
-			credentialsSource.DefaultZone = source
-			if source != CredentialsSourceDefault {
-				credentialsSource.Variables[scw.ScwDefaultZoneEnv] = append(credentialsSource.Variables[scw.ScwDefaultZoneEnv], source)
-			}
+	if profile.DefaultZone != nil {
+		c.DefaultZone = source
+		if source != CredentialsSourceDefault {
+			c.Variables[scw.ScwDefaultZoneEnv] = append(c.Variables[scw.ScwDefaultZoneEnv], source)
 		}
 	}
+}
 
-	return credentialsSource
+// applyCredentialsSecret overrides the credentials of the profile with the ones stored in the Secret Manager secret version
+// of the provider credentials_secret block. They take precedence over every other source.
+func applyCredentialsSecret(ctx context.Context, profile *scw.Profile, credentialsSource *CredentialsSource, config *CredentialsSecretConfig, httpClient *http.Client) (*scw.Profile, error) {
+	if config == nil {
+		return profile, nil
+	}
+
+	secretProfile, err := loadCredentialsSecretProfile(ctx, profile, config, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsSource.add(CredentialsSourceSecretManager, secretProfile)
+
+	return scw.MergeProfiles(profile, secretProfile), nil
 }
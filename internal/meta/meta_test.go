@@ -1,9 +1,13 @@
 package meta_test
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/stretchr/testify/assert"
@@ -47,3 +51,140 @@ SCW_DEFAULT_PROJECT_ID     Active Profile in config.yaml, Environment variable
 `
 	assert.Equal(t, expectedMessage, message)
 }
+
+func TestLoadProfileFromFrameworkConfig_CredentialsSecret(t *testing.T) {
+	t.Setenv("SCW_CONFIG_PATH", path.Join(t.TempDir(), "config.yaml"))
+	t.Setenv("SCW_ACCESS_KEY", "SCWXXXXXXXXXXXXXXXXX")
+	t.Setenv("SCW_SECRET_KEY", "866F4A9A-D058-4D3C-A39F-86930849CCC0")
+	t.Setenv("SCW_DEFAULT_PROJECT_ID", "866F4A9A-D058-4D3C-A39F-86930849CCC0")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/secret-manager/v1beta1/regions/nl-ams/secrets/11111111-1111-1111-1111-111111111111/versions/latest_enabled/access", r.URL.Path)
+		assert.Equal(t, "866F4A9A-D058-4D3C-A39F-86930849CCC0", r.Header.Get("X-Auth-Token"))
+
+		data, _ := json.Marshal(map[string]string{
+			"access_key": "SCWYYYYYYYYYYYYYYYYY",
+			"secret_key": "22222222-2222-2222-2222-222222222222",
+			"project_id": "33333333-3333-3333-3333-333333333333",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"secret_id": "11111111-1111-1111-1111-111111111111",
+			"revision":  1,
+			"data":      data,
+		})
+	}))
+	defer server.Close()
+
+	profile, credentialsSource, err := meta.LoadProfileFromFrameworkConfig(t.Context(), &meta.FrameworkProviderConfig{
+		APIURL: server.URL,
+		CredentialsSecret: &meta.CredentialsSecretConfig{
+			SecretID: "nl-ams/11111111-1111-1111-1111-111111111111",
+		},
+	}, server.Client())
+	require.NoError(t, err)
+
+	assert.Equal(t, "SCWYYYYYYYYYYYYYYYYY", *profile.AccessKey)
+	assert.Equal(t, "22222222-2222-2222-2222-222222222222", *profile.SecretKey)
+	assert.Equal(t, "33333333-3333-3333-3333-333333333333", *profile.DefaultProjectID)
+	assert.Equal(t, meta.CredentialsSourceSecretManager, credentialsSource.AccessKey)
+	assert.Equal(t, meta.CredentialsSourceSecretManager, credentialsSource.SecretKey)
+	assert.Equal(t, meta.CredentialsSourceSecretManager, credentialsSource.ProjectID)
+}
+
+func TestNewMetaFromFrameworkConfig_CredentialsSecret(t *testing.T) {
+	t.Setenv("SCW_CONFIG_PATH", path.Join(t.TempDir(), "config.yaml"))
+	t.Setenv("SCW_ACCESS_KEY", "SCWXXXXXXXXXXXXXXXXX")
+	t.Setenv("SCW_SECRET_KEY", "866F4A9A-D058-4D3C-A39F-86930849CCC0")
+	t.Setenv("SCW_DEFAULT_PROJECT_ID", "866F4A9A-D058-4D3C-A39F-86930849CCC0")
+
+	accesses := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		accesses++
+
+		data, _ := json.Marshal(map[string]string{
+			"access_key": "SCWYYYYYYYYYYYYYYYYY",
+			"secret_key": "22222222-2222-2222-2222-222222222222",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"secret_id": "44444444-4444-4444-4444-444444444444",
+			"revision":  1,
+			"data":      data,
+		})
+	}))
+	defer server.Close()
+
+	httpLogPath := path.Join(t.TempDir(), "http.log")
+	config := &meta.FrameworkProviderConfig{
+		APIURL:      server.URL,
+		HTTPLogFile: httpLogPath,
+		CredentialsSecret: &meta.CredentialsSecretConfig{
+			SecretID: "nl-ams/44444444-4444-4444-4444-444444444444",
+		},
+	}
+
+	// The SDKv2 and framework providers are both configured with the credentials secret
+	for range 2 {
+		m, err := meta.NewMetaFromFrameworkConfig(t.Context(), config, "")
+		require.NoError(t, err)
+
+		accessKey, _ := m.ScwClient().GetAccessKey()
+		assert.Equal(t, "SCWYYYYYYYYYYYYYYYYY", accessKey)
+
+		// The credentials used to access the secret are not reported as conflicting
+		multiple, message, err := m.HasMultipleVariableSources()
+		require.NoError(t, err)
+		assert.False(t, multiple, message)
+	}
+
+	assert.Equal(t, 1, accesses, "the secret version is accessed once")
+
+	httpLog, err := os.ReadFile(httpLogPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(httpLog), "secret-manager", "the secret version is not written to the HTTP log")
+}
+
+func TestNewMetaFromFrameworkConfig_DefaultTimeouts(t *testing.T) {
+	t.Setenv("SCW_CONFIG_PATH", path.Join(t.TempDir(), "config.yaml"))
+	t.Setenv("SCW_ACCESS_KEY", "SCWXXXXXXXXXXXXXXXXX")
+	t.Setenv("SCW_SECRET_KEY", "866F4A9A-D058-4D3C-A39F-86930849CCC0")
+	t.Setenv("SCW_DEFAULT_PROJECT_ID", "866F4A9A-D058-4D3C-A39F-86930849CCC0")
+
+	m, err := meta.NewMetaFromFrameworkConfig(t.Context(), &meta.FrameworkProviderConfig{
+		DefaultTimeouts: &meta.DefaultTimeoutsConfig{
+			Create:       "30m",
+			Delete:       "1h",
+			Default:      "45m",
+			PollInterval: "10s",
+		},
+	}, "")
+	require.NoError(t, err)
+
+	timeouts := m.DefaultTimeouts()
+	require.NotNil(t, timeouts.Create)
+	require.NotNil(t, timeouts.Delete)
+	require.NotNil(t, timeouts.Default)
+	require.NotNil(t, timeouts.PollInterval)
+	assert.Equal(t, 30*time.Minute, *timeouts.Create)
+	assert.Equal(t, time.Hour, *timeouts.Delete)
+	assert.Equal(t, 45*time.Minute, *timeouts.Default)
+	assert.Equal(t, 10*time.Second, *timeouts.PollInterval)
+	assert.Nil(t, timeouts.Read)
+	assert.Nil(t, timeouts.Update)
+
+	assert.Equal(t, 30*time.Minute, meta.TimeoutOr(timeouts.Create, 10*time.Minute))
+	assert.Equal(t, 10*time.Minute, meta.TimeoutOr(timeouts.Update, 10*time.Minute))
+
+	_, err = meta.NewMetaFromFrameworkConfig(t.Context(), &meta.FrameworkProviderConfig{
+		DefaultTimeouts: &meta.DefaultTimeoutsConfig{
+			Update: "soon",
+		},
+	}, "")
+	require.Error(t, err)
+}
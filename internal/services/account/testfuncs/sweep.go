@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_account_project", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_account_project", &resource.Sweeper{
 		Name: "scaleway_account_project",
 		F:    testSweepAccountProject,
 	})
@@ -27,6 +27,7 @@ var serverRebootActionDescription string
 
 type ServerAppleSiliconRebootAction struct {
 	appleSiliconAPI *applesilicon.API
+	meta            *meta.Meta
 }
 
 func (s *ServerAppleSiliconRebootAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
@@ -44,6 +45,7 @@ func (s *ServerAppleSiliconRebootAction) Configure(ctx context.Context, request
 		return
 	}
 
+	s.meta = m
 	s.appleSiliconAPI = applesilicon.NewAPI(m.ScwClient())
 }
 
@@ -135,6 +137,7 @@ func (s *ServerAppleSiliconRebootAction) Invoke(ctx context.Context, request act
 		_, err = s.appleSiliconAPI.WaitForServer(&applesilicon.WaitForServerRequest{
 			Zone:     scw.Zone(zone),
 			ServerID: serverID,
+			Timeout:  s.meta.DefaultTimeouts().Update,
 		}, scw.WithContext(ctx))
 		if err != nil {
 			response.Diagnostics.AddError(
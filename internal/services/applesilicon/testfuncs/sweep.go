@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_apple_silicon_instance", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_apple_silicon_instance", &resource.Sweeper{
 		Name: "scaleway_apple_silicon",
 		F:    testSweepAppleSiliconServer,
 	})
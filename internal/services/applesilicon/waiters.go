@@ -16,8 +16,8 @@ const (
 
 func waitForAppleSiliconServer(ctx context.Context, api *applesilicon.API, zone scw.Zone, serverID string, timeout time.Duration) (*applesilicon.Server, error) {
 	retryInterval := defaultAppleSiliconServerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	server, err := api.WaitForServer(&applesilicon.WaitForServerRequest{
@@ -32,8 +32,8 @@ func waitForAppleSiliconServer(ctx context.Context, api *applesilicon.API, zone
 
 func waitForAppleSiliconPrivateNetworkServer(ctx context.Context, api *applesilicon.PrivateNetworkAPI, zone scw.Zone, serverID string, timeout time.Duration) ([]*applesilicon.ServerPrivateNetwork, error) {
 	retryInterval := defaultAppleSiliconServerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	privateNetwork, err := api.WaitForServerPrivateNetworks(&applesilicon.WaitForServerRequest{
@@ -48,8 +48,8 @@ func waitForAppleSiliconPrivateNetworkServer(ctx context.Context, api *applesili
 
 func waitForTerminalVPCState(ctx context.Context, api *applesilicon.API, zone scw.Zone, serverID string, timeout time.Duration) error {
 	retryInterval := defaultAppleSiliconServerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	err := api.WaitForServerVPCOptionTerminalState(&applesilicon.WaitForServerRequest{
@@ -17,6 +17,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -32,6 +33,7 @@ func ResourceInstanceGroup() *schema.Resource {
 		Identity:      identity.DefaultZonal(),
 		SchemaVersion: 0,
 		SchemaFunc:    instanceGroupSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -57,6 +59,7 @@ func instanceGroupSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the Instance group",
 		},
+		"tags_all": tags.AllSchema(),
 		"capacity": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -144,7 +147,7 @@ func ResourceInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, m
 		Zone:         zone,
 		ProjectID:    d.Get("project_id").(string),
 		Name:         types.ExpandOrGenerateString(d.Get("name").(string), "instance-group"),
-		Tags:         types.ExpandStrings(d.Get("tags")),
+		Tags:         tags.Expand(d, m),
 		TemplateID:   locality.ExpandID(d.Get("template_id").(string)),
 		Capacity:     expandInstanceCapacity(d.Get("capacity")),
 		Loadbalancer: expandInstanceLoadBalancer(d.Get("load_balancer")),
@@ -183,7 +186,7 @@ func ResourceInstanceGroupRead(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	diags := setInstanceGroupState(d, group)
+	diags := setInstanceGroupState(d, m, group)
 
 	err = identity.SetZonalIdentity(d, group.Zone, group.ID)
 	if err != nil {
@@ -193,10 +196,10 @@ func ResourceInstanceGroupRead(ctx context.Context, d *schema.ResourceData, m an
 	return diags
 }
 
-func setInstanceGroupState(d *schema.ResourceData, group *autoscaling.InstanceGroup) diag.Diagnostics {
+func setInstanceGroupState(d *schema.ResourceData, m any, group *autoscaling.InstanceGroup) diag.Diagnostics {
 	_ = d.Set("name", group.Name)
 	_ = d.Set("template_id", zonal.NewIDString(group.Zone, group.InstanceTemplateID))
-	_ = d.Set("tags", group.Tags)
+	tags.Set(d, m, group.Tags)
 	_ = d.Set("capacity", flattenInstanceCapacity(group.Capacity))
 	_ = d.Set("load_balancer", flattenInstanceLoadBalancer(group.Loadbalancer, group.Zone))
 	_ = d.Set("created_at", types.FlattenTime(group.CreatedAt))
@@ -225,8 +228,8 @@ func ResourceInstanceGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
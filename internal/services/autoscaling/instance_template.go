@@ -15,6 +15,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -31,6 +32,7 @@ func ResourceInstanceTemplate() *schema.Resource {
 		Identity:      identity.DefaultZonal(),
 		SchemaVersion: 0,
 		SchemaFunc:    instanceTemplateSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -125,6 +127,7 @@ func instanceTemplateSchema() map[string]*schema.Schema {
 						Optional:    true,
 						Description: "List of tags assigned to the volume",
 					},
+					"tags_all": tags.AllSchema(),
 					"boot": {
 						Type:        schema.TypeBool,
 						Optional:    true,
@@ -201,7 +204,7 @@ func ResourceInstanceTemplateCreate(ctx context.Context, d *schema.ResourceData,
 		Zone:              zone,
 		CommercialType:    d.Get("commercial_type").(string),
 		ImageID:           types.ExpandStringPtr(locality.ExpandID(d.Get("image_id"))),
-		Tags:              types.ExpandStrings(d.Get("tags")),
+		Tags:              tags.Expand(d, m),
 		SecurityGroupID:   types.ExpandStringPtr(locality.ExpandID(d.Get("security_group_id"))),
 		PlacementGroupID:  types.ExpandStringPtr(locality.ExpandID(d.Get("placement_group_id"))),
 		PublicIPsV4Count:  types.ExpandUint32Ptr(d.Get("public_ips_v4_count")),
@@ -259,7 +262,7 @@ func ResourceInstanceTemplateRead(ctx context.Context, d *schema.ResourceData, m
 
 	_ = d.Set("name", template.Name)
 	_ = d.Set("commercial_type", template.CommercialType)
-	_ = d.Set("tags", template.Tags)
+	tags.Set(d, m, template.Tags)
 	_ = d.Set("public_ips_v4_count", types.FlattenUint32Ptr(template.PublicIPsV4Count))
 	_ = d.Set("public_ips_v6_count", types.FlattenUint32Ptr(template.PublicIPsV6Count))
 	_ = d.Set("private_network_ids", regional.NewIDStrings(pnRegion, template.PrivateNetworkIDs))
@@ -342,8 +345,8 @@ func ResourceInstanceTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -9,17 +9,17 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_autoscaling_instance_group", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_autoscaling_instance_group", &resource.Sweeper{
 		Name: "scaleway_autoscaling_instance_group",
 		F:    testSweepInstanceGroup,
 	})
 
-	resource.AddTestSweepers("scaleway_autoscaling_instance_template", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_autoscaling_instance_template", &resource.Sweeper{
 		Name: "scaleway_autoscaling_instance_template",
 		F:    testSweepInstanceTemplate,
 	})
 
-	resource.AddTestSweepers("scaleway_autoscaling_instance_policy", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_autoscaling_instance_policy", &resource.Sweeper{
 		Name: "scaleway_autoscaling_instance_policy",
 		F:    testSweepInstancePolicy,
 	})
@@ -25,6 +25,7 @@ var (
 
 type ServerBaremetalAction struct {
 	baremetalAPI *baremetal.API
+	meta         *meta.Meta
 }
 
 func (s *ServerBaremetalAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
@@ -42,6 +43,7 @@ func (s *ServerBaremetalAction) Configure(ctx context.Context, request action.Co
 		return
 	}
 
+	s.meta = m
 	s.baremetalAPI = baremetal.NewAPI(m.ScwClient())
 }
 
@@ -198,6 +200,7 @@ func (s *ServerBaremetalAction) Invoke(ctx context.Context, request action.Invok
 		_, err := s.baremetalAPI.WaitForServer(&baremetal.WaitForServerRequest{
 			Zone:     scw.Zone(zone),
 			ServerID: serverID,
+			Timeout:  s.meta.DefaultTimeouts().Update,
 		}, scw.WithContext(ctx))
 		if err != nil {
 			response.Diagnostics.AddError(
@@ -24,6 +24,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -49,6 +50,7 @@ func ResourceServer() *schema.Resource {
 			customDiffOffer(),
 			cdf.LocalityCheck("private_network.#.id"),
 			customDiffPrivateNetworkOption(),
+			tags.CustomizeDiff,
 		),
 	}
 }
@@ -203,6 +205,7 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 			Computed:    true,
 			Description: "Array of tags to associate with the server",
 		},
+		"tags_all":        tags.AllSchema(),
 		"zone":            zonal.Schema(),
 		"organization_id": account.OrganizationIDSchema(),
 		"project_id":      account.ProjectIDSchema(),
@@ -405,7 +408,7 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m any) di
 		ProjectID:   types.ExpandStringPtr(d.Get("project_id")),
 		Description: d.Get("description").(string),
 		OfferID:     offerID.ID,
-		Tags:        types.ExpandStrings(d.Get("tags")),
+		Tags:        tags.Expand(d, m),
 		Protected:   d.Get("protected").(bool),
 	}
 
@@ -572,7 +575,7 @@ func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m any) diag
 	_ = d.Set("offer_id", zonal.NewIDString(server.Zone, offer.ID))
 	_ = d.Set("offer_name", offer.Name)
 	_ = d.Set("offer", zonal.NewIDString(server.Zone, offer.ID))
-	_ = d.Set("tags", server.Tags)
+	tags.Set(d, m, server.Tags)
 	_ = d.Set("domain", server.Domain)
 	_ = d.Set("ips", flattenIPs(server.IPs))
 	_ = d.Set("ipv4", flattenIPv4s(server.IPs))
@@ -782,8 +785,8 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m any) di
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -74,6 +75,7 @@ func DataSourceServerRead(ctx context.Context, d *schema.ResourceData, m any) di
 	}
 
 	diags := ResourceServerRead(ctx, d, m)
+	tags.SetDataSource(d, m)
 	if diags != nil {
 		return diags
 	}
@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_baremetal_server", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_baremetal_server", &resource.Sweeper{
 		Name: "scaleway_baremetal_server",
 		F:    testSweepServer,
 	})
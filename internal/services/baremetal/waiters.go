@@ -12,8 +12,8 @@ import (
 
 func waitForServer(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (*baremetal.Server, error) {
 	retryInterval := retryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	server, err := api.WaitForServer(&baremetal.WaitForServerRequest{
@@ -28,8 +28,8 @@ func waitForServer(ctx context.Context, api *baremetal.API, zone scw.Zone, serve
 
 func waitForServerInstall(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (*baremetal.Server, error) {
 	retryInterval := retryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	server, err := api.WaitForServerInstall(&baremetal.WaitForServerInstallRequest{
@@ -44,8 +44,8 @@ func waitForServerInstall(ctx context.Context, api *baremetal.API, zone scw.Zone
 
 func waitForServerOptions(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (*baremetal.Server, error) {
 	retryInterval := retryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	server, err := api.WaitForServerOptions(&baremetal.WaitForServerOptionsRequest{
@@ -60,8 +60,8 @@ func waitForServerOptions(ctx context.Context, api *baremetal.API, zone scw.Zone
 
 func waitForServerPrivateNetwork(ctx context.Context, api *baremetalV3.PrivateNetworkAPI, zone scw.Zone, serverID string, timeout time.Duration) ([]*baremetalV3.ServerPrivateNetwork, error) {
 	retryInterval := retryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	serverPrivateNetwork, err := api.WaitForServerPrivateNetworks(&baremetalV3.WaitForServerPrivateNetworksRequest{
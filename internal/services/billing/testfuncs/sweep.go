@@ -11,15 +11,15 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_billing_budget", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_billing_budget", &resource.Sweeper{
 		Name: "scaleway_billing_budget",
 		F:    testSweepBillingBudget,
 	})
-	resource.AddTestSweepers("scaleway_billing_budget_alert", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_billing_budget_alert", &resource.Sweeper{
 		Name: "scaleway_billing_budget_alert",
 		F:    testSweepBillingBudgetAlert,
 	})
-	resource.AddTestSweepers("scaleway_billing_budget_alert_notification", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_billing_budget_alert_notification", &resource.Sweeper{
 		Name: "scaleway_billing_budget_alert_notification",
 		F:    testSweepBillingBudgetAlertNotification,
 	})
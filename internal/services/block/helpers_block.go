@@ -115,7 +115,7 @@ func migrateInstanceToBlockVolume(ctx context.Context, api *instancehelpers.Bloc
 		return nil, err
 	}
 
-	blockVolume, err := waitForBlockVolume(ctx, api.BlockAPI, zone, volumeID, timeout)
+	blockVolume, err := instancehelpers.WaitForBlockVolume(ctx, api.BlockAPI, zone, volumeID, timeout)
 	if err != nil {
 		return nil, err
 	}
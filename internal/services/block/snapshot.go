@@ -14,6 +14,8 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/instancehelpers"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -36,6 +38,7 @@ func ResourceSnapshot() *schema.Resource {
 		SchemaVersion: 0,
 		Identity:      identity.DefaultZonal(),
 		SchemaFunc:    snapshotSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -63,6 +66,7 @@ func snapshotSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the snapshot",
 		},
+		"tags_all": tags.AllSchema(),
 		"import": {
 			Type:     schema.TypeList,
 			ForceNew: true,
@@ -139,7 +143,7 @@ func ResourceBlockSnapshotCreate(ctx context.Context, d *schema.ResourceData, m
 			ProjectID: d.Get("project_id").(string),
 			Name:      types.ExpandOrGenerateString(d.Get("name").(string), "snapshot"),
 			VolumeID:  locality.ExpandID(d.Get("volume_id")),
-			Tags:      types.ExpandStrings(d.Get("tags")),
+			Tags:      tags.Expand(d, m),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
@@ -151,7 +155,7 @@ func ResourceBlockSnapshotCreate(ctx context.Context, d *schema.ResourceData, m
 			Name:      types.ExpandOrGenerateString(d.Get("name"), "snapshot"),
 			Bucket:    regional.ExpandID(d.Get("import.0.bucket")).ID,
 			Key:       d.Get("import.0.key").(string),
-			Tags:      types.ExpandStrings(d.Get("tags")),
+			Tags:      tags.Expand(d, m),
 		}
 
 		snapshot, err = api.ImportSnapshotFromObjectStorage(req, scw.WithContext(ctx))
@@ -204,7 +208,7 @@ func ResourceBlockSnapshotRead(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	setSnapshotState(d, snapshot)
+	setSnapshotState(d, m, snapshot)
 
 	err = identity.SetZonalIdentity(d, snapshot.Zone, snapshot.ID)
 	if err != nil {
@@ -240,8 +244,8 @@ func ResourceBlockSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m
 		req.Name = types.ExpandUpdatedStringPtr(d.Get("name"))
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if _, err := api.UpdateSnapshot(req, scw.WithContext(ctx)); err != nil {
@@ -271,7 +275,7 @@ func ResourceBlockSnapshotDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	snapshot, err := waitForBlockSnapshotToBeAvailable(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
+	snapshot, err := instancehelpers.WaitForBlockSnapshotToBeAvailable(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -292,14 +296,14 @@ func ResourceBlockSnapshotDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func setSnapshotState(resourceData *schema.ResourceData, snapshot *block.Snapshot) {
+func setSnapshotState(resourceData *schema.ResourceData, m any, snapshot *block.Snapshot) {
 	if snapshot == nil {
 		return
 	}
 
 	_ = resourceData.Set("name", snapshot.Name)
 	_ = resourceData.Set("project_id", snapshot.ProjectID)
-	_ = resourceData.Set("tags", snapshot.Tags)
+	tags.Set(resourceData, m, snapshot.Tags)
 	_ = resourceData.Set("zone", snapshot.Zone)
 
 	if snapshot.ParentVolume != nil {
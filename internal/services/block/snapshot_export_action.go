@@ -20,7 +20,8 @@ var (
 )
 
 type ExportSnapshot struct {
-	api *block.API
+	api  *block.API
+	meta *meta.Meta
 }
 
 func (e *ExportSnapshot) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
@@ -38,6 +39,7 @@ func (e *ExportSnapshot) Configure(_ context.Context, req action.ConfigureReques
 		return
 	}
 
+	e.meta = m
 	client := m.ScwClient()
 	e.api = block.NewAPI(client)
 }
@@ -148,6 +150,7 @@ func (e *ExportSnapshot) Invoke(ctx context.Context, req action.InvokeRequest, r
 		_, err = e.api.WaitForSnapshot(&block.WaitForSnapshotRequest{
 			SnapshotID: snapshotID,
 			Zone:       scw.Zone(zone),
+			Timeout:    e.meta.DefaultTimeouts().Update,
 		}, scw.WithContext(ctx))
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -252,7 +252,7 @@ func (r *SnapshotListResource) List(ctx context.Context, req list.ListRequest, s
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			setSnapshotState(resourceData, row.Snapshot)
+			setSnapshotState(resourceData, r.meta, row.Snapshot)
 
 			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
 			if errTfTypeResourceState != nil {
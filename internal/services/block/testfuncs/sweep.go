@@ -9,11 +9,11 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_block_snapshot", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_block_snapshot", &resource.Sweeper{
 		Name: "scaleway_block_snapshot",
 		F:    testSweepSnapshot,
 	})
-	resource.AddTestSweepers("scaleway_block_volume", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_block_volume", &resource.Sweeper{
 		Name: "scaleway_block_volume",
 		F:    testSweepBlockVolume,
 	})
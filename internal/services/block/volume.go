@@ -15,6 +15,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/instancehelpers"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -39,6 +40,7 @@ func ResourceVolume() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customDiffSnapshot("snapshot_id"),
 			customDiffCannotShrink("size_in_gb"),
+			tags.CustomizeDiff,
 		),
 	}
 }
@@ -84,6 +86,7 @@ func volumeSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the volume",
 		},
+		"tags_all": tags.AllSchema(),
 		"srn": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -113,7 +116,7 @@ func ResourceBlockVolumeCreate(ctx context.Context, d *schema.ResourceData, m an
 			Zone:      zone,
 			Name:      types.ExpandOrGenerateString(d.Get("name").(string), "volume"),
 			ProjectID: d.Get("project_id").(string),
-			Tags:      types.ExpandStrings(d.Get("tags")),
+			Tags:      tags.Expand(d, m),
 			PerfIops:  types.ExpandUint32Ptr(d.Get("iops")),
 		}
 
@@ -150,7 +153,7 @@ func ResourceBlockVolumeCreate(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	_, err = waitForBlockVolume(ctx, api.BlockAPI, zone, volume.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = instancehelpers.WaitForBlockVolume(ctx, api.BlockAPI, zone, volume.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -164,7 +167,7 @@ func ResourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	volume, err := waitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutRead))
+	volume, err := instancehelpers.WaitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		if httperrors.Is404(err) {
 			d.SetId("")
@@ -175,7 +178,7 @@ func ResourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	setVolumeState(api, d, volume)
+	setVolumeState(api, d, m, volume)
 
 	err = identity.SetZonalIdentity(d, volume.Zone, id)
 	if err != nil {
@@ -191,7 +194,7 @@ func ResourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	volume, err := waitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutUpdate))
+	volume, err := instancehelpers.WaitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		if httperrors.Is404(err) {
 			d.SetId("")
@@ -216,8 +219,8 @@ func ResourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m an
 		req.Size = new(scw.Size(uint64(d.Get("size_in_gb").(int)) * gb))
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("iops") {
@@ -237,7 +240,7 @@ func ResourceBlockVolumeDelete(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	volume, err := waitForBlockVolumeToBeAvailable(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
+	volume, err := instancehelpers.WaitForBlockVolumeToBeAvailable(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if httperrors.Is404(err) {
 			d.SetId("")
@@ -256,7 +259,7 @@ func ResourceBlockVolumeDelete(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	_, err = waitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
+	_, err = instancehelpers.WaitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
 		return diag.FromErr(err)
 	}
@@ -264,14 +267,14 @@ func ResourceBlockVolumeDelete(ctx context.Context, d *schema.ResourceData, m an
 	return nil
 }
 
-func setVolumeState(api *block.API, resourceData *schema.ResourceData, volume *block.Volume) {
+func setVolumeState(api *block.API, resourceData *schema.ResourceData, m any, volume *block.Volume) {
 	if volume == nil {
 		return
 	}
 
 	_ = resourceData.Set("name", volume.Name)
 	_ = resourceData.Set("project_id", volume.ProjectID)
-	_ = resourceData.Set("tags", volume.Tags)
+	tags.Set(resourceData, m, volume.Tags)
 	_ = resourceData.Set("size_in_gb", int(volume.Size/scw.GB))
 	_ = resourceData.Set("zone", volume.Zone)
 
@@ -198,7 +198,7 @@ func (r *VolumeListResource) List(ctx context.Context, req list.ListRequest, str
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			setVolumeState(r.blockAPI, resourceData, row.Volume)
+			setVolumeState(r.blockAPI, resourceData, r.meta, row.Volume)
 
 			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
 			if errTfTypeResourceState != nil {
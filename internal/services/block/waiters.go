@@ -9,44 +9,10 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForBlockVolume(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Volume, error) {
-	retryInterval := defaultBlockRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
-
-	volume, err := blockAPI.WaitForVolumeAndReferences(&block.WaitForVolumeAndReferencesRequest{
-		Zone:          zone,
-		VolumeID:      id,
-		RetryInterval: &retryInterval,
-		Timeout:       new(timeout),
-	}, scw.WithContext(ctx))
-
-	return volume, err
-}
-
-func waitForBlockVolumeToBeAvailable(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Volume, error) {
-	retryInterval := defaultBlockRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
-
-	volume, err := blockAPI.WaitForVolumeAndReferences(&block.WaitForVolumeAndReferencesRequest{
-		Zone:          zone,
-		VolumeID:      id,
-		RetryInterval: &retryInterval,
-		Timeout:       new(timeout),
-
-		VolumeTerminalStatus: new(block.VolumeStatusAvailable),
-	}, scw.WithContext(ctx))
-
-	return volume, err
-}
-
 func waitForBlockSnapshot(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Snapshot, error) {
 	retryInterval := defaultBlockRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	snapshot, err := blockAPI.WaitForSnapshot(&block.WaitForSnapshotRequest{
@@ -58,21 +24,3 @@ func waitForBlockSnapshot(ctx context.Context, blockAPI *block.API, zone scw.Zon
 
 	return snapshot, err
 }
-
-func waitForBlockSnapshotToBeAvailable(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Snapshot, error) {
-	retryInterval := defaultBlockRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
-
-	snapshot, err := blockAPI.WaitForSnapshot(&block.WaitForSnapshotRequest{
-		Zone:          zone,
-		SnapshotID:    id,
-		RetryInterval: &retryInterval,
-		Timeout:       new(timeout),
-
-		TerminalStatus: new(block.SnapshotStatusAvailable),
-	}, scw.WithContext(ctx))
-
-	return snapshot, err
-}
@@ -16,19 +16,19 @@ import (
 // Cockpit resource doesn't require explicit deactivation.
 // Sources, tokens, and other resources are cleaned up by their respective sweepers.
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_cockpit_grafana_user", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_cockpit_grafana_user", &resource.Sweeper{
 		Name: "scaleway_cockpit_grafana_user",
 		F:    testSweepCockpitGrafanaUser,
 	})
-	resource.AddTestSweepers("scaleway_cockpit_token", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_cockpit_token", &resource.Sweeper{
 		Name: "scaleway_cockpit_token",
 		F:    testSweepCockpitToken,
 	})
-	resource.AddTestSweepers("scaleway_cockpit_source", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_cockpit_source", &resource.Sweeper{
 		Name: "scaleway_cockpit_source",
 		F:    testSweepCockpitDataSource,
 	})
-	resource.AddTestSweepers("scaleway_cockpit_alert_manager", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_cockpit_alert_manager", &resource.Sweeper{
 		Name: "scaleway_cockpit_alert_manager",
 		F:    testSweepCockpitAlertManager,
 	})
@@ -14,8 +14,10 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -26,9 +28,7 @@ func ResourceContainer() *schema.Resource {
 		ReadContext:   ResourceContainerRead,
 		UpdateContext: ResourceContainerUpdate,
 		DeleteContext: ResourceContainerDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      identity.DefaultRegionalImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultContainerTimeout),
 			Read:    schema.DefaultTimeout(defaultContainerTimeout),
@@ -37,7 +37,9 @@ func ResourceContainer() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultContainerTimeout),
 		},
 		SchemaVersion: 0,
+		Identity:      identity.DefaultRegional(),
 		SchemaFunc:    containerSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -69,6 +71,7 @@ func containerSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags [\"tag1\", \"tag2\", ...] attached to the container.",
 		},
+		"tags_all": tags.AllSchema(),
 		"environment_variables": {
 			Type:        schema.TypeMap,
 			Optional:    true,
@@ -413,7 +416,7 @@ func ResourceContainerCreate(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.Errorf("unexpected namespace error: %s", err)
 	}
 
-	req, err := setCreateContainerRequest(d, region)
+	req, err := setCreateContainerRequest(d, m, region)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -423,7 +426,10 @@ func ResourceContainerCreate(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.Errorf("creation container error: %s", err)
 	}
 
-	d.SetId(regional.NewIDString(region, res.ID))
+	err = identity.SetRegionalIdentity(d, region, res.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return ResourceContainerRead(ctx, d, m)
 }
@@ -445,67 +451,14 @@ func ResourceContainerRead(ctx context.Context, d *schema.ResourceData, m any) d
 		return diag.Errorf("unexpected waiting container error: %s", err)
 	}
 
-	_ = d.Set("name", co.Name)
-	_ = d.Set("namespace_id", regional.NewID(region, co.NamespaceID).String())
-	_ = d.Set("status", co.Status.String())
-	_ = d.Set("error_message", co.ErrorMessage)
-	_ = d.Set("environment_variables", types.FlattenMap(co.EnvironmentVariables))
-	_ = d.Set("min_scale", int(co.MinScale))
-	_ = d.Set("max_scale", int(co.MaxScale))
-	_ = d.Set("memory_limit_bytes", int(co.MemoryLimitBytes))
-	_ = d.Set("memory_limit", int(co.MemoryLimitBytes/scw.MB))
-	_ = d.Set("cpu_limit", int(co.MvcpuLimit))
-	_ = d.Set("timeout", co.Timeout.Seconds)
-	_ = d.Set("privacy", co.Privacy.String())
-	_ = d.Set("description", co.Description)
-	_ = d.Set("registry_image", co.Image)
-	_ = d.Set("image", co.Image)
-	_ = d.Set("public_endpoint", co.PublicEndpoint)
-	_ = d.Set("domain_name", strings.TrimPrefix(co.PublicEndpoint, "https://"))
-	_ = d.Set("protocol", co.Protocol.String())
-	_ = d.Set("port", int(co.Port))
-	_ = d.Set("https_connections_only", co.HTTPSConnectionsOnly)
+	diags := setContainerState(d, m, co)
 
-	if co.HTTPSConnectionsOnly {
-		_ = d.Set("http_option", containerBeta.ContainerHTTPOptionRedirected.String())
-	} else {
-		_ = d.Set("http_option", containerBeta.ContainerHTTPOptionEnabled.String())
-	}
-
-	_ = d.Set("sandbox", co.Sandbox)
-	_ = d.Set("health_check", flattenLivenessProbeAsHealthCheck(co.LivenessProbe))
-	_ = d.Set("liveness_probe", flattenContainerProbe(co.LivenessProbe))
-	_ = d.Set("startup_probe", flattenContainerProbe(co.StartupProbe))
-	_ = d.Set("scaling_option", flattenScalingOption(co.ScalingOption))
-	_ = d.Set("region", co.Region.String())
-	_ = d.Set("local_storage_limit_bytes", int(co.LocalStorageLimitBytes))
-	_ = d.Set("local_storage_limit", int(co.LocalStorageLimitBytes/scw.MB))
-	_ = d.Set("secret_environment_variables", co.SecretEnvironmentVariables)
-	_ = d.Set("tags", types.FlattenSliceString(co.Tags))
-	_ = d.Set("command", types.FlattenSliceString(co.Command))
-	_ = d.Set("args", types.FlattenSliceString(co.Args))
-
-	if co.PrivateNetworkID != nil {
-		_ = d.Set("private_network_id", regional.NewID(region, types.FlattenStringPtr(co.PrivateNetworkID).(string)).String())
-	} else {
-		_ = d.Set("private_network_id", nil)
-	}
-
-	if co.Status != container.ContainerStatusReady {
-		statusWarning := diag.Diagnostic{
-			Severity:      diag.Warning,
-			Summary:       fmt.Sprintf("Container is not ready, but has status %q", co.Status.String()),
-			AttributePath: cty.GetAttrPath("status"),
-		}
-
-		if co.ErrorMessage != nil {
-			statusWarning.Detail = fmt.Sprintf("Container error message: %q", *co.ErrorMessage)
-		}
-
-		return diag.Diagnostics{statusWarning}
+	err = identity.SetRegionalIdentity(d, region, containerID)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func ResourceContainerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -528,7 +481,7 @@ func ResourceContainerUpdate(ctx context.Context, d *schema.ResourceData, m any)
 	}
 
 	// update container
-	req, err := setUpdateContainerRequest(d, region, containerID)
+	req, err := setUpdateContainerRequest(d, m, region, containerID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -569,3 +522,67 @@ func ResourceContainerDelete(ctx context.Context, d *schema.ResourceData, m any)
 
 	return nil
 }
+
+func setContainerState(d *schema.ResourceData, m any, co *container.Container) diag.Diagnostics {
+	_ = d.Set("name", co.Name)
+	_ = d.Set("namespace_id", regional.NewID(co.Region, co.NamespaceID).String())
+	_ = d.Set("status", co.Status.String())
+	_ = d.Set("error_message", co.ErrorMessage)
+	_ = d.Set("environment_variables", types.FlattenMap(co.EnvironmentVariables))
+	_ = d.Set("min_scale", int(co.MinScale))
+	_ = d.Set("max_scale", int(co.MaxScale))
+	_ = d.Set("memory_limit_bytes", int(co.MemoryLimitBytes))
+	_ = d.Set("memory_limit", int(co.MemoryLimitBytes/scw.MB))
+	_ = d.Set("cpu_limit", int(co.MvcpuLimit))
+	_ = d.Set("timeout", co.Timeout.Seconds)
+	_ = d.Set("privacy", co.Privacy.String())
+	_ = d.Set("description", co.Description)
+	_ = d.Set("registry_image", co.Image)
+	_ = d.Set("image", co.Image)
+	_ = d.Set("public_endpoint", co.PublicEndpoint)
+	_ = d.Set("domain_name", strings.TrimPrefix(co.PublicEndpoint, "https://"))
+	_ = d.Set("protocol", co.Protocol.String())
+	_ = d.Set("port", int(co.Port))
+	_ = d.Set("https_connections_only", co.HTTPSConnectionsOnly)
+
+	if co.HTTPSConnectionsOnly {
+		_ = d.Set("http_option", containerBeta.ContainerHTTPOptionRedirected.String())
+	} else {
+		_ = d.Set("http_option", containerBeta.ContainerHTTPOptionEnabled.String())
+	}
+
+	_ = d.Set("sandbox", co.Sandbox)
+	_ = d.Set("health_check", flattenLivenessProbeAsHealthCheck(co.LivenessProbe))
+	_ = d.Set("liveness_probe", flattenContainerProbe(co.LivenessProbe))
+	_ = d.Set("startup_probe", flattenContainerProbe(co.StartupProbe))
+	_ = d.Set("scaling_option", flattenScalingOption(co.ScalingOption))
+	_ = d.Set("region", co.Region.String())
+	_ = d.Set("local_storage_limit_bytes", int(co.LocalStorageLimitBytes))
+	_ = d.Set("local_storage_limit", int(co.LocalStorageLimitBytes/scw.MB))
+	_ = d.Set("secret_environment_variables", co.SecretEnvironmentVariables)
+	tags.Set(d, m, co.Tags)
+	_ = d.Set("command", types.FlattenSliceString(co.Command))
+	_ = d.Set("args", types.FlattenSliceString(co.Args))
+
+	if co.PrivateNetworkID != nil {
+		_ = d.Set("private_network_id", regional.NewID(co.Region, types.FlattenStringPtr(co.PrivateNetworkID).(string)).String())
+	} else {
+		_ = d.Set("private_network_id", nil)
+	}
+
+	if co.Status != container.ContainerStatusReady {
+		statusWarning := diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("Container is not ready, but has status %q", co.Status.String()),
+			AttributePath: cty.GetAttrPath("status"),
+		}
+
+		if co.ErrorMessage != nil {
+			statusWarning.Detail = fmt.Sprintf("Container error message: %q", *co.ErrorMessage)
+		}
+
+		return diag.Diagnostics{statusWarning}
+	}
+
+	return nil
+}
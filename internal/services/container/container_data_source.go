@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -84,5 +85,8 @@ func DataSourceContainerRead(ctx context.Context, d *schema.ResourceData, m any)
 	d.SetId(regionalID)
 	_ = d.Set("container_id", regionalID)
 
-	return ResourceContainerRead(ctx, d, m)
+	diags := ResourceContainerRead(ctx, d, m)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
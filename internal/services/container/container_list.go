@@ -0,0 +1,239 @@
+package container
+
+import (
+	"cmp"
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/api/container/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*ContainerListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*ContainerListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*ContainerListResource)(nil)
+)
+
+type ContainerListResource struct {
+	meta         *meta.Meta
+	containerAPI *container.API
+}
+
+func (r *ContainerListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.containerAPI = container.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewContainerListResource() list.ListResource {
+	return &ContainerListResource{}
+}
+
+func (r *ContainerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"namespace_id":    listscw.RegionalIDAttribute("ID of the namespace to list the containers of"),
+			"name":            listscw.NameAttribute("Name of the container to filter for"),
+			"tags":            listscw.TagsAttribute("Tags of the container to filter for"),
+			"statuses":        listscw.StatesAttribute[container.ContainerStatus]("Statuses of the container to filter for (e.g. ready, error)"),
+			"organization_id": listscw.OrganizationIDAttribute("Organization ID to filter for"),
+			"project_ids":     listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":         listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *ContainerListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	containerResource := ResourceContainer()
+
+	resp.ProtoV6Schema = translate.Schema(containerResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(containerResource.ProtoIdentitySchema(ctx)())
+}
+
+type ContainerListResourceModel struct {
+	Tags           types.List   `tfsdk:"tags"`
+	Statuses       types.List   `tfsdk:"statuses"`
+	Regions        types.List   `tfsdk:"regions"`
+	ProjectIDs     types.List   `tfsdk:"project_ids"`
+	NamespaceID    types.String `tfsdk:"namespace_id"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (m *ContainerListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *ContainerListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *ContainerListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *ContainerListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *ContainerListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container"
+}
+
+func (r *ContainerListResource) FetchContainers(ctx context.Context, region scw.Region, project, namespaceID *string, tags, statuses []string, data ContainerListResourceModel) ([]*container.Container, error) {
+	response, err := r.containerAPI.ListContainers(&container.ListContainersRequest{
+		Region:         region,
+		NamespaceID:    namespaceID,
+		Name:           data.Name.ValueStringPointer(),
+		OrganizationID: data.OrganizationID.ValueStringPointer(),
+		ProjectID:      project,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make([]*container.Container, 0, len(response.Containers))
+
+	for _, co := range response.Containers {
+		if listscw.MatchTags(tags, co.Tags) && listscw.MatchStates(statuses, co.Status.String()) {
+			containers = append(containers, co)
+		}
+	}
+
+	return containers, nil
+}
+
+func (r *ContainerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ContainerListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	tags, diags := listscw.ExtractTags(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	statuses, diags := listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	regions, err := listscw.ExtractRegions(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing regions", "An error was encountered when listing regions: "+err.Error()),
+		})
+
+		return
+	}
+
+	namespaceID, regions := listscw.ExtractRegionalID(data.NamespaceID, regions)
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allContainers, err := listscw.FetchConcurrently(ctx, listscw.RegionalProjectTargets(regions, projects),
+		func(ctx context.Context, target listscw.RegionalFetchTarget) ([]*container.Container, error) {
+			return r.FetchContainers(ctx, target.Region, &target.ProjectID, namespaceID, tags, statuses, data)
+		},
+		func(a, b *container.Container) int {
+			return cmp.Or(
+				strings.Compare(string(a.Region), string(b.Region)),
+				strings.Compare(a.NamespaceID, b.NamespaceID),
+				strings.Compare(a.ID, b.ID),
+			)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing containers", "Failed to list containers: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, co := range allContainers {
+			result := req.NewListResult(ctx)
+			result.DisplayName = co.Name
+
+			containerResource := ResourceContainer()
+			resourceData := containerResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, co.Region, co.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			sdkDiags := setContainerState(resourceData, r.meta, co)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting container state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
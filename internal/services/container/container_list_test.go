@@ -0,0 +1,69 @@
+package container_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListContainers_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListContainers_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isContainerDestroyed(tt),
+			isNamespaceDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_container_namespace" "main" {
+					  name = "tf-acc-container-list"
+					}
+
+					resource "scaleway_container" "main" {
+					  name         = "tf-acc-container-list"
+					  namespace_id = scaleway_container_namespace.main.id
+					  image        = "%s"
+					  port         = 80
+					  tags         = ["tf-acc-container-list"]
+					}
+				`, defaultTestImage),
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_container" "by_namespace" {
+					  provider = scaleway
+
+					  config {
+					    namespace_id = scaleway_container_namespace.main.id
+					  }
+					}
+
+					list "scaleway_container" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    project_ids = [scaleway_container_namespace.main.project_id]
+					    tags        = ["tf-acc-container-list"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_container.by_namespace", 1),
+					querycheck.ExpectLength("list.scaleway_container.by_tag", 1),
+				},
+			},
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/container/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -24,9 +25,7 @@ func ResourceCron() *schema.Resource {
 		UpdateContext:      ResourceContainerCronUpdate,
 		DeleteContext:      ResourceContainerCronDelete,
 		DeprecationMessage: "The \"scaleway_container_cron\" resource is deprecated, please use `scaleway_container_trigger` with a cron configuration instead",
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:           identity.DefaultRegionalImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultContainerCronTimeout),
 			Read:    schema.DefaultTimeout(defaultContainerCronTimeout),
@@ -35,6 +34,7 @@ func ResourceCron() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultContainerCronTimeout),
 		},
 		SchemaVersion: 0,
+		Identity:      identity.DefaultRegional(),
 		SchemaFunc:    cronSchema,
 	}
 }
@@ -111,7 +111,10 @@ func ResourceContainerCronCreate(ctx context.Context, d *schema.ResourceData, m
 
 	tflog.Info(ctx, "[INFO] cron job ready")
 
-	d.SetId(regional.NewIDString(region, res.ID))
+	err = identity.SetRegionalIdentity(d, region, res.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return ResourceContainerCronRead(ctx, d, m)
 }
@@ -133,12 +136,12 @@ func ResourceContainerCronRead(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set("container_id", regional.NewID(region, trigger.ContainerID).String())
-	_ = d.Set("schedule", trigger.CronConfig.Schedule)
-	_ = d.Set("args", trigger.CronConfig.Body)
-	_ = d.Set("status", trigger.Status)
-	_ = d.Set("name", trigger.Name)
-	_ = d.Set("region", region)
+	setCronState(d, region, trigger)
+
+	err = identity.SetRegionalIdentity(d, region, containerCronID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -214,3 +217,12 @@ func ResourceContainerCronDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+func setCronState(d *schema.ResourceData, region scw.Region, trigger *container.Trigger) {
+	_ = d.Set("container_id", regional.NewID(region, trigger.ContainerID).String())
+	_ = d.Set("schedule", trigger.CronConfig.Schedule)
+	_ = d.Set("args", trigger.CronConfig.Body)
+	_ = d.Set("status", trigger.Status)
+	_ = d.Set("name", trigger.Name)
+	_ = d.Set("region", region)
+}
@@ -0,0 +1,189 @@
+package container
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/api/container/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*CronListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*CronListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*CronListResource)(nil)
+)
+
+type CronListResource struct {
+	meta         *meta.Meta
+	containerAPI *container.API
+}
+
+func (r *CronListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.containerAPI = container.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewCronListResource() list.ListResource {
+	return &CronListResource{}
+}
+
+func (r *CronListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_id": listscw.RegionalIDAttribute("ID of the container to list the crons of"),
+			"namespace_id": listscw.RegionalIDAttribute("ID of the namespace to list the crons of"),
+			"name":         listscw.NameAttribute("Name of the cron to filter for"),
+			"statuses":     listscw.StatesAttribute[container.TriggerStatus]("Statuses of the cron to filter for (e.g. ready, error)"),
+			"project_ids":  listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":      listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *CronListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	cronResource := ResourceCron()
+
+	resp.ProtoV6Schema = translate.Schema(cronResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(cronResource.ProtoIdentitySchema(ctx)())
+}
+
+type CronListResourceModel struct {
+	Statuses    types.List   `tfsdk:"statuses"`
+	Regions     types.List   `tfsdk:"regions"`
+	ProjectIDs  types.List   `tfsdk:"project_ids"`
+	ContainerID types.String `tfsdk:"container_id"`
+	NamespaceID types.String `tfsdk:"namespace_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (m *CronListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *CronListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *CronListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *CronListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container_cron"
+}
+
+func (r *CronListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data CronListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	filters := triggerFilters{
+		name: data.Name.ValueString(),
+	}
+
+	filters.statuses, diags = listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	regions, err := listscw.ExtractRegions(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing regions", "An error was encountered when listing regions: "+err.Error()),
+		})
+
+		return
+	}
+
+	filters.containerID, regions = listscw.ExtractRegionalID(data.ContainerID, regions)
+	filters.namespaceID, regions = listscw.ExtractRegionalID(data.NamespaceID, regions)
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allTriggers, err := listTriggers(ctx, r.containerAPI, regions, projects, filters)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing crons", "Failed to list container crons: "+err.Error()),
+		})
+
+		return
+	}
+
+	// Crons are the triggers with a cron configuration
+	allTriggers = slices.DeleteFunc(allTriggers, func(trigger *regionalTrigger) bool {
+		return trigger.CronConfig == nil
+	})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, trigger := range allTriggers {
+			result := req.NewListResult(ctx)
+			result.DisplayName = trigger.Name
+
+			cronResource := ResourceCron()
+			resourceData := cronResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, trigger.region, trigger.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			setCronState(resourceData, trigger.region, trigger.Trigger)
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package container_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListContainerCrons_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListContainerCrons_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isCronDestroyed(tt),
+			isContainerDestroyed(tt),
+			isNamespaceDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_container_namespace" "main" {
+					  name = "tf-acc-container-cron-list"
+					}
+
+					resource "scaleway_container" "main" {
+					  namespace_id = scaleway_container_namespace.main.id
+					  image        = "%s"
+					  port         = 80
+					}
+
+					resource "scaleway_container_cron" "main" {
+					  name         = "tf-acc-container-cron-list"
+					  container_id = scaleway_container.main.id
+					  schedule     = "5 4 * * *"
+					  args         = jsonencode({ test = "scw" })
+					}
+				`, defaultTestImage),
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_container_cron" "by_container" {
+					  provider = scaleway
+
+					  config {
+					    container_id = scaleway_container.main.id
+					  }
+					}
+
+					list "scaleway_container_cron" "by_namespace" {
+					  provider = scaleway
+
+					  config {
+					    namespace_id = scaleway_container_namespace.main.id
+					    name         = "tf-acc-container-cron-list"
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_container_cron.by_container", 1),
+					querycheck.ExpectLength("list.scaleway_container_cron.by_namespace", 1),
+				},
+			},
+		},
+	})
+}
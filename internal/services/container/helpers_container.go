@@ -15,6 +15,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -74,7 +75,7 @@ func NewAPIBetaWithRegionAndID(m any, id string) (*containerV1Beta1.API, scw.Reg
 	return api, region, id, nil
 }
 
-func setCreateContainerRequest(d *schema.ResourceData, region scw.Region) (*containerV1.CreateContainerRequest, error) {
+func setCreateContainerRequest(d *schema.ResourceData, m any, region scw.Region) (*containerV1.CreateContainerRequest, error) {
 	// required
 	nameRaw := d.Get("name")
 	namespaceID := d.Get("namespace_id")
@@ -214,8 +215,8 @@ func setCreateContainerRequest(d *schema.ResourceData, region scw.Region) (*cont
 		req.StartupProbe = startupProbeReq
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		req.Tags = types.ExpandStrings(tags)
+	if containerTags := tags.Expand(d, m); len(containerTags) > 0 {
+		req.Tags = containerTags
 	}
 
 	if command, ok := d.GetOk("command"); ok {
@@ -233,7 +234,7 @@ func setCreateContainerRequest(d *schema.ResourceData, region scw.Region) (*cont
 	return req, nil
 }
 
-func setUpdateContainerRequest(d *schema.ResourceData, region scw.Region, containerID string) (*containerV1.UpdateContainerRequest, error) {
+func setUpdateContainerRequest(d *schema.ResourceData, m any, region scw.Region, containerID string) (*containerV1.UpdateContainerRequest, error) {
 	req := &containerV1.UpdateContainerRequest{
 		Region:      region,
 		ContainerID: containerID,
@@ -249,8 +250,8 @@ func setUpdateContainerRequest(d *schema.ResourceData, region scw.Region, contai
 		req.SecretEnvironmentVariables = filterSecretEnvsToPatch(types.ExpandMapStringString(newEnv))
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChanges("min_scale") {
@@ -10,8 +10,10 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -21,9 +23,7 @@ func ResourceNamespace() *schema.Resource {
 		ReadContext:   ResourceContainerNamespaceRead,
 		UpdateContext: ResourceContainerNamespaceUpdate,
 		DeleteContext: ResourceContainerNamespaceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      identity.DefaultRegionalImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultContainerNamespaceTimeout),
 			Read:    schema.DefaultTimeout(defaultContainerNamespaceTimeout),
@@ -32,7 +32,9 @@ func ResourceNamespace() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultContainerNamespaceTimeout),
 		},
 		SchemaVersion: 0,
+		Identity:      identity.DefaultRegional(),
 		SchemaFunc:    namespaceSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -58,6 +60,7 @@ func namespaceSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags [\"tag1\", \"tag2\", ...] attached to the container namespace",
 		},
+		"tags_all": tags.AllSchema(),
 		"environment_variables": {
 			Type:        schema.TypeMap,
 			Optional:    true,
@@ -121,9 +124,8 @@ func ResourceContainerNamespaceCreate(ctx context.Context, d *schema.ResourceDat
 		Region:                     region,
 	}
 
-	rawTag, tagExist := d.GetOk("tags")
-	if tagExist {
-		createReq.Tags = types.ExpandStrings(rawTag)
+	if nsTags := tags.Expand(d, m); len(nsTags) > 0 {
+		createReq.Tags = nsTags
 	}
 
 	ns, err := api.CreateNamespace(createReq, scw.WithContext(ctx))
@@ -131,7 +133,10 @@ func ResourceContainerNamespaceCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	d.SetId(regional.NewIDString(region, ns.ID))
+	err = identity.SetRegionalIdentity(d, region, ns.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	_, err = waitForNamespace(ctx, api, region, ns.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -158,14 +163,12 @@ func ResourceContainerNamespaceRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set("name", ns.Name)
-	_ = d.Set("organization_id", ns.OrganizationID)
-	_ = d.Set("project_id", ns.ProjectID)
-	_ = d.Set("description", ns.Description)
-	_ = d.Set("environment_variables", ns.EnvironmentVariables)
-	_ = d.Set("secret_environment_variables", ns.SecretEnvironmentVariables)
-	_ = d.Set("tags", types.FlattenSliceString(ns.Tags))
-	_ = d.Set("region", ns.Region)
+	setNamespaceState(d, m, ns)
+
+	err = identity.SetRegionalIdentity(d, region, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -190,8 +193,8 @@ func ResourceContainerNamespaceUpdate(ctx context.Context, d *schema.ResourceDat
 		req.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChanges("environment_variables") {
@@ -244,3 +247,14 @@ func ResourceContainerNamespaceDelete(ctx context.Context, d *schema.ResourceDat
 
 	return nil
 }
+
+func setNamespaceState(d *schema.ResourceData, m any, ns *container.Namespace) {
+	_ = d.Set("name", ns.Name)
+	_ = d.Set("organization_id", ns.OrganizationID)
+	_ = d.Set("project_id", ns.ProjectID)
+	_ = d.Set("description", ns.Description)
+	_ = d.Set("environment_variables", ns.EnvironmentVariables)
+	_ = d.Set("secret_environment_variables", ns.SecretEnvironmentVariables)
+	tags.Set(d, m, ns.Tags)
+	_ = d.Set("region", ns.Region)
+}
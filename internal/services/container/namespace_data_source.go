@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/container/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -68,5 +69,8 @@ func DataSourceContainerNamespaceRead(ctx context.Context, d *schema.ResourceDat
 	d.SetId(regionalID)
 	_ = d.Set("namespace_id", regionalID)
 
-	return ResourceContainerNamespaceRead(ctx, d, m)
+	diags := ResourceContainerNamespaceRead(ctx, d, m)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
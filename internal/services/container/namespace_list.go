@@ -0,0 +1,214 @@
+package container
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/api/container/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*NamespaceListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*NamespaceListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*NamespaceListResource)(nil)
+)
+
+type NamespaceListResource struct {
+	meta         *meta.Meta
+	containerAPI *container.API
+}
+
+func (r *NamespaceListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.containerAPI = container.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewNamespaceListResource() list.ListResource {
+	return &NamespaceListResource{}
+}
+
+func (r *NamespaceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":            listscw.NameAttribute("Name of the namespace to filter for"),
+			"tags":            listscw.TagsAttribute("Tags of the namespace to filter for"),
+			"statuses":        listscw.StatesAttribute[container.NamespaceStatus]("Statuses of the namespace to filter for (e.g. ready, error)"),
+			"organization_id": listscw.OrganizationIDAttribute("Organization ID to filter for"),
+			"project_ids":     listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":         listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *NamespaceListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	namespaceResource := ResourceNamespace()
+
+	resp.ProtoV6Schema = translate.Schema(namespaceResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(namespaceResource.ProtoIdentitySchema(ctx)())
+}
+
+type NamespaceListResourceModel struct {
+	Tags           types.List   `tfsdk:"tags"`
+	Statuses       types.List   `tfsdk:"statuses"`
+	Regions        types.List   `tfsdk:"regions"`
+	ProjectIDs     types.List   `tfsdk:"project_ids"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (m *NamespaceListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *NamespaceListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *NamespaceListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *NamespaceListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *NamespaceListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container_namespace"
+}
+
+func (r *NamespaceListResource) FetchNamespaces(ctx context.Context, region scw.Region, project *string, tags, statuses []string, data NamespaceListResourceModel) ([]*container.Namespace, error) {
+	response, err := r.containerAPI.ListNamespaces(&container.ListNamespacesRequest{
+		Region:         region,
+		Name:           data.Name.ValueStringPointer(),
+		OrganizationID: data.OrganizationID.ValueStringPointer(),
+		ProjectID:      project,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]*container.Namespace, 0, len(response.Namespaces))
+
+	for _, namespace := range response.Namespaces {
+		if listscw.MatchTags(tags, namespace.Tags) && listscw.MatchStates(statuses, namespace.Status.String()) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	return namespaces, nil
+}
+
+func (r *NamespaceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data NamespaceListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	tags, diags := listscw.ExtractTags(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	statuses, diags := listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	regions, err := listscw.ExtractRegions(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing regions", "An error was encountered when listing regions: "+err.Error()),
+		})
+
+		return
+	}
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allNamespaces, err := listscw.FetchConcurrently(ctx, listscw.RegionalProjectTargets(regions, projects),
+		func(ctx context.Context, target listscw.RegionalFetchTarget) ([]*container.Namespace, error) {
+			return r.FetchNamespaces(ctx, target.Region, &target.ProjectID, tags, statuses, data)
+		},
+		func(a, b *container.Namespace) int {
+			return listscw.CompareRegionalProjectItems(a.ProjectID, b.ProjectID, a.Region, b.Region, a.ID, b.ID)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing namespaces", "Failed to list container namespaces: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, namespace := range allNamespaces {
+			result := req.NewListResult(ctx)
+			result.DisplayName = namespace.Name
+
+			namespaceResource := ResourceNamespace()
+			resourceData := namespaceResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, namespace.Region, namespace.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			setNamespaceState(resourceData, r.meta, namespace)
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
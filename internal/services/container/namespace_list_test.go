@@ -0,0 +1,60 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListContainerNamespaces_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListContainerNamespaces_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             isNamespaceDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_container_namespace" "main" {
+					  name = "tf-acc-container-namespace-list"
+					  tags = ["tf-acc-container-namespace-list"]
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_container_namespace" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    project_ids = [scaleway_container_namespace.main.project_id]
+					    tags        = ["tf-acc-container-namespace-list"]
+					    statuses    = ["ready"]
+					  }
+					}
+
+					list "scaleway_container_namespace" "by_name" {
+					  provider = scaleway
+
+					  config {
+					    project_ids = [scaleway_container_namespace.main.project_id]
+					    name        = "tf-acc-container-namespace-list"
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_container_namespace.by_tag", 1),
+					querycheck.ExpectLength("list.scaleway_container_namespace.by_name", 1),
+				},
+			},
+		},
+	})
+}
@@ -9,16 +9,16 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_container_namespace", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_container_namespace", &resource.Sweeper{
 		Name:         "scaleway_container_namespace",
 		F:            testSweepNamespace,
 		Dependencies: []string{"scaleway_container"},
 	})
-	resource.AddTestSweepers("scaleway_container", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_container", &resource.Sweeper{
 		Name: "scaleway_container",
 		F:    testSweepContainer,
 	})
-	resource.AddTestSweepers("scaleway_container_trigger", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_container_trigger", &resource.Sweeper{
 		Name: "scaleway_container_trigger",
 		F:    testSweepTrigger,
 	})
@@ -11,8 +11,10 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -23,9 +25,7 @@ func ResourceTrigger() *schema.Resource {
 		ReadContext:   ResourceContainerTriggerRead,
 		UpdateContext: ResourceContainerTriggerUpdate,
 		DeleteContext: ResourceContainerTriggerDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      identity.DefaultRegionalImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultTriggerTimeout),
 			Read:    schema.DefaultTimeout(defaultTriggerTimeout),
@@ -34,10 +34,12 @@ func ResourceTrigger() *schema.Resource {
 			Create:  schema.DefaultTimeout(defaultTriggerTimeout),
 		},
 		SchemaVersion: 0,
+		Identity:      identity.DefaultRegional(),
 		SchemaFunc:    triggerSchema,
 		CustomizeDiff: customdiff.All(
 			cdf.LocalityCheck("container_id"),
 			forceNewOnSourceChange("sqs", "nats", "cron"),
+			tags.CustomizeDiff,
 		),
 	}
 }
@@ -70,6 +72,7 @@ func triggerSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags [\"tag1\", \"tag2\", ...] attached to the container trigger",
 		},
+		"tags_all": tags.AllSchema(),
 		"destination_config": {
 			Type:        schema.TypeList,
 			MaxItems:    1,
@@ -248,8 +251,8 @@ func ResourceContainerTriggerCreate(ctx context.Context, d *schema.ResourceData,
 		Description: types.ExpandStringPtr(d.Get("description")),
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		req.Tags = types.ExpandStrings(tags)
+	if triggerTags := tags.Expand(d, m); len(triggerTags) > 0 {
+		req.Tags = triggerTags
 	}
 
 	if destConf, err := expandDestinationConfig(d.Get("destination_config.0")); err != nil {
@@ -281,7 +284,10 @@ func ResourceContainerTriggerCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	d.SetId(regional.NewIDString(region, trigger.ID))
+	err = identity.SetRegionalIdentity(d, region, trigger.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	_, err = waitForTrigger(ctx, api, trigger.ID, region, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -308,27 +314,11 @@ func ResourceContainerTriggerRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set("name", trigger.Name)
-	_ = d.Set("description", trigger.Description)
-	_ = d.Set("tags", types.FlattenSliceString(trigger.Tags))
-	_ = d.Set("destination_config", flattenDestinationConfig(trigger.DestinationConfig))
-	_ = d.Set("sqs", flattenTriggerSqs(d, trigger.SqsConfig))
-	_ = d.Set("nats", flattenTriggerNats(d, trigger.NatsConfig))
-	_ = d.Set("cron", flattenTriggerCron(trigger.CronConfig))
+	diags := setTriggerState(d, m, trigger)
 
-	diags := diag.Diagnostics(nil)
-
-	if trigger.Status == container.TriggerStatusError {
-		errMsg := ""
-		if trigger.ErrorMessage != nil {
-			errMsg = *trigger.ErrorMessage
-		}
-
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "Trigger in error state",
-			Detail:   errMsg,
-		})
+	err = identity.SetRegionalIdentity(d, region, id)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
@@ -364,8 +354,8 @@ func ResourceContainerTriggerUpdate(ctx context.Context, d *schema.ResourceData,
 		req.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("destination_config") {
@@ -420,3 +410,30 @@ func ResourceContainerTriggerDelete(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+func setTriggerState(d *schema.ResourceData, m any, trigger *container.Trigger) diag.Diagnostics {
+	_ = d.Set("name", trigger.Name)
+	_ = d.Set("description", trigger.Description)
+	tags.Set(d, m, trigger.Tags)
+	_ = d.Set("destination_config", flattenDestinationConfig(trigger.DestinationConfig))
+	_ = d.Set("sqs", flattenTriggerSqs(d, trigger.SqsConfig))
+	_ = d.Set("nats", flattenTriggerNats(d, trigger.NatsConfig))
+	_ = d.Set("cron", flattenTriggerCron(trigger.CronConfig))
+
+	diags := diag.Diagnostics(nil)
+
+	if trigger.Status == container.TriggerStatusError {
+		errMsg := ""
+		if trigger.ErrorMessage != nil {
+			errMsg = *trigger.ErrorMessage
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Trigger in error state",
+			Detail:   errMsg,
+		})
+	}
+
+	return diags
+}
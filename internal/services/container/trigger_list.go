@@ -0,0 +1,275 @@
+package container
+
+import (
+	"cmp"
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/api/container/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*TriggerListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*TriggerListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*TriggerListResource)(nil)
+)
+
+type TriggerListResource struct {
+	meta         *meta.Meta
+	containerAPI *container.API
+}
+
+func (r *TriggerListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.containerAPI = container.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewTriggerListResource() list.ListResource {
+	return &TriggerListResource{}
+}
+
+func (r *TriggerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_id": listscw.RegionalIDAttribute("ID of the container to list the triggers of"),
+			"namespace_id": listscw.RegionalIDAttribute("ID of the namespace to list the triggers of"),
+			"name":         listscw.NameAttribute("Name of the trigger to filter for"),
+			"tags":         listscw.TagsAttribute("Tags of the trigger to filter for"),
+			"statuses":     listscw.StatesAttribute[container.TriggerStatus]("Statuses of the trigger to filter for (e.g. ready, error)"),
+			"project_ids":  listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":      listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *TriggerListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	triggerResource := ResourceTrigger()
+
+	resp.ProtoV6Schema = translate.Schema(triggerResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(triggerResource.ProtoIdentitySchema(ctx)())
+}
+
+type TriggerListResourceModel struct {
+	Tags        types.List   `tfsdk:"tags"`
+	Statuses    types.List   `tfsdk:"statuses"`
+	Regions     types.List   `tfsdk:"regions"`
+	ProjectIDs  types.List   `tfsdk:"project_ids"`
+	ContainerID types.String `tfsdk:"container_id"`
+	NamespaceID types.String `tfsdk:"namespace_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (m *TriggerListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *TriggerListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *TriggerListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *TriggerListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *TriggerListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container_trigger"
+}
+
+// regionalTrigger is a trigger with the region it was listed in.
+type regionalTrigger struct {
+	*container.Trigger
+	region scw.Region
+}
+
+// triggerFilters are the filters shared by the trigger and cron list resources.
+type triggerFilters struct {
+	containerID *string
+	namespaceID *string
+	name        string
+	tags        []string
+	statuses    []string
+}
+
+func (f triggerFilters) match(trigger *container.Trigger) bool {
+	return (f.name == "" || trigger.Name == f.name) &&
+		listscw.MatchTags(f.tags, trigger.Tags) &&
+		listscw.MatchStates(f.statuses, trigger.Status.String())
+}
+
+// fetchTriggers lists the triggers of a project in a region, keeping the ones matching the filters.
+func fetchTriggers(ctx context.Context, containerAPI *container.API, region scw.Region, project *string, filters triggerFilters) ([]*regionalTrigger, error) {
+	response, err := containerAPI.ListTriggers(&container.ListTriggersRequest{
+		Region:      region,
+		ContainerID: filters.containerID,
+		NamespaceID: filters.namespaceID,
+		ProjectID:   project,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	triggers := make([]*regionalTrigger, 0, len(response.Triggers))
+
+	for _, trigger := range response.Triggers {
+		if filters.match(trigger) {
+			triggers = append(triggers, &regionalTrigger{Trigger: trigger, region: region})
+		}
+	}
+
+	return triggers, nil
+}
+
+// listTriggers lists the triggers of the projects and regions matching the filters.
+func listTriggers(ctx context.Context, containerAPI *container.API, regions []scw.Region, projects []string, filters triggerFilters) ([]*regionalTrigger, error) {
+	return listscw.FetchConcurrently(ctx, listscw.RegionalProjectTargets(regions, projects),
+		func(ctx context.Context, target listscw.RegionalFetchTarget) ([]*regionalTrigger, error) {
+			return fetchTriggers(ctx, containerAPI, target.Region, &target.ProjectID, filters)
+		},
+		func(a, b *regionalTrigger) int {
+			return cmp.Or(
+				strings.Compare(string(a.region), string(b.region)),
+				strings.Compare(a.ContainerID, b.ContainerID),
+				strings.Compare(a.ID, b.ID),
+			)
+		},
+	)
+}
+
+func (r *TriggerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data TriggerListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	filters := triggerFilters{
+		name: data.Name.ValueString(),
+	}
+
+	filters.tags, diags = listscw.ExtractTags(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	filters.statuses, diags = listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	regions, err := listscw.ExtractRegions(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing regions", "An error was encountered when listing regions: "+err.Error()),
+		})
+
+		return
+	}
+
+	filters.containerID, regions = listscw.ExtractRegionalID(data.ContainerID, regions)
+	filters.namespaceID, regions = listscw.ExtractRegionalID(data.NamespaceID, regions)
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allTriggers, err := listTriggers(ctx, r.containerAPI, regions, projects, filters)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing triggers", "Failed to list container triggers: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, trigger := range allTriggers {
+			result := req.NewListResult(ctx)
+			result.DisplayName = trigger.Name
+
+			triggerResource := ResourceTrigger()
+			resourceData := triggerResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, trigger.region, trigger.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			sdkDiags := setTriggerState(resourceData, r.meta, trigger.Trigger)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting trigger state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			// The container and region are known from the configuration when reading a trigger, but not when listing them
+			_ = resourceData.Set("container_id", regional.NewIDString(trigger.region, trigger.ContainerID))
+			_ = resourceData.Set("region", trigger.region.String())
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
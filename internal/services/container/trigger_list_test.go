@@ -0,0 +1,84 @@
+package container_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListContainerTriggers_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListContainerTriggers_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			isTriggerDestroyed(tt),
+			isContainerDestroyed(tt),
+			isNamespaceDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_container_namespace" "main" {
+					  name = "tf-acc-container-trigger-list"
+					}
+
+					resource "scaleway_container" "main" {
+					  namespace_id = scaleway_container_namespace.main.id
+					  image        = "%s"
+					  port         = 80
+					}
+
+					resource "scaleway_container_trigger" "main" {
+					  container_id = scaleway_container.main.id
+					  name         = "tf-acc-container-trigger-list"
+					  tags         = ["tf-acc-container-trigger-list"]
+
+					  destination_config {
+					    http_path   = "/"
+					    http_method = "get"
+					  }
+
+					  cron {
+					    schedule = "5 4 1 * *"
+					    timezone = "Europe/Paris"
+					  }
+					}
+				`, defaultTestImage),
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_container_trigger" "by_container" {
+					  provider = scaleway
+
+					  config {
+					    container_id = scaleway_container.main.id
+					  }
+					}
+
+					list "scaleway_container_trigger" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    namespace_id = scaleway_container_namespace.main.id
+					    tags         = ["tf-acc-container-trigger-list"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_container_trigger.by_container", 1),
+					querycheck.ExpectLength("list.scaleway_container_trigger.by_tag", 1),
+				},
+			},
+		},
+	})
+}
@@ -11,8 +11,8 @@ import (
 
 func waitForNamespace(ctx context.Context, containerAPI *container.API, region scw.Region, namespaceID string, timeout time.Duration) (*container.Namespace, error) {
 	retryInterval := DefaultContainerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	ns, err := containerAPI.WaitForNamespace(&container.WaitForNamespaceRequest{
@@ -27,8 +27,8 @@ func waitForNamespace(ctx context.Context, containerAPI *container.API, region s
 
 func waitForTrigger(ctx context.Context, api *container.API, triggerID string, region scw.Region, timeout time.Duration) (*container.Trigger, error) {
 	retryInterval := defaultTriggerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	request := container.WaitForTriggerRequest{
@@ -43,8 +43,8 @@ func waitForTrigger(ctx context.Context, api *container.API, triggerID string, r
 
 func waitForContainer(ctx context.Context, api *container.API, containerID string, region scw.Region, timeout time.Duration) (*container.Container, error) {
 	retryInterval := DefaultContainerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	request := container.WaitForContainerRequest{
@@ -59,8 +59,8 @@ func waitForContainer(ctx context.Context, api *container.API, containerID strin
 
 func waitForDomain(ctx context.Context, api *container.API, domainID string, region scw.Region, timeout time.Duration) (*container.Domain, error) {
 	retryInterval := DefaultContainerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	request := container.WaitForDomainRequest{
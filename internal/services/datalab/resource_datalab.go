@@ -23,6 +23,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 )
 
 var (
@@ -30,6 +31,7 @@ var (
 	_ resource.ResourceWithConfigure   = (*DatalabResource)(nil)
 	_ resource.ResourceWithImportState = (*DatalabResource)(nil)
 	_ resource.ResourceWithIdentity    = (*DatalabResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*DatalabResource)(nil)
 )
 
 func NewDatalabResource() resource.Resource {
@@ -43,6 +45,7 @@ type DatalabResource struct {
 
 type datalabResourceModel struct {
 	Tags              types.List   `tfsdk:"tags"`
+	TagsAll           types.Set    `tfsdk:"tags_all"`
 	Status            types.String `tfsdk:"status"`
 	Worker            types.Object `tfsdk:"worker"`
 	Region            types.String `tfsdk:"region"`
@@ -156,6 +159,11 @@ func (r *DatalabResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				ElementType:         types.StringType,
 				MarkdownDescription: "Tags associated with the Datalab instance.",
 			},
+			"tags_all": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "All the tags of the Datalab instance, including the ones inherited from the provider default_tags block.",
+			},
 			"spark_version": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The Spark version to use for the Datalab instance. Available versions can be retrieved from `ListClusterVersions`.",
@@ -374,7 +382,7 @@ func (r *DatalabResource) Create(ctx context.Context, req resource.CreateRequest
 		HasNotebook:      data.HasNotebook.ValueBool(),
 	}
 
-	createReq.Tags = expandTags(ctx, data.Tags, &resp.Diagnostics)
+	createReq.Tags = r.expandTagsAll(ctx, data.Tags, types.SetNull(types.StringType), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -430,6 +438,7 @@ func (r *DatalabResource) Create(ctx context.Context, req resource.CreateRequest
 	dl, err = r.api.WaitForDatalab(&datalab.WaitForDatalabRequest{
 		Region:    region,
 		DatalabID: dl.ID,
+		Timeout:   r.meta.DefaultTimeouts().Create,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed waiting for Datalab", err.Error())
@@ -447,6 +456,7 @@ func (r *DatalabResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	state := flattenDatalab(ctx, dl, &resp.Diagnostics)
+	r.flattenTags(ctx, &state, dl.Tags, data.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	resp.Diagnostics.Append(resp.Identity.Set(ctx, framework.SetRegionalIdentity(dl.Region, dl.ID))...)
@@ -502,6 +512,7 @@ func (r *DatalabResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	newState := flattenDatalab(ctx, dl, &resp.Diagnostics)
+	r.flattenTags(ctx, &newState, dl.Tags, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 
 	resp.Diagnostics.Append(resp.Identity.Set(ctx, framework.SetRegionalIdentity(dl.Region, dl.ID))...)
@@ -546,8 +557,8 @@ func (r *DatalabResource) Update(ctx context.Context, req resource.UpdateRequest
 		hasChanges = true
 	}
 
-	if !plan.Tags.Equal(state.Tags) {
-		updateReq.Tags = expandTags(ctx, plan.Tags, &resp.Diagnostics)
+	if !plan.Tags.Equal(state.Tags) || !plan.TagsAll.Equal(state.TagsAll) {
+		updateReq.Tags = r.expandTagsAll(ctx, plan.Tags, state.TagsAll, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -597,6 +608,7 @@ func (r *DatalabResource) Update(ctx context.Context, req resource.UpdateRequest
 	dl, err = r.api.WaitForDatalab(&datalab.WaitForDatalabRequest{
 		Region:    region,
 		DatalabID: dl.ID,
+		Timeout:   r.meta.DefaultTimeouts().Update,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed waiting for Datalab update", err.Error())
@@ -614,11 +626,75 @@ func (r *DatalabResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	newState := flattenDatalab(ctx, dl, &resp.Diagnostics)
+	r.flattenTags(ctx, &newState, dl.Tags, plan.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 
 	resp.Diagnostics.Append(resp.Identity.Set(ctx, framework.SetRegionalIdentity(dl.Region, dl.ID))...)
 }
 
+// ModifyPlan plans tags_all from the tags of the Datalab and the provider default tags.
+func (r *DatalabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.meta == nil {
+		return
+	}
+
+	var plan datalabResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteTags := types.SetNull(types.StringType)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags_all"), &remoteTags)...)
+	}
+
+	if plan.Tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.StringType))...)
+
+		return
+	}
+
+	tagsAll, diags := flattenStringSet(ctx, r.expandTagsAll(ctx, plan.Tags, remoteTags, &resp.Diagnostics))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// expandTagsAll returns the tags to send to the API: the tags of the Datalab merged with the provider default tags,
+// and the remote tags ignored by the provider ignore_tags block so they are not removed by an update.
+func (r *DatalabResource) expandTagsAll(ctx context.Context, configuredTags types.List, remoteTags types.Set, diags *diag.Diagnostics) []string {
+	ignoreTags := r.meta.IgnoreTags()
+
+	var remote []string
+	if !remoteTags.IsNull() && !remoteTags.IsUnknown() {
+		diags.Append(remoteTags.ElementsAs(ctx, &remote, false)...)
+	}
+
+	return tags.KeepIgnored(
+		tags.Merge(expandTags(ctx, configuredTags, diags), r.meta.DefaultTags()),
+		remote,
+		ignoreTags.Keys,
+		ignoreTags.KeyPrefixes,
+	)
+}
+
+// flattenTags stores the remote tags in tags_all, and in tags without the default tags that are not configured on the Datalab.
+// The tags ignored by the provider ignore_tags block are only stored in tags_all, so that updates can keep them.
+func (r *DatalabResource) flattenTags(ctx context.Context, model *datalabResourceModel, remoteTags []string, configuredTags types.List, diags *diag.Diagnostics) {
+	ignoreTags := r.meta.IgnoreTags()
+
+	resourceTags, d := flattenStringList(ctx, tags.RemoveDefaults(tags.Ignore(remoteTags, ignoreTags.Keys, ignoreTags.KeyPrefixes), r.meta.DefaultTags(), expandTags(ctx, configuredTags, diags)))
+	diags.Append(d...)
+
+	tagsAll, d := flattenStringSet(ctx, remoteTags)
+	diags.Append(d...)
+
+	model.Tags = resourceTags
+	model.TagsAll = tagsAll
+}
+
 func (r *DatalabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state datalabResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -651,6 +727,7 @@ func (r *DatalabResource) Delete(ctx context.Context, req resource.DeleteRequest
 	dl, err := r.api.WaitForDatalab(&datalab.WaitForDatalabRequest{
 		Region:    region,
 		DatalabID: id,
+		Timeout:   r.meta.DefaultTimeouts().Delete,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if httperrors.Is404(err) {
@@ -789,6 +866,14 @@ func expandTags(ctx context.Context, tags types.List, diags *diag.Diagnostics) [
 	return result
 }
 
+func flattenStringSet(ctx context.Context, items []string) (types.Set, diag.Diagnostics) {
+	if len(items) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, items)
+}
+
 func flattenStringList(ctx context.Context, items []string) (types.List, diag.Diagnostics) {
 	if len(items) == 0 {
 		return types.ListNull(types.StringType), nil
@@ -13,14 +13,14 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("scaleway_datalab", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_datalab", &resource.Sweeper{
 		Name: "scaleway_datalab",
 		F:    testSweepDatalab,
 	})
 }
 
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	acctest.TestMain(m)
 }
 
 func testSweepDatalab(_ string) error {
@@ -16,6 +16,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -46,6 +47,7 @@ func ResourceDeployment() *schema.Resource {
 
 				return nil
 			},
+			tags.CustomizeDiff,
 		),
 		SchemaFunc: deploymentSchema,
 	}
@@ -66,6 +68,7 @@ func deploymentSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags to apply",
 		},
+		"tags_all": tags.AllSchema(),
 		"version": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -266,8 +269,8 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		req.ShardCount = new(uint32(v.(int)))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		req.Tags = types.ExpandStrings(v)
+	if deploymentTags := tags.Expand(d, meta); len(deploymentTags) > 0 {
+		req.Tags = deploymentTags
 	}
 
 	// Always create a public endpoint by default
@@ -341,7 +344,7 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta an
 	_ = d.Set("region", string(deployment.Region))
 	_ = d.Set("project_id", deployment.ProjectID)
 	_ = d.Set("name", deployment.Name)
-	_ = d.Set("tags", types.FlattenSliceString(deployment.Tags))
+	tags.Set(d, meta, deployment.Tags)
 	_ = d.Set("version", deployment.Version)
 	_ = d.Set("replica_count", int(deployment.ReplicaCount))
 	_ = d.Set("shard_count", int(deployment.ShardCount))
@@ -414,8 +417,8 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		changed = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = new(types.ExpandStrings(d.Get("tags")))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, meta)
 		changed = true
 	}
 
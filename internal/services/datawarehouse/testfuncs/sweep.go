@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_datawarehouse_deployment", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_datawarehouse_deployment", &resource.Sweeper{
 		Name: "scaleway_datawarehouse_deployment",
 		F:    testSweepDatawarehouseDeployment,
 	})
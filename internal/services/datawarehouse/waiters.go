@@ -11,8 +11,8 @@ import (
 
 func waitForDatawarehouseDeployment(ctx context.Context, api *datawarehouse.API, region scw.Region, id string, timeout time.Duration) (*datawarehouse.Deployment, error) {
 	retryInterval := defaultWaitRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	req := &datawarehouse.WaitForDeploymentRequest{
@@ -18,8 +18,8 @@ const (
 
 func waitForDNSZone(ctx context.Context, domainAPI *domain.API, dnsZone string, timeout time.Duration) (*domain.DNSZone, error) {
 	retryInterval := defaultDomainZoneRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return domainAPI.WaitForDNSZone(&domain.WaitForDNSZoneRequest{
@@ -31,8 +31,8 @@ func waitForDNSZone(ctx context.Context, domainAPI *domain.API, dnsZone string,
 
 func waitForDNSRecordExist(ctx context.Context, domainAPI *domain.API, dnsZone, recordName string, recordType domain.RecordType, timeout time.Duration) (*domain.Record, error) {
 	retryInterval := defaultDomainZoneRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return domainAPI.WaitForDNSRecordExist(&domain.WaitForDNSRecordExistRequest{
@@ -46,8 +46,8 @@ func waitForDNSRecordExist(ctx context.Context, domainAPI *domain.API, dnsZone,
 
 func waitForDomainsRegistration(ctx context.Context, api *domain.RegistrarAPI, domainName string, timeout time.Duration) (*domain.Domain, error) {
 	retryInterval := defaultWaitDomainsRegistrationRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return api.WaitForOrderDomain(&domain.WaitForOrderDomainRequest{
@@ -59,8 +59,8 @@ func waitForDomainsRegistration(ctx context.Context, api *domain.RegistrarAPI, d
 
 func waitForAutoRenewStatus(ctx context.Context, api *domain.RegistrarAPI, domainName string, timeout time.Duration) (*domain.Domain, error) {
 	retryInterval := defaultWaitDomainsRegistrationRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return api.WaitForAutoRenewStatus(&domain.WaitForAutoRenewStatusRequest{
@@ -72,8 +72,8 @@ func waitForAutoRenewStatus(ctx context.Context, api *domain.RegistrarAPI, domai
 
 func waitForDNSSECStatus(ctx context.Context, api *domain.RegistrarAPI, domainName string, timeout time.Duration) (*domain.Domain, error) {
 	retryInterval := defaultWaitDomainsRegistrationRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return api.WaitForDNSSECStatus(&domain.WaitForDNSSECStatusRequest{
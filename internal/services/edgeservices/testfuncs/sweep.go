@@ -9,35 +9,35 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_edge_services_pipeline", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_edge_services_pipeline", &resource.Sweeper{
 		Name: "scaleway_edge_services_pipeline",
 		F:    testSweepPipeline,
 	})
-	resource.AddTestSweepers("scaleway_edge_services_backend_stage", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_edge_services_backend_stage", &resource.Sweeper{
 		Name: "scaleway_edge_services_backend_stage",
 		F:    testSweepBackend,
 	})
-	resource.AddTestSweepers("scaleway_edge_services_tls_stage", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_edge_services_tls_stage", &resource.Sweeper{
 		Name: "scaleway_edge_services_tls_stage",
 		F:    testSweepTLS,
 	})
-	resource.AddTestSweepers("scaleway_edge_services_dns_stage", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_edge_services_dns_stage", &resource.Sweeper{
 		Name: "scaleway_edge_services_dns_stage",
 		F:    testSweepDNS,
 	})
-	resource.AddTestSweepers("scaleway_edge_services_cache_stage", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_edge_services_cache_stage", &resource.Sweeper{
 		Name: "scaleway_edge_services_cache_stage",
 		F:    testSweepCache,
 	})
-	resource.AddTestSweepers("scaleway_edge_services_plan", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_edge_services_plan", &resource.Sweeper{
 		Name: "scaleway_edge_services_plan",
 		F:    testSweepPlan,
 	})
-	resource.AddTestSweepers("scaleway_edge_services_waf_stage", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_edge_services_waf_stage", &resource.Sweeper{
 		Name: "scaleway_edge_services_waf_stage",
 		F:    testSweepWAF,
 	})
-	resource.AddTestSweepers("scaleway_edge_services_route_stage", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_edge_services_route_stage", &resource.Sweeper{
 		Name: "scaleway_edge_services_route_stage",
 		F:    testSweepRoute,
 	})
@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -33,6 +34,7 @@ func ResourceFileSystem() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    fileSystemSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 		Identity:      identity.DefaultRegional(),
 	}
 }
@@ -59,6 +61,7 @@ func fileSystemSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The list of tags assigned to the filesystem",
 		},
+		"tags_all":        tags.AllSchema(),
 		"project_id":      account.ProjectIDSchema(),
 		"organization_id": account.OrganizationIDSchema(),
 		"region":          regional.Schema(),
@@ -101,7 +104,7 @@ func ResourceFileSystemCreate(ctx context.Context, d *schema.ResourceData, m any
 		Name:      types.ExpandOrGenerateString(d.Get("name").(string), "file"),
 		ProjectID: d.Get("project_id").(string),
 		Size:      *types.ExpandUint64Ptr(d.Get("size_in_gb")),
-		Tags:      types.ExpandStrings(d.Get("tags")),
+		Tags:      tags.Expand(d, m),
 	}
 
 	if size, ok := d.GetOk("size_in_gb"); ok {
@@ -150,19 +153,19 @@ func ResourceFileSystemRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	setFileSystemState(d, fileSystem)
+	setFileSystemState(d, m, fileSystem)
 
 	return nil
 }
 
-func setFileSystemState(d *schema.ResourceData, fileSystem *file.FileSystem) {
+func setFileSystemState(d *schema.ResourceData, m any, fileSystem *file.FileSystem) {
 	_ = d.Set("name", fileSystem.Name)
 	_ = d.Set("project_id", fileSystem.ProjectID)
 	_ = d.Set("region", fileSystem.Region)
 	_ = d.Set("organization_id", fileSystem.OrganizationID)
 	_ = d.Set("status", fileSystem.Status)
 	_ = d.Set("size_in_gb", int(fileSystem.Size/scw.GB))
-	_ = d.Set("tags", fileSystem.Tags)
+	tags.Set(d, m, fileSystem.Tags)
 	_ = d.Set("created_at", fileSystem.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", fileSystem.UpdatedAt.Format(time.RFC3339))
 	_ = d.Set("number_of_attachments", int64(fileSystem.NumberOfAttachments))
@@ -200,8 +203,8 @@ func ResourceFileSystemUpdate(ctx context.Context, d *schema.ResourceData, m any
 		req.Size = types.ExpandUint64Ptr(sizeInGB)
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if _, err := api.UpdateFileSystem(req, scw.WithContext(ctx)); err != nil {
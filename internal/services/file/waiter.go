@@ -11,8 +11,8 @@ import (
 
 func waitForFileSystem(ctx context.Context, fileAPI *file.API, region scw.Region, id string, timeout time.Duration) (*file.FileSystem, error) {
 	retryInterval := defaultFileSystemRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	fileSystem, err := fileAPI.WaitForFileSystem(&file.WaitForFileSystemRequest{
@@ -43,8 +43,8 @@ func NewAPIWithZoneAndID(m any, id string) (*flexibleip.API, scw.Zone, string, e
 
 func waitFlexibleIP(ctx context.Context, api *flexibleip.API, zone scw.Zone, id string, timeout time.Duration) (*flexibleip.FlexibleIP, error) {
 	retryInterval := retryFlexibleIPInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return api.WaitForFlexibleIP(&flexibleip.WaitForFlexibleIPRequest{
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -13,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -35,7 +37,10 @@ func ResourceIP() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    ipSchema,
-		CustomizeDiff: cdf.LocalityCheck("server_id"),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("server_id"),
+			tags.CustomizeDiff,
+		),
 	}
 }
 
@@ -72,6 +77,7 @@ func ipSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the flexible IP",
 		},
+		"tags_all":        tags.AllSchema(),
 		"zone":            zonal.Schema(),
 		"organization_id": account.OrganizationIDSchema(),
 		"project_id":      account.ProjectIDSchema(),
@@ -108,7 +114,7 @@ func ResourceFlexibleIPCreate(ctx context.Context, d *schema.ResourceData, m any
 		Zone:        zone,
 		ProjectID:   d.Get("project_id").(string),
 		Description: d.Get("description").(string),
-		Tags:        types.ExpandStrings(d.Get("tags")),
+		Tags:        tags.Expand(d, m),
 		ServerID:    types.ExpandStringPtr(locality.ExpandID(d.Get("server_id"))),
 		Reverse:     types.ExpandStringPtr(d.Get("reverse")),
 		IsIPv6:      d.Get("is_ipv6").(bool),
@@ -157,7 +163,7 @@ func ResourceFlexibleIPRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	diags := setFlexibleIPState(d, flexibleIP, zone)
+	diags := setFlexibleIPState(d, m, flexibleIP, zone)
 
 	err = identity.SetZonalIdentity(d, flexibleIP.Zone, flexibleIP.ID)
 	if err != nil {
@@ -167,7 +173,7 @@ func ResourceFlexibleIPRead(ctx context.Context, d *schema.ResourceData, m any)
 	return diags
 }
 
-func setFlexibleIPState(d *schema.ResourceData, flexibleIP *flexibleip.FlexibleIP, zone scw.Zone) diag.Diagnostics {
+func setFlexibleIPState(d *schema.ResourceData, m any, flexibleIP *flexibleip.FlexibleIP, zone scw.Zone) diag.Diagnostics {
 	_ = d.Set("ip_address", flexibleIP.IPAddress.String())
 	_ = d.Set("zone", flexibleIP.Zone)
 	_ = d.Set("organization_id", flexibleIP.OrganizationID)
@@ -175,7 +181,7 @@ func setFlexibleIPState(d *schema.ResourceData, flexibleIP *flexibleip.FlexibleI
 	_ = d.Set("reverse", flexibleIP.Reverse)
 	_ = d.Set("created_at", types.FlattenTime(flexibleIP.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(flexibleIP.UpdatedAt))
-	_ = d.Set("tags", flexibleIP.Tags)
+	tags.Set(d, m, flexibleIP.Tags)
 	_ = d.Set("status", flexibleIP.Status.String())
 
 	if flexibleIP.ServerID != nil {
@@ -210,8 +216,8 @@ func ResourceFlexibleIPUpdate(ctx context.Context, d *schema.ResourceData, m any
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
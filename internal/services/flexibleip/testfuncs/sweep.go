@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_flexible_ip", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_flexible_ip", &resource.Sweeper{
 		Name: "scaleway_flexible_ip",
 		F:    testSweepFlexibleIP,
 	})
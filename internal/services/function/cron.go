@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -21,9 +22,7 @@ func ResourceCron() *schema.Resource {
 		ReadContext:   ResourceFunctionCronRead,
 		UpdateContext: ResourceFunctionCronUpdate,
 		DeleteContext: ResourceFunctionCronDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      identity.DefaultRegionalImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultFunctionCronTimeout),
 			Read:    schema.DefaultTimeout(defaultFunctionCronTimeout),
@@ -32,6 +31,7 @@ func ResourceCron() *schema.Resource {
 			Create:  schema.DefaultTimeout(defaultFunctionCronTimeout),
 		},
 		SchemaVersion: 0,
+		Identity:      identity.DefaultRegional(),
 		SchemaFunc:    cronSchema,
 		CustomizeDiff: cdf.LocalityCheck("function_id"),
 	}
@@ -109,7 +109,10 @@ func ResourceFunctionCronCreate(ctx context.Context, d *schema.ResourceData, m a
 		return diag.FromErr(err)
 	}
 
-	d.SetId(regional.NewIDString(region, cron.ID))
+	err = identity.SetRegionalIdentity(d, region, cron.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return ResourceFunctionCronRead(ctx, d, m)
 }
@@ -131,19 +134,16 @@ func ResourceFunctionCronRead(ctx context.Context, d *schema.ResourceData, m any
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set("function_id", regional.NewID(region, cron.FunctionID).String())
-	_ = d.Set("schedule", cron.Schedule)
-	_ = d.Set("name", cron.Name)
+	diags := setCronState(d, region, cron)
+	if diags.HasError() {
+		return diags
+	}
 
-	args, err := scw.EncodeJSONObject(*cron.Args, scw.NoEscape)
+	err = identity.SetRegionalIdentity(d, region, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set("args", args)
-	_ = d.Set("status", cron.Status)
-	_ = d.Set("region", region.String())
-
 	return nil
 }
 
@@ -222,3 +222,20 @@ func ResourceFunctionCronDelete(ctx context.Context, d *schema.ResourceData, m a
 
 	return nil
 }
+
+func setCronState(d *schema.ResourceData, region scw.Region, cron *function.Cron) diag.Diagnostics {
+	_ = d.Set("function_id", regional.NewID(region, cron.FunctionID).String())
+	_ = d.Set("schedule", cron.Schedule)
+	_ = d.Set("name", cron.Name)
+
+	args, err := scw.EncodeJSONObject(*cron.Args, scw.NoEscape)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("args", args)
+	_ = d.Set("status", cron.Status)
+	_ = d.Set("region", region.String())
+
+	return nil
+}
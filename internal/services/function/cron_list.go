@@ -0,0 +1,250 @@
+package function
+
+import (
+	"cmp"
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*CronListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*CronListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*CronListResource)(nil)
+)
+
+type CronListResource struct {
+	meta        *meta.Meta
+	functionAPI *function.API
+}
+
+func (r *CronListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.functionAPI = function.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewCronListResource() list.ListResource {
+	return &CronListResource{}
+}
+
+func (r *CronListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_id":  listscw.RegionalIDAttribute("ID of the function to list the crons of. The crons of every function of the namespaces are listed if not set"),
+			"namespace_id": listscw.RegionalIDAttribute("ID of the namespace to list the crons of. The crons of every namespace of the projects and regions are listed if not set"),
+			"name":         listscw.NameAttribute("Name of the cron to filter for"),
+			"statuses":     listscw.StatesAttribute[function.CronStatus]("Statuses of the cron to filter for (e.g. ready, error)"),
+			"project_ids":  listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":      listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *CronListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	cronResource := ResourceCron()
+
+	resp.ProtoV6Schema = translate.Schema(cronResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(cronResource.ProtoIdentitySchema(ctx)())
+}
+
+type CronListResourceModel struct {
+	Statuses    types.List   `tfsdk:"statuses"`
+	Regions     types.List   `tfsdk:"regions"`
+	ProjectIDs  types.List   `tfsdk:"project_ids"`
+	FunctionID  types.String `tfsdk:"function_id"`
+	NamespaceID types.String `tfsdk:"namespace_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (m *CronListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *CronListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *CronListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *CronListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_cron"
+}
+
+// regionalCron is a cron with the region it was listed in.
+type regionalCron struct {
+	*function.Cron
+	region scw.Region
+}
+
+// listCronFunctions returns the functions to list the crons of: the one of function_id, or every function of the namespaces.
+// A function ID without region is looked up in the first region.
+func (r *CronListResource) listCronFunctions(ctx context.Context, data *CronListResourceModel) ([]*function.Function, error) {
+	regions, err := listscw.ExtractRegions(ctx, data, r.meta)
+	if err != nil {
+		return nil, err
+	}
+
+	functionID, regions := listscw.ExtractRegionalID(data.FunctionID, regions)
+	if functionID != nil {
+		return []*function.Function{{ID: *functionID, Region: regions[0]}}, nil
+	}
+
+	namespaceID, regions := listscw.ExtractRegionalID(data.NamespaceID, regions)
+
+	projects, err := listscw.ExtractProjects(ctx, data, r.meta)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := listNamespaces(ctx, r.functionAPI, namespaceID, regions, projects, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return listFunctions(ctx, r.functionAPI, namespaces, nil, functionFilters{})
+}
+
+func (r *CronListResource) FetchCrons(ctx context.Context, fn *function.Function, statuses []string, data CronListResourceModel) ([]*regionalCron, error) {
+	response, err := r.functionAPI.ListCrons(&function.ListCronsRequest{
+		Region:     fn.Region,
+		FunctionID: fn.ID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	crons := make([]*regionalCron, 0, len(response.Crons))
+
+	for _, cron := range response.Crons {
+		if (data.Name.IsNull() || cron.Name == data.Name.ValueString()) &&
+			listscw.MatchStates(statuses, cron.Status.String()) {
+			crons = append(crons, &regionalCron{Cron: cron, region: fn.Region})
+		}
+	}
+
+	return crons, nil
+}
+
+func (r *CronListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data CronListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	statuses, diags := listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	functions, err := r.listCronFunctions(ctx, &data)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing functions", "An error was encountered when listing functions: "+err.Error()),
+		})
+
+		return
+	}
+
+	allCrons, err := listscw.FetchConcurrently(ctx, functions,
+		func(ctx context.Context, fn *function.Function) ([]*regionalCron, error) {
+			return r.FetchCrons(ctx, fn, statuses, data)
+		},
+		func(a, b *regionalCron) int {
+			return cmp.Or(
+				strings.Compare(string(a.region), string(b.region)),
+				strings.Compare(a.FunctionID, b.FunctionID),
+				strings.Compare(a.ID, b.ID),
+			)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing crons", "Failed to list function crons: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, cron := range allCrons {
+			result := req.NewListResult(ctx)
+			result.DisplayName = cron.Name
+
+			cronResource := ResourceCron()
+			resourceData := cronResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, cron.region, cron.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			sdkDiags := setCronState(resourceData, cron.region, cron.Cron)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting cron state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
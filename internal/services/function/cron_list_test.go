@@ -0,0 +1,76 @@
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListFunctionCrons_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListFunctionCrons_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckFunctionCronDestroy(tt),
+			testAccCheckFunctionDestroy(tt),
+			testAccCheckFunctionNamespaceDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_function_namespace" "main" {
+					  name = "tf-acc-function-cron-list"
+					}
+
+					resource "scaleway_function" "main" {
+					  name         = "tf-acc-function-cron-list"
+					  namespace_id = scaleway_function_namespace.main.id
+					  runtime      = "node26"
+					  privacy      = "private"
+					  handler      = "handler.handle"
+					}
+
+					resource "scaleway_function_cron" "main" {
+					  name        = "tf-acc-function-cron-list"
+					  function_id = scaleway_function.main.id
+					  schedule    = "0 0 * * *"
+					  args        = jsonencode({})
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_function_cron" "by_function" {
+					  provider = scaleway
+
+					  config {
+					    function_id = scaleway_function.main.id
+					  }
+					}
+
+					list "scaleway_function_cron" "by_namespace" {
+					  provider = scaleway
+
+					  config {
+					    namespace_id = scaleway_function_namespace.main.id
+					    name         = "tf-acc-function-cron-list"
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_function_cron.by_function", 1),
+					querycheck.ExpectLength("list.scaleway_function_cron.by_namespace", 1),
+				},
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -67,5 +68,8 @@ func DataSourceFunctionRead(ctx context.Context, d *schema.ResourceData, m any)
 	d.SetId(regionalID)
 	_ = d.Set("function_id", regionalID)
 
-	return ResourceFunctionRead(ctx, d, m)
+	diags := ResourceFunctionRead(ctx, d, m)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
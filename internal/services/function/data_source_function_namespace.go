@@ -8,6 +8,7 @@ import (
 	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -68,5 +69,8 @@ func DataSourceFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData
 	d.SetId(regionalID)
 	_ = d.Set("namespace_id", regionalID)
 
-	return ResourceFunctionNamespaceRead(ctx, d, m)
+	diags := ResourceFunctionNamespaceRead(ctx, d, m)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
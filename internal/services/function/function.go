@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
@@ -14,9 +15,11 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -27,9 +30,7 @@ func ResourceFunction() *schema.Resource {
 		ReadContext:   ResourceFunctionRead,
 		UpdateContext: ResourceFunctionUpdate,
 		DeleteContext: ResourceFunctionDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      identity.DefaultRegionalImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(DefaultFunctionTimeout),
 			Read:    schema.DefaultTimeout(DefaultFunctionTimeout),
@@ -38,8 +39,12 @@ func ResourceFunction() *schema.Resource {
 			Create:  schema.DefaultTimeout(DefaultFunctionTimeout),
 		},
 		SchemaVersion: 0,
+		Identity:      identity.DefaultRegional(),
 		SchemaFunc:    functionSchema,
-		CustomizeDiff: cdf.LocalityCheck("namespace_id"),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("namespace_id"),
+			tags.CustomizeDiff,
+		),
 	}
 }
 
@@ -73,6 +78,7 @@ func functionSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags [\"tag1\", \"tag2\", ...] attached to the function.",
 		},
+		"tags_all": tags.AllSchema(),
 		"environment_variables": {
 			Type:        schema.TypeMap,
 			Optional:    true,
@@ -216,8 +222,8 @@ func ResourceFunctionCreate(ctx context.Context, d *schema.ResourceData, m any)
 		Sandbox:                    function.FunctionSandbox(d.Get("sandbox").(string)),
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		req.Tags = types.ExpandStrings(tags)
+	if functionTags := tags.Expand(d, m); len(functionTags) > 0 {
+		req.Tags = functionTags
 	}
 
 	if timeout, ok := d.GetOk("timeout"); ok {
@@ -274,7 +280,10 @@ func ResourceFunctionCreate(ctx context.Context, d *schema.ResourceData, m any)
 		})
 	}
 
-	d.SetId(regional.NewIDString(region, f.ID))
+	err = identity.SetRegionalIdentity(d, region, f.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	_, err = waitForFunction(ctx, api, region, f.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -301,47 +310,11 @@ func ResourceFunctionRead(ctx context.Context, d *schema.ResourceData, m any) di
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if f.ErrorMessage != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "Function error",
-			Detail:   *f.ErrorMessage,
-		})
-	}
-
-	if f.RuntimeMessage != "" {
-		diags = append(diags, diag.Diagnostic{
-			Severity:      diag.Warning,
-			Summary:       "Function runtime warning",
-			Detail:        f.RuntimeMessage,
-			AttributePath: cty.GetAttrPath("runtime"),
-		})
-	}
-
-	_ = d.Set("description", f.Description)
-	_ = d.Set("environment_variables", f.EnvironmentVariables)
-	_ = d.Set("handler", f.Handler)
-	_ = d.Set("max_scale", int(f.MaxScale))
-	_ = d.Set("memory_limit", int(f.MemoryLimit))
-	_ = d.Set("cpu_limit", int(f.CPULimit))
-	_ = d.Set("min_scale", int(f.MinScale))
-	_ = d.Set("name", f.Name)
-	_ = d.Set("privacy", f.Privacy.String())
-	_ = d.Set("region", f.Region.String())
-	_ = d.Set("timeout", f.Timeout.Seconds)
-	_ = d.Set("domain_name", f.DomainName)
-	_ = d.Set("http_option", f.HTTPOption)
-	_ = d.Set("namespace_id", f.NamespaceID)
-	_ = d.Set("sandbox", f.Sandbox)
-	_ = d.Set("secret_environment_variables", flattenFunctionSecrets(f.SecretEnvironmentVariables))
-	_ = d.Set("tags", types.FlattenSliceString(f.Tags))
+	diags := setFunctionState(d, m, f)
 
-	if f.PrivateNetworkID != nil {
-		_ = d.Set("private_network_id", regional.NewID(region, types.FlattenStringPtr(f.PrivateNetworkID).(string)).String())
-	} else {
-		_ = d.Set("private_network_id", nil)
+	err = identity.SetRegionalIdentity(d, region, id)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
@@ -386,8 +359,8 @@ func ResourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, m any)
 		updated = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("memory_limit") {
@@ -503,3 +476,50 @@ func ResourceFunctionDelete(ctx context.Context, d *schema.ResourceData, m any)
 
 	return nil
 }
+
+func setFunctionState(d *schema.ResourceData, m any, f *function.Function) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if f.ErrorMessage != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Function error",
+			Detail:   *f.ErrorMessage,
+		})
+	}
+
+	if f.RuntimeMessage != "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Function runtime warning",
+			Detail:        f.RuntimeMessage,
+			AttributePath: cty.GetAttrPath("runtime"),
+		})
+	}
+
+	_ = d.Set("description", f.Description)
+	_ = d.Set("environment_variables", f.EnvironmentVariables)
+	_ = d.Set("handler", f.Handler)
+	_ = d.Set("max_scale", int(f.MaxScale))
+	_ = d.Set("memory_limit", int(f.MemoryLimit))
+	_ = d.Set("cpu_limit", int(f.CPULimit))
+	_ = d.Set("min_scale", int(f.MinScale))
+	_ = d.Set("name", f.Name)
+	_ = d.Set("privacy", f.Privacy.String())
+	_ = d.Set("region", f.Region.String())
+	_ = d.Set("timeout", f.Timeout.Seconds)
+	_ = d.Set("domain_name", f.DomainName)
+	_ = d.Set("http_option", f.HTTPOption)
+	_ = d.Set("namespace_id", f.NamespaceID)
+	_ = d.Set("sandbox", f.Sandbox)
+	_ = d.Set("secret_environment_variables", flattenFunctionSecrets(f.SecretEnvironmentVariables))
+	tags.Set(d, m, f.Tags)
+
+	if f.PrivateNetworkID != nil {
+		_ = d.Set("private_network_id", regional.NewID(f.Region, types.FlattenStringPtr(f.PrivateNetworkID).(string)).String())
+	} else {
+		_ = d.Set("private_network_id", nil)
+	}
+
+	return diags
+}
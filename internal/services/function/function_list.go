@@ -0,0 +1,274 @@
+package function
+
+import (
+	"cmp"
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*FunctionListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*FunctionListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*FunctionListResource)(nil)
+)
+
+type FunctionListResource struct {
+	meta        *meta.Meta
+	functionAPI *function.API
+}
+
+func (r *FunctionListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.functionAPI = function.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewFunctionListResource() list.ListResource {
+	return &FunctionListResource{}
+}
+
+func (r *FunctionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"namespace_id":    listscw.RegionalIDAttribute("ID of the namespace to list the functions of. The functions of every namespace of the projects and regions are listed if not set"),
+			"name":            listscw.NameAttribute("Name of the function to filter for"),
+			"tags":            listscw.TagsAttribute("Tags of the function to filter for"),
+			"statuses":        listscw.StatesAttribute[function.FunctionStatus]("Statuses of the function to filter for (e.g. ready, error)"),
+			"organization_id": listscw.OrganizationIDAttribute("Organization ID to filter for"),
+			"project_ids":     listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":         listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *FunctionListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	functionResource := ResourceFunction()
+
+	resp.ProtoV6Schema = translate.Schema(functionResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(functionResource.ProtoIdentitySchema(ctx)())
+}
+
+type FunctionListResourceModel struct {
+	Tags           types.List   `tfsdk:"tags"`
+	Statuses       types.List   `tfsdk:"statuses"`
+	Regions        types.List   `tfsdk:"regions"`
+	ProjectIDs     types.List   `tfsdk:"project_ids"`
+	NamespaceID    types.String `tfsdk:"namespace_id"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (m *FunctionListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *FunctionListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *FunctionListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *FunctionListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *FunctionListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function"
+}
+
+// functionFilters are the filters of the function list resource which are not supported by the API.
+type functionFilters struct {
+	tags     []string
+	statuses []string
+}
+
+func (f functionFilters) match(fn *function.Function) bool {
+	return listscw.MatchTags(f.tags, fn.Tags) && listscw.MatchStates(f.statuses, fn.Status.String())
+}
+
+// listNamespaces returns the namespaces to list the functions of: the one of namespaceID, or every namespace of the projects and regions.
+// A namespace ID without region is looked up in the first region.
+func listNamespaces(ctx context.Context, functionAPI *function.API, namespaceID *string, regions []scw.Region, projects []string, organizationID *string) ([]*function.Namespace, error) {
+	if namespaceID != nil {
+		return []*function.Namespace{{ID: *namespaceID, Region: regions[0]}}, nil
+	}
+
+	return listscw.FetchConcurrently(ctx, listscw.RegionalProjectTargets(regions, projects),
+		func(ctx context.Context, target listscw.RegionalFetchTarget) ([]*function.Namespace, error) {
+			return fetchNamespaces(ctx, functionAPI, target.Region, &target.ProjectID, nil, organizationID, nil, nil)
+		},
+		nil,
+	)
+}
+
+// listFunctions lists the functions of the namespaces, keeping the ones matching the filters.
+func listFunctions(ctx context.Context, functionAPI *function.API, namespaces []*function.Namespace, name *string, filters functionFilters) ([]*function.Function, error) {
+	return listscw.FetchConcurrently(ctx, namespaces,
+		func(ctx context.Context, namespace *function.Namespace) ([]*function.Function, error) {
+			response, err := functionAPI.ListFunctions(&function.ListFunctionsRequest{
+				Region:      namespace.Region,
+				NamespaceID: namespace.ID,
+				Name:        name,
+			}, scw.WithContext(ctx), scw.WithAllPages())
+			if err != nil {
+				return nil, err
+			}
+
+			functions := make([]*function.Function, 0, len(response.Functions))
+
+			for _, fn := range response.Functions {
+				if filters.match(fn) {
+					functions = append(functions, fn)
+				}
+			}
+
+			return functions, nil
+		},
+		func(a, b *function.Function) int {
+			return cmp.Or(
+				strings.Compare(string(a.Region), string(b.Region)),
+				strings.Compare(a.NamespaceID, b.NamespaceID),
+				strings.Compare(a.ID, b.ID),
+			)
+		},
+	)
+}
+
+func (r *FunctionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data FunctionListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	filters := functionFilters{}
+
+	filters.tags, diags = listscw.ExtractTags(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	filters.statuses, diags = listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	regions, err := listscw.ExtractRegions(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing regions", "An error was encountered when listing regions: "+err.Error()),
+		})
+
+		return
+	}
+
+	namespaceID, regions := listscw.ExtractRegionalID(data.NamespaceID, regions)
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	namespaces, err := listNamespaces(ctx, r.functionAPI, namespaceID, regions, projects, data.OrganizationID.ValueStringPointer())
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing namespaces", "An error was encountered when listing function namespaces: "+err.Error()),
+		})
+
+		return
+	}
+
+	allFunctions, err := listFunctions(ctx, r.functionAPI, namespaces, data.Name.ValueStringPointer(), filters)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing functions", "Failed to list functions: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, fn := range allFunctions {
+			result := req.NewListResult(ctx)
+			result.DisplayName = fn.Name
+
+			functionResource := ResourceFunction()
+			resourceData := functionResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, fn.Region, fn.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			sdkDiags := setFunctionState(resourceData, r.meta, fn)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting function state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListFunctions_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListFunctions_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckFunctionDestroy(tt),
+			testAccCheckFunctionNamespaceDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_function_namespace" "main" {
+					  name = "tf-acc-function-function-list"
+					}
+
+					resource "scaleway_function" "main" {
+					  name         = "tf-acc-function-function-list"
+					  namespace_id = scaleway_function_namespace.main.id
+					  runtime      = "node26"
+					  privacy      = "private"
+					  handler      = "handler.handle"
+					  tags         = ["tf-acc-function-list"]
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_function" "by_namespace" {
+					  provider = scaleway
+
+					  config {
+					    namespace_id = scaleway_function_namespace.main.id
+					  }
+					}
+
+					list "scaleway_function" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    project_ids = [scaleway_function_namespace.main.project_id]
+					    tags        = ["tf-acc-function-list"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_function.by_namespace", 1),
+					querycheck.ExpectLength("list.scaleway_function.by_tag", 1),
+				},
+			},
+		},
+	})
+}
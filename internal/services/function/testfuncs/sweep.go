@@ -10,19 +10,19 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_function_cron", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_function_cron", &resource.Sweeper{
 		Name: "scaleway_function_cron",
 		F:    testSweepFunctionCron,
 	})
-	resource.AddTestSweepers("scaleway_function", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_function", &resource.Sweeper{
 		Name: "scaleway_function",
 		F:    testSweepFunction,
 	})
-	resource.AddTestSweepers("scaleway_function_namespace", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_function_namespace", &resource.Sweeper{
 		Name: "scaleway_function_namespace",
 		F:    testSweepFunctionNamespace,
 	})
-	resource.AddTestSweepers("scaleway_function_trigger", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_function_trigger", &resource.Sweeper{
 		Name: "scaleway_function_trigger",
 		F:    testSweepFunctionTrigger,
 	})
@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -23,9 +24,7 @@ func ResourceTrigger() *schema.Resource {
 		ReadContext:   ResourceFunctionTriggerRead,
 		UpdateContext: ResourceFunctionTriggerUpdate,
 		DeleteContext: ResourceFunctionTriggerDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      identity.DefaultRegionalImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(DefaultFunctionTimeout),
 			Read:    schema.DefaultTimeout(DefaultFunctionTimeout),
@@ -34,6 +33,7 @@ func ResourceTrigger() *schema.Resource {
 			Create:  schema.DefaultTimeout(DefaultFunctionTimeout),
 		},
 		SchemaVersion: 0,
+		Identity:      identity.DefaultRegional(),
 		SchemaFunc:    triggerSchema,
 		CustomizeDiff: cdf.LocalityCheck("function_id"),
 	}
@@ -178,7 +178,10 @@ func ResourceFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	d.SetId(regional.NewIDString(region, trigger.ID))
+	err = identity.SetRegionalIdentity(d, region, trigger.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	_, err = waitForTrigger(ctx, api, region, trigger.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -205,22 +208,11 @@ func ResourceFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set("name", trigger.Name)
-	_ = d.Set("description", trigger.Description)
+	diags := setTriggerState(d, trigger)
 
-	diags := diag.Diagnostics(nil)
-
-	if trigger.Status == function.TriggerStatusError {
-		errMsg := ""
-		if trigger.ErrorMessage != nil {
-			errMsg = *trigger.ErrorMessage
-		}
-
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "Trigger in error state",
-			Detail:   errMsg,
-		})
+	err = identity.SetRegionalIdentity(d, region, id)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
@@ -289,3 +281,25 @@ func ResourceFunctionTriggerDelete(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+func setTriggerState(d *schema.ResourceData, trigger *function.Trigger) diag.Diagnostics {
+	_ = d.Set("name", trigger.Name)
+	_ = d.Set("description", trigger.Description)
+
+	diags := diag.Diagnostics(nil)
+
+	if trigger.Status == function.TriggerStatusError {
+		errMsg := ""
+		if trigger.ErrorMessage != nil {
+			errMsg = *trigger.ErrorMessage
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Trigger in error state",
+			Detail:   errMsg,
+		})
+	}
+
+	return diags
+}
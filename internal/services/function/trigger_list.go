@@ -0,0 +1,241 @@
+package function
+
+import (
+	"cmp"
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*TriggerListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*TriggerListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*TriggerListResource)(nil)
+)
+
+type TriggerListResource struct {
+	meta        *meta.Meta
+	functionAPI *function.API
+}
+
+func (r *TriggerListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.functionAPI = function.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewTriggerListResource() list.ListResource {
+	return &TriggerListResource{}
+}
+
+func (r *TriggerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_id":  listscw.RegionalIDAttribute("ID of the function to list the triggers of"),
+			"namespace_id": listscw.RegionalIDAttribute("ID of the namespace to list the triggers of"),
+			"name":         listscw.NameAttribute("Name of the trigger to filter for"),
+			"statuses":     listscw.StatesAttribute[function.TriggerStatus]("Statuses of the trigger to filter for (e.g. ready, error)"),
+			"project_ids":  listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":      listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *TriggerListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	triggerResource := ResourceTrigger()
+
+	resp.ProtoV6Schema = translate.Schema(triggerResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(triggerResource.ProtoIdentitySchema(ctx)())
+}
+
+type TriggerListResourceModel struct {
+	Statuses    types.List   `tfsdk:"statuses"`
+	Regions     types.List   `tfsdk:"regions"`
+	ProjectIDs  types.List   `tfsdk:"project_ids"`
+	FunctionID  types.String `tfsdk:"function_id"`
+	NamespaceID types.String `tfsdk:"namespace_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (m *TriggerListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *TriggerListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *TriggerListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *TriggerListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_trigger"
+}
+
+// regionalTrigger is a trigger with the region it was listed in.
+type regionalTrigger struct {
+	*function.Trigger
+	region scw.Region
+}
+
+func (r *TriggerListResource) FetchTriggers(ctx context.Context, region scw.Region, project, functionID, namespaceID *string, statuses []string, data TriggerListResourceModel) ([]*regionalTrigger, error) {
+	response, err := r.functionAPI.ListTriggers(&function.ListTriggersRequest{
+		Region:      region,
+		FunctionID:  functionID,
+		NamespaceID: namespaceID,
+		ProjectID:   project,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	triggers := make([]*regionalTrigger, 0, len(response.Triggers))
+
+	for _, trigger := range response.Triggers {
+		if (data.Name.IsNull() || trigger.Name == data.Name.ValueString()) &&
+			listscw.MatchStates(statuses, trigger.Status.String()) {
+			triggers = append(triggers, &regionalTrigger{Trigger: trigger, region: region})
+		}
+	}
+
+	return triggers, nil
+}
+
+func (r *TriggerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data TriggerListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	statuses, diags := listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	regions, err := listscw.ExtractRegions(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing regions", "An error was encountered when listing regions: "+err.Error()),
+		})
+
+		return
+	}
+
+	functionID, regions := listscw.ExtractRegionalID(data.FunctionID, regions)
+	namespaceID, regions := listscw.ExtractRegionalID(data.NamespaceID, regions)
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allTriggers, err := listscw.FetchConcurrently(ctx, listscw.RegionalProjectTargets(regions, projects),
+		func(ctx context.Context, target listscw.RegionalFetchTarget) ([]*regionalTrigger, error) {
+			return r.FetchTriggers(ctx, target.Region, &target.ProjectID, functionID, namespaceID, statuses, data)
+		},
+		func(a, b *regionalTrigger) int {
+			return cmp.Or(
+				strings.Compare(string(a.region), string(b.region)),
+				strings.Compare(a.FunctionID, b.FunctionID),
+				strings.Compare(a.ID, b.ID),
+			)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing triggers", "Failed to list function triggers: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, trigger := range allTriggers {
+			result := req.NewListResult(ctx)
+			result.DisplayName = trigger.Name
+
+			triggerResource := ResourceTrigger()
+			resourceData := triggerResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, trigger.region, trigger.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			sdkDiags := setTriggerState(resourceData, trigger.Trigger)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting trigger state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			// The function and region are known from the configuration when reading a trigger, but not when listing them
+			_ = resourceData.Set("function_id", regional.NewIDString(trigger.region, trigger.FunctionID))
+			_ = resourceData.Set("region", trigger.region.String())
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
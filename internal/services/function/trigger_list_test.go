@@ -0,0 +1,82 @@
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListFunctionTriggers_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListFunctionTriggers_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckFunctionTriggerDestroy(tt),
+			testAccCheckFunctionDestroy(tt),
+			testAccCheckFunctionNamespaceDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_function_namespace" "main" {
+					  name = "tf-acc-function-trigger-list"
+					}
+
+					resource "scaleway_function" "main" {
+					  name         = "tf-acc-function-trigger-list"
+					  namespace_id = scaleway_function_namespace.main.id
+					  runtime      = "node26"
+					  privacy      = "private"
+					  handler      = "handler.handle"
+					}
+
+					resource "scaleway_mnq_nats_account" "main" {}
+
+					resource "scaleway_function_trigger" "main" {
+					  name        = "tf-acc-function-trigger-list"
+					  function_id = scaleway_function.main.id
+
+					  nats {
+					    subject    = "TestSubject"
+					    account_id = scaleway_mnq_nats_account.main.id
+					    region     = scaleway_mnq_nats_account.main.region
+					  }
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_function_trigger" "by_function" {
+					  provider = scaleway
+
+					  config {
+					    function_id = scaleway_function.main.id
+					  }
+					}
+
+					list "scaleway_function_trigger" "by_namespace" {
+					  provider = scaleway
+
+					  config {
+					    namespace_id = scaleway_function_namespace.main.id
+					    name         = "tf-acc-function-trigger-list"
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_function_trigger.by_function", 1),
+					querycheck.ExpectLength("list.scaleway_function_trigger.by_namespace", 1),
+				},
+			},
+		},
+	})
+}
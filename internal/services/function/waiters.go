@@ -11,8 +11,8 @@ import (
 
 func waitForNamespace(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (*function.Namespace, error) {
 	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	ns, err := functionAPI.WaitForNamespace(&function.WaitForNamespaceRequest{
@@ -27,8 +27,8 @@ func waitForNamespace(ctx context.Context, functionAPI *function.API, region scw
 
 func waitForFunction(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (*function.Function, error) {
 	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	f, err := functionAPI.WaitForFunction(&function.WaitForFunctionRequest{
@@ -43,8 +43,8 @@ func waitForFunction(ctx context.Context, functionAPI *function.API, region scw.
 
 func waitForCron(ctx context.Context, functionAPI *function.API, region scw.Region, cronID string, timeout time.Duration) (*function.Cron, error) {
 	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return functionAPI.WaitForCron(&function.WaitForCronRequest{
@@ -57,8 +57,8 @@ func waitForCron(ctx context.Context, functionAPI *function.API, region scw.Regi
 
 func waitForDomain(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (*function.Domain, error) {
 	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	domain, err := functionAPI.WaitForDomain(&function.WaitForDomainRequest{
@@ -73,8 +73,8 @@ func waitForDomain(ctx context.Context, functionAPI *function.API, region scw.Re
 
 func waitForTrigger(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (*function.Trigger, error) {
 	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	trigger, err := functionAPI.WaitForTrigger(&function.WaitForTriggerRequest{
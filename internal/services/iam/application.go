@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -25,6 +26,7 @@ func ResourceApplication() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    applicationSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -64,6 +66,7 @@ func applicationSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the application",
 		},
+		"tags_all":        tags.AllSchema(),
 		"organization_id": account.OrganizationIDOptionalSchema(),
 	}
 }
@@ -75,7 +78,7 @@ func resourceIamApplicationCreate(ctx context.Context, d *schema.ResourceData, m
 		Name:           types.ExpandOrGenerateString(d.Get("name"), "application"),
 		Description:    d.Get("description").(string),
 		OrganizationID: d.Get("organization_id").(string),
-		Tags:           types.ExpandStrings(d.Get("tags")),
+		Tags:           tags.Expand(d, m),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -105,7 +108,7 @@ func resourceIamApplicationRead(ctx context.Context, d *schema.ResourceData, m a
 		return diag.FromErr(err)
 	}
 
-	setApplicationState(d, app)
+	setApplicationState(d, m, app)
 
 	err = identity.SetGlobalIdentity(d, app.ID)
 	if err != nil {
@@ -134,8 +137,8 @@ func resourceIamApplicationUpdate(ctx context.Context, d *schema.ResourceData, m
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -162,12 +165,12 @@ func resourceIamApplicationDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func setApplicationState(d *schema.ResourceData, app *iam.Application) {
+func setApplicationState(d *schema.ResourceData, m any, app *iam.Application) {
 	_ = d.Set("name", app.Name)
 	_ = d.Set("description", app.Description)
 	_ = d.Set("created_at", types.FlattenTime(app.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(app.UpdatedAt))
 	_ = d.Set("organization_id", app.OrganizationID)
 	_ = d.Set("editable", app.Editable)
-	_ = d.Set("tags", types.FlattenSliceString(app.Tags))
+	tags.Set(d, m, app.Tags)
 }
@@ -178,7 +178,7 @@ func (r *ApplicationListResource) List(ctx context.Context, req list.ListRequest
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			setApplicationState(resourceData, application)
+			setApplicationState(resourceData, r.meta, application)
 
 			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
 			if errTfTypeResourceState != nil {
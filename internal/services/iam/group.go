@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -26,6 +27,7 @@ func ResourceGroup() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    groupSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -84,6 +86,7 @@ func groupSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the application",
 		},
+		"tags_all":        tags.AllSchema(),
 		"organization_id": account.OrganizationIDOptionalSchema(),
 	}
 }
@@ -94,7 +97,7 @@ func resourceIamGroupCreate(ctx context.Context, d *schema.ResourceData, m any)
 		OrganizationID: d.Get("organization_id").(string),
 		Name:           types.ExpandOrGenerateString(d.Get("name"), "group"),
 		Description:    d.Get("description").(string),
-		Tags:           types.ExpandStrings(d.Get("tags")),
+		Tags:           tags.Expand(d, m),
 	}
 
 	group, err := api.CreateGroup(req, scw.WithContext(ctx))
@@ -141,7 +144,7 @@ func resourceIamGroupRead(ctx context.Context, d *schema.ResourceData, m any) di
 	}
 
 	external_membership := d.Get("external_membership").(bool)
-	diags := setGroupState(d, group, external_membership)
+	diags := setGroupState(d, m, group, external_membership)
 
 	err = identity.SetGlobalIdentity(d, group.ID)
 	if err != nil {
@@ -161,12 +164,12 @@ func resourceIamGroupUpdate(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("name", "description", "tags") {
+	if d.HasChanges("name", "description", "tags", "tags_all") {
 		_, err = api.UpdateGroup(&iam.UpdateGroupRequest{
 			GroupID:     group.ID,
 			Name:        types.ExpandUpdatedStringPtr(d.Get("name")),
 			Description: types.ExpandUpdatedStringPtr(d.Get("description")),
-			Tags:        types.ExpandUpdatedStringsPtr(d.Get("tags")),
+			Tags:        tags.ExpandUpdated(d, m),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
@@ -225,13 +228,13 @@ func resourceIamGroupDelete(ctx context.Context, d *schema.ResourceData, m any)
 	return nil
 }
 
-func setGroupState(d *schema.ResourceData, group *iam.Group, external_membership bool) diag.Diagnostics {
+func setGroupState(d *schema.ResourceData, m any, group *iam.Group, external_membership bool) diag.Diagnostics {
 	_ = d.Set("name", group.Name)
 	_ = d.Set("description", group.Description)
 	_ = d.Set("created_at", types.FlattenTime(group.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(group.UpdatedAt))
 	_ = d.Set("organization_id", group.OrganizationID)
-	_ = d.Set("tags", types.FlattenSliceString(group.Tags))
+	tags.Set(d, m, group.Tags)
 
 	if !external_membership {
 		_ = d.Set("user_ids", group.UserIDs)
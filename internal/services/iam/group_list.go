@@ -169,7 +169,7 @@ func (r *GroupListResource) List(ctx context.Context, req list.ListRequest, stre
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			setGroupState(resourceData, group, false)
+			setGroupState(resourceData, r.meta, group, false)
 
 			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
 			if errTfTypeResourceState != nil {
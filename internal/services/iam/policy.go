@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -27,6 +28,7 @@ func ResourcePolicy() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    policySchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -131,6 +133,7 @@ func policySchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the policy",
 		},
+		"tags_all": tags.AllSchema(),
 	}
 }
 
@@ -146,7 +149,7 @@ func resourceIamPolicyCreate(ctx context.Context, d *schema.ResourceData, m any)
 		ApplicationID:  types.ExpandStringPtr(d.Get("application_id")),
 		NoPrincipal:    types.ExpandBoolPtr(types.GetBool(d, "no_principal")),
 		OrganizationID: d.Get("organization_id").(string),
-		Tags:           types.ExpandStrings(d.Get("tags")),
+		Tags:           tags.Expand(d, m),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -183,7 +186,7 @@ func resourceIamPolicyRead(ctx context.Context, d *schema.ResourceData, m any) d
 		return diag.FromErr(fmt.Errorf("failed to list policy's rules: %w", err))
 	}
 
-	setPolicyState(d, pol, listRules.Rules)
+	setPolicyState(d, m, pol, listRules.Rules)
 
 	err = identity.SetGlobalIdentity(d, pol.ID)
 	if err != nil {
@@ -212,9 +215,9 @@ func resourceIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m any)
 		req.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		hasUpdated = true
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("user_id") {
@@ -276,14 +279,14 @@ func resourceIamPolicyDelete(ctx context.Context, d *schema.ResourceData, m any)
 	return nil
 }
 
-func setPolicyState(d *schema.ResourceData, pol *iam.Policy, rules []*iam.Rule) {
+func setPolicyState(d *schema.ResourceData, m any, pol *iam.Policy, rules []*iam.Rule) {
 	_ = d.Set("name", pol.Name)
 	_ = d.Set("description", pol.Description)
 	_ = d.Set("created_at", types.FlattenTime(pol.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(pol.UpdatedAt))
 	_ = d.Set("organization_id", pol.OrganizationID)
 	_ = d.Set("editable", pol.Editable)
-	_ = d.Set("tags", types.FlattenSliceString(pol.Tags))
+	tags.Set(d, m, pol.Tags)
 
 	if pol.UserID != nil {
 		_ = d.Set("user_id", types.FlattenStringPtr(pol.UserID))
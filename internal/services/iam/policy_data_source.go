@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -86,7 +87,8 @@ func DataSourceIamPolicyRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(fmt.Errorf("failed to list policy's rules: %w", err))
 	}
 
-	setPolicyState(d, pol, listRules.Rules)
+	setPolicyState(d, m, pol, listRules.Rules)
+	tags.SetDataSource(d, m)
 
 	return nil
 }
@@ -236,7 +236,7 @@ func (r *PolicyListResource) List(ctx context.Context, req list.ListRequest, str
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			setPolicyState(resourceData, policy, nil)
+			setPolicyState(resourceData, r.meta, policy, nil)
 
 			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
 			if errTfTypeResourceState != nil {
@@ -12,35 +12,35 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_iam_api_key", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_api_key", &resource.Sweeper{
 		Name: "scaleway_iam_api_key",
 		F:    testSweepIamAPIKey,
 	})
-	resource.AddTestSweepers("scaleway_iam_application", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_application", &resource.Sweeper{
 		Name: "scaleway_iam_application",
 		F:    testSweepIamApplication,
 	})
-	resource.AddTestSweepers("scaleway_iam_group", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_group", &resource.Sweeper{
 		Name: "scaleway_iam_group",
 		F:    testSweepIamGroup,
 	})
-	resource.AddTestSweepers("scaleway_iam_policy", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_policy", &resource.Sweeper{
 		Name: "scaleway_iam_policy",
 		F:    testSweepIamPolicy,
 	})
-	resource.AddTestSweepers("scaleway_iam_ssh_key", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_ssh_key", &resource.Sweeper{
 		Name: "scaleway_iam_ssh_key",
 		F:    testSweepSSHKey,
 	})
-	resource.AddTestSweepers("scaleway_iam_user", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_user", &resource.Sweeper{
 		Name: "scaleway_iam_user",
 		F:    testSweepUser,
 	})
-	resource.AddTestSweepers("scaleway_iam_saml", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_saml", &resource.Sweeper{
 		Name: "scaleway_iam_saml",
 		F:    testSweepSaml,
 	})
-	resource.AddTestSweepers("scaleway_iam_scim", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_scim", &resource.Sweeper{
 		Name: "scaleway_iam_scim",
 		F:    testSweepScim,
 	})
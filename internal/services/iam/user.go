@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -30,6 +31,7 @@ func ResourceUser() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    userSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -48,6 +50,7 @@ func userSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the user",
 		},
+		"tags_all": tags.AllSchema(),
 		"send_password_email": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -167,7 +170,7 @@ func resourceIamUserCreate(ctx context.Context, d *schema.ResourceData, m any) d
 
 	req := &iam.CreateUserRequest{
 		OrganizationID: d.Get("organization_id").(string),
-		Tags:           types.ExpandStrings(d.Get("tags")),
+		Tags:           tags.Expand(d, m),
 		Member: &iam.CreateUserRequestMember{
 			Email:             d.Get("email").(string),
 			SendPasswordEmail: d.Get("send_password_email").(bool),
@@ -210,7 +213,7 @@ func resourceIamUserRead(ctx context.Context, d *schema.ResourceData, m any) dia
 		return diag.FromErr(err)
 	}
 
-	setUserState(d, user)
+	setUserState(d, m, user)
 
 	err = identity.SetGlobalIdentity(d, user.ID)
 	if err != nil {
@@ -232,8 +235,8 @@ func resourceIamUserUpdate(ctx context.Context, d *schema.ResourceData, m any) d
 
 	req := &iam.UpdateUserRequest{UserID: user.ID}
 
-	if d.HasChanges("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("email") {
@@ -287,10 +290,10 @@ func resourceIamUserDelete(ctx context.Context, d *schema.ResourceData, m any) d
 	return nil
 }
 
-func setUserState(d *schema.ResourceData, user *iam.User) {
+func setUserState(d *schema.ResourceData, m any, user *iam.User) {
 	_ = d.Set("organization_id", user.OrganizationID)
 	_ = d.Set("email", user.Email)
-	_ = d.Set("tags", types.FlattenSliceString(user.Tags))
+	tags.Set(d, m, user.Tags)
 	_ = d.Set("username", user.Username)
 	_ = d.Set("first_name", user.FirstName)
 	_ = d.Set("last_name", user.LastName)
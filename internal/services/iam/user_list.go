@@ -181,7 +181,7 @@ func (r *UserListResource) List(ctx context.Context, req list.ListRequest, strea
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			setUserState(resourceData, user)
+			setUserState(resourceData, r.meta, user)
 
 			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
 			if errTfTypeResourceState != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -38,6 +39,7 @@ func ResourceDeployment() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    deploymentSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -81,6 +83,7 @@ func deploymentSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the deployment",
 		},
+		"tags_all": tags.AllSchema(),
 		"min_size": {
 			Type:         schema.TypeInt,
 			Optional:     true,
@@ -219,7 +222,7 @@ func ResourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m any
 		Name:         d.Get("name").(string),
 		NodeTypeName: d.Get("node_type").(string),
 		ModelID:      locality.ExpandID(d.Get("model_id").(string)),
-		Tags:         types.ExpandStrings(d.Get("tags")),
+		Tags:         tags.Expand(d, m),
 		Endpoints:    buildEndpoints(d),
 	}
 
@@ -313,7 +316,7 @@ func ResourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m any)
 	_ = d.Set("size", int(deployment.Size))
 	_ = d.Set("status", deployment.Status)
 	_ = d.Set("model_id", deployment.ModelID)
-	_ = d.Set("tags", types.ExpandUpdatedStringsPtr(deployment.Tags))
+	tags.Set(d, m, deployment.Tags)
 	_ = d.Set("created_at", types.FlattenTime(deployment.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(deployment.UpdatedAt))
 
@@ -428,8 +431,8 @@ func ResourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m any
 		req.Name = types.ExpandUpdatedStringPtr(d.Get("name"))
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("min_size") {
@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -241,7 +242,7 @@ func ResourceModelRead(ctx context.Context, d *schema.ResourceData, m any) diag.
 	_ = d.Set("name", model.Name)
 	_ = d.Set("status", model.Status.String())
 	_ = d.Set("description", model.Description)
-	_ = d.Set("tags", model.Tags)
+	_ = d.Set("tags", tags.Flatten(m, model.Tags))
 	_ = d.Set("created_at", types.FlattenTime(model.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(model.UpdatedAt))
 	_ = d.Set("has_eula", model.HasEula)
@@ -9,12 +9,12 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_inference_deployment", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_inference_deployment", &resource.Sweeper{
 		Name:         "scaleway_inference_deployment",
 		Dependencies: nil,
 		F:            testSweepDeployment,
 	})
-	resource.AddTestSweepers("scaleway_inference_model", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_inference_model", &resource.Sweeper{
 		Name:         "scaleway_inference_model",
 		Dependencies: nil,
 		F:            testSweepModel,
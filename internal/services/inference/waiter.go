@@ -11,8 +11,8 @@ import (
 
 func waitForDeployment(ctx context.Context, inferenceAPI *inference.API, region scw.Region, id string, timeout time.Duration) (*inference.Deployment, error) {
 	retryInterval := defaultDeploymentRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	deployment, err := inferenceAPI.WaitForDeployment(&inference.WaitForDeploymentRequest{
@@ -27,8 +27,8 @@ func waitForDeployment(ctx context.Context, inferenceAPI *inference.API, region
 
 func waitForModel(ctx context.Context, inferenceAPI *inference.API, region scw.Region, id string, timeout time.Duration) (*inference.Model, error) {
 	retryInterval := defaultModelRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	model, err := inferenceAPI.WaitForModel(&inference.WaitForModelRequest{
@@ -12,6 +12,9 @@ const (
 	// InstanceServerStateStandby transient state of the instance event waiting third action or rescue mode
 	InstanceServerStateStandby = "standby"
 
+	// InstanceServerPreStopActionNone powers off the server without trying a soft stop first
+	InstanceServerPreStopActionNone = "none"
+
 	DefaultInstanceServerWaitTimeout        = 20 * time.Minute
 	defaultInstancePrivateNICWaitTimeout    = 10 * time.Minute
 	defaultInstanceVolumeDeleteTimeout      = 10 * time.Minute
@@ -23,5 +26,7 @@ const (
 
 	defaultInstanceSnapshotWaitTimeout = 1 * time.Hour
 
+	defaultInstanceServerGracefulTimeout = 5 * time.Minute
+
 	defaultInstanceImageTimeout = 1 * time.Hour
 )
@@ -167,7 +167,142 @@ func serverStateExpand(rawState string) (instance.ServerState, error) {
 	return apiState, nil
 }
 
-func reachState(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, serverID string, toState instance.ServerState) error {
+// shutdownPolicy describes how a running server is powered off
+type shutdownPolicy struct {
+	// PreStopAction is the soft stop tried before powering off the server, empty to power it off right away
+	PreStopAction instance.ServerAction
+	// GracefulTimeout is the time given to the soft stop before falling back to a hard power off
+	GracefulTimeout time.Duration
+}
+
+// softStops returns true if the policy tries a soft stop before powering off the server
+func (p *shutdownPolicy) softStops() bool {
+	return p != nil && p.PreStopAction != ""
+}
+
+// stopAction returns the action used to stop the server: the soft stop, or a power off without one
+func (p *shutdownPolicy) stopAction() instance.ServerAction {
+	if p.softStops() {
+		return p.PreStopAction
+	}
+
+	return instance.ServerActionPoweroff
+}
+
+// expandShutdownPolicy returns the shutdown policy of a server, nil if none is configured
+func expandShutdownPolicy(raw any) (*shutdownPolicy, error) {
+	rawList, ok := raw.([]any)
+	if !ok || len(rawList) == 0 || rawList[0] == nil {
+		return nil, nil
+	}
+
+	rawPolicy := rawList[0].(map[string]any)
+
+	return newShutdownPolicy(rawPolicy["pre_stop_action"].(string), rawPolicy["graceful_timeout"].(string))
+}
+
+// newShutdownPolicy builds a shutdown policy, empty values fall back to the defaults
+func newShutdownPolicy(preStopAction string, gracefulTimeout string) (*shutdownPolicy, error) {
+	policy := &shutdownPolicy{
+		PreStopAction:   instance.ServerActionStopInPlace,
+		GracefulTimeout: defaultInstanceServerGracefulTimeout,
+	}
+
+	switch preStopAction {
+	case "":
+		// keep the default soft stop
+	case InstanceServerPreStopActionNone:
+		policy.PreStopAction = ""
+	default:
+		policy.PreStopAction = instance.ServerAction(preStopAction)
+	}
+
+	if gracefulTimeout != "" {
+		timeout, err := types.ExpandDuration(gracefulTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shutdown policy graceful_timeout: %w", err)
+		}
+
+		policy.GracefulTimeout = *timeout
+	}
+
+	return policy, nil
+}
+
+// stopServerGracefully tries the soft stop of the shutdown policy, and powers the server off when it is not stopped
+// once the graceful timeout is elapsed. A server stopped in place by the soft stop is considered stopped.
+func stopServerGracefully(ctx context.Context, api *instance.API, zone scw.Zone, serverID string, policy *shutdownPolicy) error {
+	if policy.softStops() {
+		_, err := api.ServerAction(&instance.ServerActionRequest{
+			Zone:     zone,
+			ServerID: serverID,
+			Action:   policy.PreStopAction,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to %s server %s before powering it off: %w", policy.PreStopAction, serverID, err)
+		}
+
+		state, err := waitForServerSoftStop(ctx, api, zone, serverID, policy.GracefulTimeout)
+		if err != nil {
+			return err
+		}
+
+		if state == instance.ServerStateStopped || state == instance.ServerStateStoppedInPlace {
+			return nil
+		}
+
+		// The soft stop did not complete in time, the server is powered off anyway
+		tflog.Warn(ctx, fmt.Sprintf("server %s did not stop within %s, powering it off", serverID, policy.GracefulTimeout))
+	}
+
+	return api.ServerActionAndWait(&instance.ServerActionAndWaitRequest{
+		ServerID:      serverID,
+		Action:        instance.ServerActionPoweroff,
+		Zone:          zone,
+		Timeout:       new(DefaultInstanceServerWaitTimeout),
+		RetryInterval: transport.WaitRetryInterval(ctx),
+	}, scw.WithContext(ctx))
+}
+
+// waitForServerSoftStop polls the server until it is stopped or until the timeout is elapsed, and returns its state.
+// The server is still running right after the soft stop is requested, so waiting for a terminal state would return right away.
+func waitForServerSoftStop(ctx context.Context, api *instance.API, zone scw.Zone, serverID string, timeout time.Duration) (instance.ServerState, error) {
+	retryInterval := instancehelpers.DefaultInstanceRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		res, err := api.GetServer(&instance.GetServerRequest{
+			Zone:     zone,
+			ServerID: serverID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+
+		state := res.Server.State
+		if state != instance.ServerStateRunning && state != instance.ServerStateStopping {
+			return state, nil
+		}
+
+		// The server is still running or stopping, it is powered off by the caller
+		if !time.Now().Before(deadline) {
+			return state, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(min(retryInterval, time.Until(deadline))):
+		}
+	}
+}
+
+// reachStateWithShutdownPolicy brings the server to the given state, powering it off according to the shutdown policy when needed
+func reachStateWithShutdownPolicy(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, serverID string, toState instance.ServerState, policy *shutdownPolicy) error {
 	response, err := api.GetServer(&instance.GetServerRequest{
 		Zone:     zone,
 		ServerID: serverID,
@@ -182,6 +317,11 @@ func reachState(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, z
 		return nil
 	}
 
+	// A server stopped in place is considered stopped when the policy stops it in place, it is not powered on again to power it off
+	if fromState == instance.ServerStateStoppedInPlace && toState == instance.ServerStateStopped && policy.softStops() {
+		return nil
+	}
+
 	transitionMap := map[[2]instance.ServerState][]instance.ServerAction{
 		{instance.ServerStateStopped, instance.ServerStateRunning}:        {instance.ServerActionPoweron},
 		{instance.ServerStateStopped, instance.ServerStateStoppedInPlace}: {instance.ServerActionPoweron, instance.ServerActionStopInPlace},
@@ -203,7 +343,7 @@ func reachState(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, z
 			_, err := api.BlockAPI.WaitForVolumeAndReferences(&blockSDK.WaitForVolumeAndReferencesRequest{
 				VolumeID:      volume.ID,
 				Zone:          zone,
-				RetryInterval: transport.DefaultWaitRetryInterval,
+				RetryInterval: transport.WaitRetryInterval(ctx),
 			}, scw.WithContext(ctx))
 			if err != nil {
 				return err
@@ -212,7 +352,7 @@ func reachState(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, z
 			_, err = api.WaitForVolume(&instance.WaitForVolumeRequest{
 				Zone:          zone,
 				VolumeID:      volume.ID,
-				RetryInterval: transport.DefaultWaitRetryInterval,
+				RetryInterval: transport.WaitRetryInterval(ctx),
 			}, scw.WithContext(ctx))
 			if err != nil {
 				return err
@@ -221,12 +361,21 @@ func reachState(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, z
 	}
 
 	for _, a := range actions {
+		if a == instance.ServerActionPoweroff && policy != nil {
+			err = stopServerGracefully(ctx, api.API, zone, serverID, policy)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		err = api.ServerActionAndWait(&instance.ServerActionAndWaitRequest{
 			ServerID:      serverID,
 			Action:        a,
 			Zone:          zone,
 			Timeout:       new(DefaultInstanceServerWaitTimeout),
-			RetryInterval: transport.DefaultWaitRetryInterval,
+			RetryInterval: transport.WaitRetryInterval(ctx),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return err
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -15,6 +16,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/instancehelpers"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -39,7 +41,10 @@ func ResourceImage() *schema.Resource {
 		SchemaVersion: 0,
 		SchemaFunc:    imageSchema,
 		Identity:      identity.DefaultZonal(),
-		CustomizeDiff: cdf.LocalityCheck("root_volume_id", "additional_volume_ids.#"),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("root_volume_id", "additional_volume_ids.#"),
+			tags.CustomizeDiff,
+		),
 	}
 }
 
@@ -82,6 +87,7 @@ func imageSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"public": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -210,9 +216,8 @@ func ResourceInstanceImageCreate(ctx context.Context, d *schema.ResourceData, m
 		req.ExtraVolumes = expandImageExtraVolumesTemplates(locality.ExpandIDs(extraVolumesIDs))
 	}
 
-	tags, tagsExist := d.GetOk("tags")
-	if tagsExist {
-		req.Tags = types.ExpandStrings(tags)
+	if imageTags := tags.Expand(d, m); len(imageTags) > 0 {
+		req.Tags = imageTags
 	}
 
 	if _, exist := d.GetOk("public"); exist {
@@ -232,23 +237,23 @@ func ResourceInstanceImageCreate(ctx context.Context, d *schema.ResourceData, m
 	image, err := api.WaitForImage(&instanceSDK.WaitForImageRequest{
 		ImageID:       res.Image.ID,
 		Zone:          zone,
-		RetryInterval: transport.DefaultWaitRetryInterval,
+		RetryInterval: transport.WaitRetryInterval(ctx),
 		Timeout:       new(d.Timeout(schema.TimeoutCreate)),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return setImageState(d, image)
+	return setImageState(d, m, image)
 }
 
-func setImageState(d *schema.ResourceData, image *instanceSDK.Image) diag.Diagnostics {
+func setImageState(d *schema.ResourceData, m any, image *instanceSDK.Image) diag.Diagnostics {
 	_ = d.Set("name", image.Name)
 	_ = d.Set("root_volume_id", zonal.NewIDString(image.Zone, image.RootVolume.ID))
 	_ = d.Set("architecture", image.Arch)
 	_ = d.Set("root_volume", flattenImageRootVolume(image.RootVolume, image.Zone))
 	_ = d.Set("additional_volumes", flattenImageExtraVolumes(image.ExtraVolumes, image.Zone))
-	_ = d.Set("tags", image.Tags)
+	tags.Set(d, m, image.Tags)
 	_ = d.Set("public", image.Public)
 	_ = d.Set("creation_date", types.FlattenTime(image.CreationDate))
 	_ = d.Set("modification_date", types.FlattenTime(image.ModificationDate))
@@ -286,7 +291,7 @@ func ResourceInstanceImageRead(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	return setImageState(d, image.Image)
+	return setImageState(d, m, image.Image)
 }
 
 func ResourceInstanceImageUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -312,7 +317,7 @@ func ResourceInstanceImageUpdate(ctx context.Context, d *schema.ResourceData, m
 		req.Public = types.ExpandBoolPtr(d.Get("public"))
 	}
 
-	req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	req.Tags = tags.ExpandUpdated(d, m)
 
 	image, err := api.GetImage(&instanceSDK.GetImageRequest{
 		Zone:    zone,
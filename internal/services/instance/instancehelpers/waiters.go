@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/scaleway/scaleway-sdk-go/api/block/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
@@ -13,8 +14,8 @@ const DefaultInstanceRetryInterval = 5 * time.Second
 
 func WaitForVolume(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Volume, error) {
 	retryInterval := DefaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	volume, err := api.WaitForVolume(&instance.WaitForVolumeRequest{
@@ -26,3 +27,55 @@ func WaitForVolume(ctx context.Context, api *instance.API, zone scw.Zone, id str
 
 	return volume, err
 }
+
+func WaitForBlockVolume(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Volume, error) {
+	retryInterval := DefaultInstanceRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
+	}
+
+	volume, err := blockAPI.WaitForVolumeAndReferences(&block.WaitForVolumeAndReferencesRequest{
+		Zone:          zone,
+		VolumeID:      id,
+		Timeout:       new(timeout),
+		RetryInterval: &retryInterval,
+	}, scw.WithContext(ctx))
+
+	return volume, err
+}
+
+func WaitForBlockVolumeToBeAvailable(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Volume, error) {
+	retryInterval := DefaultInstanceRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
+	}
+
+	volume, err := blockAPI.WaitForVolumeAndReferences(&block.WaitForVolumeAndReferencesRequest{
+		Zone:          zone,
+		VolumeID:      id,
+		Timeout:       new(timeout),
+		RetryInterval: &retryInterval,
+
+		VolumeTerminalStatus: new(block.VolumeStatusAvailable),
+	}, scw.WithContext(ctx))
+
+	return volume, err
+}
+
+func WaitForBlockSnapshotToBeAvailable(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Snapshot, error) {
+	retryInterval := DefaultInstanceRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
+	}
+
+	snapshot, err := blockAPI.WaitForSnapshot(&block.WaitForSnapshotRequest{
+		Zone:          zone,
+		SnapshotID:    id,
+		Timeout:       new(timeout),
+		RetryInterval: &retryInterval,
+
+		TerminalStatus: new(block.SnapshotStatusAvailable),
+	}, scw.WithContext(ctx))
+
+	return snapshot, err
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -76,5 +77,8 @@ func DataSourceInstanceIPRead(ctx context.Context, d *schema.ResourceData, m any
 
 	d.SetId(zonal.NewIDString(res.IP.Zone, res.IP.ID))
 
-	return setIPState(d, res.IP)
+	diags := setIPState(d, m, res.IP)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
@@ -0,0 +1,228 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*IPListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*IPListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*IPListResource)(nil)
+)
+
+type IPListResource struct {
+	meta        *meta.Meta
+	instanceAPI *instanceSDK.API
+}
+
+func (r *IPListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.instanceAPI = instanceSDK.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewIPListResource() list.ListResource {
+	return &IPListResource{}
+}
+
+func (r *IPListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":            listscw.NameAttribute("Name of the IP to filter for"),
+			"tags":            listscw.TagsAttribute("Tags of the IP to filter for"),
+			"states":          listscw.StatesAttribute[instanceSDK.IPState]("States of the IP to filter for (e.g. attached, detached)"),
+			"organization_id": listscw.OrganizationIDAttribute("Organization ID to filter for"),
+			"project_ids":     listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"zones":           listscw.ZonesAttribute("Zones to filter for."),
+		},
+	}
+}
+
+func (r *IPListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	ipResource := ResourceIP()
+
+	resp.ProtoV6Schema = translate.Schema(ipResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(ipResource.ProtoIdentitySchema(ctx)())
+}
+
+type IPListResourceModel struct {
+	Tags           types.List   `tfsdk:"tags"`
+	States         types.List   `tfsdk:"states"`
+	Zones          types.List   `tfsdk:"zones"`
+	ProjectIDs     types.List   `tfsdk:"project_ids"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (m *IPListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *IPListResourceModel) GetStates() types.List   { return m.States }
+func (m *IPListResourceModel) GetZones() types.List    { return m.Zones }
+func (m *IPListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *IPListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_ip"
+}
+
+func (r *IPListResource) FetchIPs(ctx context.Context, zone scw.Zone, project *string, tags, states []string, data IPListResourceModel) ([]*instanceSDK.IP, error) {
+	response, err := r.instanceAPI.ListIPs(&instanceSDK.ListIPsRequest{
+		Zone:         zone,
+		Name:         data.Name.ValueStringPointer(),
+		Tags:         tags,
+		Organization: data.OrganizationID.ValueStringPointer(),
+		Project:      project,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*instanceSDK.IP, 0, len(response.IPs))
+
+	for _, item := range response.IPs {
+		if listscw.MatchStates(states, item.State.String()) {
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+func (r *IPListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data IPListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	tags, diags := listscw.ExtractTags(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	states, diags := listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	zones, err := listscw.ExtractZones(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing zones", "An error was encountered when listing zones: "+err.Error()),
+		})
+
+		return
+	}
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allIPs, err := listscw.FetchConcurrently(ctx, listscw.ZonalProjectTargets(zones, projects),
+		func(ctx context.Context, target listscw.ZonalFetchTarget) ([]*instanceSDK.IP, error) {
+			return r.FetchIPs(ctx, target.Zone, &target.ProjectID, tags, states, data)
+		},
+		func(a, b *instanceSDK.IP) int {
+			return listscw.CompareZonalProjectItems(a.Project, b.Project, a.Zone, b.Zone, a.ID, b.ID)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing IPs", "Failed to list IPs: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range allIPs {
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.Address.String()
+
+			ipResource := ResourceIP()
+			resourceData := ipResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetZonalIdentity(resourceData, item.Zone, item.ID)
+			if err != nil {
+				result.Diagnostics.AddError("Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			sdkDiags := setIPState(resourceData, r.meta, item)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting IP state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
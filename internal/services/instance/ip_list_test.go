@@ -0,0 +1,51 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	instancetestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+)
+
+func TestAccListInstanceIPs_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListInstanceIPs_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             instancetestfuncs.IsIPDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_ip" "main" {
+					  tags = ["tf-acc-instance-ip-list"]
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_instance_ip" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    zones       = ["fr-par-1"]
+					    project_ids = [scaleway_instance_ip.main.project_id]
+					    states      = ["detached"]
+					    tags        = ["tf-acc-instance-ip-list"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_instance_ip.by_tag", 1),
+				},
+			},
+		},
+	})
+}
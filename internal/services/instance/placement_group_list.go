@@ -0,0 +1,221 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	instance "github.com/scaleway/scaleway-sdk-go/api/instance/v2alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*PlacementGroupListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*PlacementGroupListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*PlacementGroupListResource)(nil)
+)
+
+type PlacementGroupListResource struct {
+	meta        *meta.Meta
+	instanceAPI *instanceSDK.API
+}
+
+func (r *PlacementGroupListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.instanceAPI = instanceSDK.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewPlacementGroupListResource() list.ListResource {
+	return &PlacementGroupListResource{}
+}
+
+func (r *PlacementGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":            listscw.NameAttribute("Name of the placement group to filter for"),
+			"tags":            listscw.TagsAttribute("Tags of the placement group to filter for"),
+			"organization_id": listscw.OrganizationIDAttribute("Organization ID to filter for"),
+			"project_ids":     listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"zones":           listscw.ZonesAttribute("Zones to filter for."),
+		},
+	}
+}
+
+func (r *PlacementGroupListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	placementGroupResource := ResourcePlacementGroup()
+
+	resp.ProtoV6Schema = translate.Schema(placementGroupResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(placementGroupResource.ProtoIdentitySchema(ctx)())
+}
+
+type PlacementGroupListResourceModel struct {
+	Tags           types.List   `tfsdk:"tags"`
+	Zones          types.List   `tfsdk:"zones"`
+	ProjectIDs     types.List   `tfsdk:"project_ids"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (m *PlacementGroupListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *PlacementGroupListResourceModel) GetZones() types.List    { return m.Zones }
+func (m *PlacementGroupListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *PlacementGroupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_placement_group"
+}
+
+func (r *PlacementGroupListResource) FetchPlacementGroups(ctx context.Context, zone scw.Zone, project *string, tags []string, data PlacementGroupListResourceModel) ([]*instanceSDK.PlacementGroup, error) {
+	response, err := r.instanceAPI.ListPlacementGroups(&instanceSDK.ListPlacementGroupsRequest{
+		Zone:         zone,
+		Name:         data.Name.ValueStringPointer(),
+		Tags:         tags,
+		Organization: data.OrganizationID.ValueStringPointer(),
+		Project:      project,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	return response.PlacementGroups, nil
+}
+
+func (r *PlacementGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data PlacementGroupListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	tags, diags := listscw.ExtractTags(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	zones, err := listscw.ExtractZones(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing zones", "An error was encountered when listing zones: "+err.Error()),
+		})
+
+		return
+	}
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allPlacementGroups, err := listscw.FetchConcurrently(ctx, listscw.ZonalProjectTargets(zones, projects),
+		func(ctx context.Context, target listscw.ZonalFetchTarget) ([]*instanceSDK.PlacementGroup, error) {
+			return r.FetchPlacementGroups(ctx, target.Zone, &target.ProjectID, tags, data)
+		},
+		func(a, b *instanceSDK.PlacementGroup) int {
+			return listscw.CompareZonalProjectItems(a.Project, b.Project, a.Zone, b.Zone, a.ID, b.ID)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing placement groups", "Failed to list placement groups: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range allPlacementGroups {
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.Name
+
+			placementGroupResource := ResourcePlacementGroup()
+			resourceData := placementGroupResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetZonalIdentity(resourceData, item.Zone, item.ID)
+			if err != nil {
+				result.Diagnostics.AddError("Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			// The resource reads the placement group from both API versions, the v1 listing contains the fields of both.
+			placementGroup := &instance.PlacementGroup{
+				ID:         item.ID,
+				Name:       item.Name,
+				ProjectID:  item.Project,
+				Tags:       item.Tags,
+				PolicyType: instance.PlacementGroupPolicyType(item.PolicyType.String()),
+				Zone:       item.Zone,
+			}
+
+			sdkDiags := setPlacementGroupState(resourceData, r.meta, placementGroup, item)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting placement group state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListInstancePlacementGroups_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListInstancePlacementGroups_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             isPlacementGroupDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_placement_group" "main" {
+					  name = "tf-acc-placement-group-list"
+					  tags = ["tf-acc-instance-placement-group-list"]
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_instance_placement_group" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    zones       = ["fr-par-1"]
+					    project_ids = [scaleway_instance_placement_group.main.project_id]
+					    tags        = ["tf-acc-instance-placement-group-list"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_instance_placement_group.by_tag", 1),
+				},
+			},
+		},
+	})
+}
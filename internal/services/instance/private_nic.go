@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	instanceV1 "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	instance "github.com/scaleway/scaleway-sdk-go/api/instance/v2alpha1"
@@ -19,7 +20,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 )
 
 func privateNICIdentity() *schema.ResourceIdentity {
@@ -46,9 +47,12 @@ func ResourcePrivateNIC() *schema.Resource {
 			Delete:  schema.DefaultTimeout(defaultInstancePrivateNICWaitTimeout),
 			Default: schema.DefaultTimeout(defaultInstancePrivateNICWaitTimeout),
 		},
-		SchemaFunc:    privateNicSchema,
-		CustomizeDiff: cdf.LocalityCheck("server_id", "private_network_id"),
-		Identity:      privateNICIdentity(),
+		SchemaFunc: privateNicSchema,
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("server_id", "private_network_id"),
+			tags.CustomizeDiff,
+		),
+		Identity: privateNICIdentity(),
 	}
 }
 
@@ -80,6 +84,7 @@ func privateNicSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the private-nic",
 		},
+		"tags_all": tags.AllSchema(),
 		"ip_ids": {
 			Type: schema.TypeList,
 			Elem: &schema.Schema{
@@ -138,7 +143,7 @@ func ResourceInstancePrivateNICCreate(ctx context.Context, d *schema.ResourceDat
 		Zone:             zone,
 		ServerID:         new(zonal.ExpandID(d.Get("server_id").(string)).ID),
 		PrivateNetworkID: regional.ExpandID(d.Get("private_network_id").(string)).ID,
-		Tags:             types.ExpandStrings(d.Get("tags")),
+		Tags:             tags.Expand(d, m),
 		IPIDs:            locality.ExpandIDs(d.Get("ipam_ip_ids")),
 	}
 
@@ -183,9 +188,7 @@ func setPrivateNICState(ctx context.Context, instanceAPI *instanceV1.API, d *sch
 	_ = d.Set("private_network_id", regional.NewIDString(region, privateNIC.PrivateNetworkID))
 	_ = d.Set("mac_address", privateNIC.MacAddress)
 
-	if len(privateNIC.Tags) > 0 {
-		_ = d.Set("tags", privateNIC.Tags)
-	}
+	tags.Set(d, m, privateNIC.Tags)
 
 	// Get private NIC's private IPs if possible
 	diags := diag.Diagnostics{}
@@ -287,8 +290,8 @@ func ResourceInstancePrivateNICUpdate(ctx context.Context, d *schema.ResourceDat
 		PrivateNetworkInterfaceID: privateNICID,
 	}
 
-	if d.HasChange("tags") {
-		updateReq.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateReq.Tags = tags.ExpandUpdated(d, m)
 		needsUpdate = true
 	}
 
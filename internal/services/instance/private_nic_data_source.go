@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -111,7 +112,10 @@ func DataSourceInstancePrivateNICRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	return setPrivateNICState(ctx, instanceAPIV1, d, pNIC, zone, m)
+	diags := setPrivateNICState(ctx, instanceAPIV1, d, pNIC, zone, m)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
 
 func privateNICWithFilters(privateNICs []*instance.PrivateNetworkInterfaceSummary, d *schema.ResourceData) (*instance.PrivateNetworkInterfaceSummary, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,10 +16,18 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
+// securityGroupsWithInlineRules records the security groups read by a scaleway_instance_security_group
+// with external_rules = false, whose rules would be replaced on its next update.
+// The API does not know about external_rules, so it can only be found in the state of this resource. Only the
+// security groups read by this provider process are recorded: detecting the conflict with a rule is best-effort, e.g.
+// it is missed when the security group is managed by another configuration or workspace.
+var securityGroupsWithInlineRules sync.Map
+
 func ResourceSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: ResourceInstanceSecurityGroupCreate,
@@ -31,8 +40,9 @@ func ResourceSecurityGroup() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultInstanceSecurityGroupTimeout),
 		},
-		SchemaFunc: securityGroupSchema,
-		Identity:   identity.DefaultZonal(),
+		SchemaFunc:    securityGroupSchema,
+		CustomizeDiff: tags.CustomizeDiff,
+		Identity:      identity.DefaultZonal(),
 	}
 }
 
@@ -104,6 +114,7 @@ func securityGroupSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the security group",
 		},
+		"tags_all":        tags.AllSchema(),
 		"zone":            zonal.Schema(),
 		"organization_id": account.OrganizationIDSchema(),
 		"project_id":      account.ProjectIDSchema(),
@@ -126,10 +137,8 @@ func ResourceInstanceSecurityGroupCreate(ctx context.Context, d *schema.Resource
 		OutboundDefaultPolicy: instanceSDK.SecurityGroupPolicy(d.Get("outbound_default_policy").(string)),
 		EnableDefaultSecurity: types.ExpandBoolPtr(d.Get("enable_default_security")),
 	}
-	tags := types.ExpandStrings(d.Get("tags"))
-
-	if len(tags) > 0 {
-		req.Tags = tags
+	if sgTags := tags.Expand(d, m); len(sgTags) > 0 {
+		req.Tags = sgTags
 	}
 
 	res, err := instanceAPI.CreateSecurityGroup(req, scw.WithContext(ctx))
@@ -143,13 +152,13 @@ func ResourceInstanceSecurityGroupCreate(ctx context.Context, d *schema.Resource
 	}
 
 	if d.Get("external_rules").(bool) {
-		return setSecurityGroupState(ctx, instanceAPI, d, res.SecurityGroup)
+		return setSecurityGroupState(ctx, instanceAPI, d, m, res.SecurityGroup)
 	}
 	// We call update instead of read as it will take care of creating rules.
 	return ResourceInstanceSecurityGroupUpdate(ctx, d, m)
 }
 
-func setSecurityGroupState(ctx context.Context, instanceAPI *instanceSDK.API, d *schema.ResourceData, sg *instanceSDK.SecurityGroup) diag.Diagnostics {
+func setSecurityGroupState(ctx context.Context, instanceAPI *instanceSDK.API, d *schema.ResourceData, m any, sg *instanceSDK.SecurityGroup) diag.Diagnostics {
 	_ = d.Set("zone", sg.Zone)
 	_ = d.Set("organization_id", sg.Organization)
 	_ = d.Set("project_id", sg.Project)
@@ -159,9 +168,13 @@ func setSecurityGroupState(ctx context.Context, instanceAPI *instanceSDK.API, d
 	_ = d.Set("inbound_default_policy", sg.InboundDefaultPolicy.String())
 	_ = d.Set("outbound_default_policy", sg.OutboundDefaultPolicy.String())
 	_ = d.Set("enable_default_security", sg.EnableDefaultSecurity)
-	_ = d.Set("tags", sg.Tags)
+	tags.Set(d, m, sg.Tags)
+
+	if d.Get("external_rules").(bool) {
+		securityGroupsWithInlineRules.Delete(sg.ID)
+	} else {
+		securityGroupsWithInlineRules.Store(sg.ID, struct{}{})
 
-	if !d.Get("external_rules").(bool) {
 		inboundRules, outboundRules, err := getSecurityGroupRules(ctx, instanceAPI, sg.Zone, sg.ID, d)
 		if err != nil {
 			return diag.FromErr(err)
@@ -199,7 +212,7 @@ func ResourceInstanceSecurityGroupRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	return setSecurityGroupState(ctx, instanceAPI, d, res.SecurityGroup)
+	return setSecurityGroupState(ctx, instanceAPI, d, m, res.SecurityGroup)
 }
 
 func getSecurityGroupRules(ctx context.Context, instanceAPI *instanceSDK.API, zone scw.Zone, securityGroupID string, d *schema.ResourceData) ([]any, []any, error) {
@@ -312,9 +325,8 @@ func ResourceInstanceSecurityGroupUpdate(ctx context.Context, d *schema.Resource
 		Tags:                  new([]string{}),
 	}
 
-	tags := types.ExpandStrings(d.Get("tags"))
-	if len(tags) > 0 {
-		updateReq.Tags = new(types.ExpandStrings(d.Get("tags")))
+	if sgTags := tags.Expand(d, m); len(sgTags) > 0 {
+		updateReq.Tags = &sgTags
 	}
 
 	if d.HasChange("enable_default_security") {
@@ -403,6 +415,8 @@ func ResourceInstanceSecurityGroupDelete(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	securityGroupsWithInlineRules.Delete(ID)
+
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -89,5 +90,8 @@ func DataSourceInstanceSecurityGroupRead(ctx context.Context, d *schema.Resource
 	d.SetId(zonedID)
 	_ = d.Set("security_group_id", zonedID)
 
-	return setSecurityGroupState(ctx, instanceAPI, d, securityGroup)
+	diags := setSecurityGroupState(ctx, instanceAPI, d, m, securityGroup)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
@@ -0,0 +1,50 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccListInstanceSecurityGroups_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListInstanceSecurityGroups_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             isSecurityGroupDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_security_group" "main" {
+					  name = "tf-acc-security-group-list"
+					  tags = ["tf-acc-instance-security-group-list"]
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_instance_security_group" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    zones       = ["fr-par-1"]
+					    project_ids = [scaleway_instance_security_group.main.project_id]
+					    tags        = ["tf-acc-instance-security-group-list"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_instance_security_group.by_tag", 1),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,370 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+// securityGroupRuleLocks serializes the changes made to the rules of a security group,
+// as the API computes the position of a rule from the other rules of the group.
+var securityGroupRuleLocks sync.Map
+
+func lockSecurityGroupRules(securityGroupID string) func() {
+	mu, _ := securityGroupRuleLocks.LoadOrStore(securityGroupID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+
+	return mu.(*sync.Mutex).Unlock
+}
+
+func securityGroupRuleIdentity() *schema.ResourceIdentity {
+	return identity.WrapSchemaMap(map[string]*schema.Schema{
+		"zone":                   identity.DefaultZoneAttribute(),
+		"security_group_id":      {Type: schema.TypeString, Description: "The ID of the security group", RequiredForImport: true},
+		"security_group_rule_id": {Type: schema.TypeString, Description: "The ID of the security group rule", RequiredForImport: true},
+	})
+}
+
+func ResourceSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceInstanceSecurityGroupRuleCreate,
+		ReadContext:   ResourceInstanceSecurityGroupRuleRead,
+		UpdateContext: ResourceInstanceSecurityGroupRuleUpdate,
+		DeleteContext: ResourceInstanceSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultInstanceSecurityGroupRuleTimeout),
+		},
+		SchemaFunc:    securityGroupRuleResourceSchema,
+		Identity:      securityGroupRuleIdentity(),
+		CustomizeDiff: securityGroupRuleCustomizeDiff,
+	}
+}
+
+// checkSecurityGroupExternalRules returns an error when the rules of the security group are managed by a
+// scaleway_instance_security_group with external_rules = false, which would remove the rule on its next update.
+// It is best-effort and not a guarantee: only the security groups read or created by this provider process are
+// known, so no error is returned for a security group managed by another configuration.
+func checkSecurityGroupExternalRules(securityGroupID string) error {
+	if _, inline := securityGroupsWithInlineRules.Load(securityGroupID); inline {
+		return fmt.Errorf("the rules of security group %s are managed by its scaleway_instance_security_group resource, "+
+			"set its external_rules argument to true to manage them with scaleway_instance_security_group_rule", securityGroupID)
+	}
+
+	return nil
+}
+
+// securityGroupRuleCustomizeDiff reports the rules planned in a security group with external_rules = false,
+// the security group being refreshed before the rules that depend on it.
+func securityGroupRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() != "" || !diff.NewValueKnown("security_group_id") {
+		return nil
+	}
+
+	return checkSecurityGroupExternalRules(zonal.ExpandID(diff.Get("security_group_id")).ID)
+}
+
+func securityGroupRuleResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"security_group_id": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The security group the rule belongs to",
+			ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+			DiffSuppressFunc: dsf.Locality,
+		},
+		"direction": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: verify.ValidateEnum[instanceSDK.SecurityGroupRuleDirection](),
+			Description:      "Direction of the traffic matched by the rule (inbound or outbound)",
+		},
+		"action": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: verify.ValidateEnum[instanceSDK.SecurityGroupRuleAction](),
+			Description:      "Action when rule match request (drop or accept)",
+		},
+		"protocol": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Default:          instanceSDK.SecurityGroupRuleProtocolTCP.String(),
+			ValidateDiagFunc: verify.ValidateEnum[instanceSDK.SecurityGroupRuleProtocol](),
+			Description:      "Protocol for this rule (TCP, UDP, ICMP or ANY)",
+		},
+		"port": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.IsPortNumber,
+			Description:   "Network port for this rule",
+			ConflictsWith: []string{"port_range"},
+		},
+		"port_range": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Description:   "Port range for this rule (e.g: 1-1024, 22-22)",
+			ConflictsWith: []string{"port"},
+		},
+		"ip_range": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "0.0.0.0/0",
+			ValidateFunc: validation.IsCIDRNetwork(0, 128),
+			Description:  "Ip range for this rule (e.g: 192.168.1.0/24)",
+		},
+		"position": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Position of the rule in the security group, the rules with the lowest positions are evaluated first. The rule is appended to the group if not set",
+		},
+		"zone": zonal.ComputedSchema(),
+	}
+}
+
+// securityGroupRuleFromResourceData builds the API rule described by the resource data
+func securityGroupRuleFromResourceData(d *schema.ResourceData) (*instanceSDK.SecurityGroupRule, error) {
+	rule, err := securityGroupRuleExpand(map[string]any{
+		"action":     d.Get("action"),
+		"protocol":   d.Get("protocol"),
+		"port":       d.Get("port"),
+		"port_range": d.Get("port_range"),
+		"ip_range":   d.Get("ip_range"),
+		"ip":         "",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Direction = instanceSDK.SecurityGroupRuleDirection(d.Get("direction").(string))
+
+	return rule, nil
+}
+
+func ResourceInstanceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	instanceAPI := instanceSDK.NewAPI(meta.ExtractScwClient(m))
+
+	zone, err := meta.ExtractZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	securityGroupID := zonal.ExpandID(d.Get("security_group_id"))
+	if securityGroupID.Zone != "" {
+		zone = securityGroupID.Zone
+	}
+
+	// The security group may have been created in the same run, after the rule was planned
+	err = checkSecurityGroupExternalRules(securityGroupID.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, err := securityGroupRuleFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unlock := lockSecurityGroupRules(securityGroupID.ID)
+	defer unlock()
+
+	// Another resource may already manage the same rule, in which case the rule must be imported instead
+	existingRules, err := instanceAPI.ListSecurityGroupRules(&instanceSDK.ListSecurityGroupRulesRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID.ID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, existingRule := range existingRules.Rules {
+		if !existingRule.Editable || existingRule.Direction != rule.Direction {
+			continue
+		}
+
+		if equal, _ := SecurityGroupRuleEquals(rule, existingRule); equal {
+			return diag.Errorf("security group %s already has an identical %s rule at position %d (%s), import it with the ID %q instead of creating it again",
+				securityGroupID.ID, rule.Direction, existingRule.Position, existingRule.ID, zonal.NewNestedIDString(zone, securityGroupID.ID, existingRule.ID))
+		}
+	}
+
+	req := &instanceSDK.CreateSecurityGroupRuleRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID.ID,
+		Protocol:        rule.Protocol,
+		Direction:       rule.Direction,
+		Action:          rule.Action,
+		IPRange:         rule.IPRange,
+		DestPortFrom:    rule.DestPortFrom,
+		DestPortTo:      rule.DestPortTo,
+		Editable:        true,
+	}
+
+	if position, ok := d.GetOk("position"); ok {
+		req.Position = uint32(position.(int))
+	}
+
+	res, err := instanceAPI.CreateSecurityGroupRule(req, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = identity.SetMultiPartIdentity(d, map[string]string{
+		"zone":                   zone.String(),
+		"security_group_id":      securityGroupID.ID,
+		"security_group_rule_id": res.Rule.ID,
+	}, "zone", "security_group_id", "security_group_rule_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ResourceInstanceSecurityGroupRuleRead(ctx, d, m)
+}
+
+func ResourceInstanceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	instanceAPI, zone, ruleID, securityGroupID, err := NewAPIWithZoneAndNestedID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.GetSecurityGroupRule(&instanceSDK.GetSecurityGroupRuleRequest{
+		Zone:                zone,
+		SecurityGroupID:     securityGroupID,
+		SecurityGroupRuleID: ruleID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Security group rule %s not found, removing it from the state", ruleID),
+				Detail: "The rules of a security group are replaced by the scaleway_instance_security_group resource unless its external_rules argument is true, " +
+					"and by the scaleway_instance_security_group_rules resource.",
+			}}
+		}
+
+		return diag.FromErr(err)
+	}
+
+	rule := res.Rule
+
+	err = identity.SetMultiPartIdentity(d, map[string]string{
+		"zone":                   zone.String(),
+		"security_group_id":      securityGroupID,
+		"security_group_rule_id": rule.ID,
+	}, "zone", "security_group_id", "security_group_rule_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("security_group_id", zonal.NewIDString(zone, securityGroupID))
+	_ = d.Set("direction", rule.Direction.String())
+	_ = d.Set("action", rule.Action.String())
+	_ = d.Set("protocol", rule.Protocol.String())
+	_ = d.Set("ip_range", rule.IPRange.String())
+	_ = d.Set("position", int(rule.Position))
+	_ = d.Set("zone", zone.String())
+
+	portFrom, portTo := uint32(0), uint32(0)
+	if rule.DestPortFrom != nil {
+		portFrom = *rule.DestPortFrom
+	}
+
+	if rule.DestPortTo != nil {
+		portTo = *rule.DestPortTo
+	}
+
+	switch {
+	case portTo != 0 && portTo != portFrom:
+		_ = d.Set("port_range", fmt.Sprintf("%d-%d", portFrom, portTo))
+		_ = d.Set("port", 0)
+	case portFrom != 0 && d.Get("port_range").(string) != "":
+		// Keep the single port range the rule was configured with
+		_ = d.Set("port_range", fmt.Sprintf("%d-%d", portFrom, portFrom))
+		_ = d.Set("port", 0)
+	case portFrom != 0:
+		_ = d.Set("port", int(portFrom))
+		_ = d.Set("port_range", "")
+	default:
+		_ = d.Set("port", 0)
+		_ = d.Set("port_range", "")
+	}
+
+	return nil
+}
+
+func ResourceInstanceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	instanceAPI, zone, ruleID, securityGroupID, err := NewAPIWithZoneAndNestedID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &instanceSDK.UpdateSecurityGroupRuleRequest{
+		Zone:                zone,
+		SecurityGroupID:     securityGroupID,
+		SecurityGroupRuleID: ruleID,
+	}
+
+	if d.HasChange("action") {
+		req.Action = instanceSDK.SecurityGroupRuleAction(d.Get("action").(string))
+	}
+
+	if d.HasChange("position") {
+		if position, ok := d.GetOk("position"); ok {
+			req.Position = new(uint32(position.(int)))
+		}
+	}
+
+	unlock := lockSecurityGroupRules(securityGroupID)
+	defer unlock()
+
+	_, err = instanceAPI.UpdateSecurityGroupRule(req, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ResourceInstanceSecurityGroupRuleRead(ctx, d, m)
+}
+
+func ResourceInstanceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	instanceAPI, zone, ruleID, securityGroupID, err := NewAPIWithZoneAndNestedID(m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unlock := lockSecurityGroupRules(securityGroupID)
+	defer unlock()
+
+	err = instanceAPI.DeleteSecurityGroupRule(&instanceSDK.DeleteSecurityGroupRuleRequest{
+		Zone:                zone,
+		SecurityGroupID:     securityGroupID,
+		SecurityGroupRuleID: ruleID,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
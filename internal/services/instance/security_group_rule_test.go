@@ -0,0 +1,159 @@
+package instance_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccSecurityGroupRule_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             isSecurityGroupDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_instance_security_group sg01 {
+						external_rules = true
+					}
+
+					resource scaleway_instance_security_group_rule http {
+						security_group_id = scaleway_instance_security_group.sg01.id
+						direction         = "inbound"
+						action            = "accept"
+						port              = 80
+					}
+
+					resource scaleway_instance_security_group_rule ssh {
+						security_group_id = scaleway_instance_security_group.sg01.id
+						direction         = "inbound"
+						action            = "accept"
+						port_range        = "22-23"
+						ip_range          = "10.0.0.0/8"
+
+						depends_on = [scaleway_instance_security_group_rule.http]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					isSecurityGroupPresent(tt, "scaleway_instance_security_group.sg01"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_security_group_rule.http", "security_group_id", "scaleway_instance_security_group.sg01", "id"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.http", "protocol", "TCP"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.http", "port", "80"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.http", "ip_range", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.http", "position", "1"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.ssh", "port_range", "22-23"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.ssh", "ip_range", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.ssh", "position", "2"),
+				),
+			},
+			{
+				// The ssh rule is moved before the http one, and the http rule now drops the traffic
+				Config: `
+					resource scaleway_instance_security_group sg01 {
+						external_rules = true
+					}
+
+					resource scaleway_instance_security_group_rule http {
+						security_group_id = scaleway_instance_security_group.sg01.id
+						direction         = "inbound"
+						action            = "drop"
+						port              = 80
+						position          = 2
+					}
+
+					resource scaleway_instance_security_group_rule ssh {
+						security_group_id = scaleway_instance_security_group.sg01.id
+						direction         = "inbound"
+						action            = "accept"
+						port_range        = "22-23"
+						ip_range          = "10.0.0.0/8"
+						position          = 1
+
+						depends_on = [scaleway_instance_security_group_rule.http]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.http", "action", "drop"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.http", "position", "2"),
+					resource.TestCheckResourceAttr("scaleway_instance_security_group_rule.ssh", "position", "1"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_instance_security_group_rule.ssh",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSecurityGroupRule_Conflict(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             isSecurityGroupDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_instance_security_group sg01 {
+						external_rules = true
+					}
+
+					resource scaleway_instance_security_group_rule first {
+						security_group_id = scaleway_instance_security_group.sg01.id
+						direction         = "outbound"
+						action            = "drop"
+						port              = 25
+					}
+
+					resource scaleway_instance_security_group_rule duplicate {
+						security_group_id = scaleway_instance_security_group.sg01.id
+						direction         = "outbound"
+						action            = "drop"
+						port              = 25
+
+						depends_on = [scaleway_instance_security_group_rule.first]
+					}
+				`,
+				ExpectError: regexp.MustCompile("already has an identical outbound rule"),
+			},
+		},
+	})
+}
+
+func TestAccSecurityGroupRule_InlineRules(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             isSecurityGroupDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_instance_security_group sg01 {
+						inbound_rule {
+							action = "accept"
+							port   = 22
+						}
+					}
+
+					resource scaleway_instance_security_group_rule http {
+						security_group_id = scaleway_instance_security_group.sg01.id
+						direction         = "inbound"
+						action            = "accept"
+						port              = 80
+					}
+				`,
+				ExpectError: regexp.MustCompile("set its external_rules argument to true"),
+			},
+		},
+	})
+}
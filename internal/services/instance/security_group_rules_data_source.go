@@ -0,0 +1,159 @@
+package instance
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func DataSourceSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceInstanceSecurityGroupRulesRead,
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The ID of the security group",
+				ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+			},
+			"direction": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only list the rules of this direction (inbound or outbound)",
+				ValidateDiagFunc: verify.ValidateEnum[instanceSDK.SecurityGroupRuleDirection](),
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules of the security group, in the order they are evaluated",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule with its zone and security group, to import it in a scaleway_instance_security_group_rule",
+						},
+						"rule_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule in the security group",
+						},
+						"direction": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Direction of the traffic matched by the rule",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Action when rule match request",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Protocol of the rule",
+						},
+						"dest_port_from": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "First port of the rule, 0 when the rule matches all ports",
+						},
+						"dest_port_to": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Last port of the rule, 0 when the rule matches a single port",
+						},
+						"ip_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Ip range of the rule",
+						},
+						"position": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Position of the rule in the security group",
+						},
+						"editable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the rule can be edited, the default rules of the security group cannot",
+						},
+					},
+				},
+			},
+			"zone": zonal.Schema(),
+		},
+	}
+}
+
+func DataSourceInstanceSecurityGroupRulesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	instanceAPI, zone, err := newAPIWithZone(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zonedID := datasource.NewZonedID(d.Get("security_group_id"), zone)
+
+	zone, securityGroupID, err := zonal.ParseID(zonedID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.ListSecurityGroupRules(&instanceSDK.ListSecurityGroupRulesRequest{
+		Zone:            zone,
+		SecurityGroupID: locality.ExpandID(securityGroupID),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(res.Rules, func(i, j int) bool {
+		return res.Rules[i].Position < res.Rules[j].Position
+	})
+
+	direction := instanceSDK.SecurityGroupRuleDirection(d.Get("direction").(string))
+	rules := make([]map[string]any, 0, len(res.Rules))
+
+	for _, rule := range res.Rules {
+		if direction != "" && rule.Direction != direction {
+			continue
+		}
+
+		portFrom, portTo := 0, 0
+		if rule.DestPortFrom != nil {
+			portFrom = int(*rule.DestPortFrom)
+		}
+
+		if rule.DestPortTo != nil {
+			portTo = int(*rule.DestPortTo)
+		}
+
+		rules = append(rules, map[string]any{
+			"id":             zonal.NewNestedIDString(zone, locality.ExpandID(securityGroupID), rule.ID),
+			"rule_id":        rule.ID,
+			"direction":      rule.Direction.String(),
+			"action":         rule.Action.String(),
+			"protocol":       rule.Protocol.String(),
+			"dest_port_from": portFrom,
+			"dest_port_to":   portTo,
+			"ip_range":       rule.IPRange.String(),
+			"position":       int(rule.Position),
+			"editable":       rule.Editable,
+		})
+	}
+
+	d.SetId(zonedID)
+	_ = d.Set("security_group_id", zonedID)
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("rules", rules)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+)
+
+func TestAccDataSourceSecurityGroupRules_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	config := `
+		resource scaleway_instance_security_group sg01 {
+			external_rules = true
+		}
+
+		resource scaleway_instance_security_group_rule http {
+			security_group_id = scaleway_instance_security_group.sg01.id
+			direction         = "inbound"
+			action            = "accept"
+			port              = 80
+		}
+
+		resource scaleway_instance_security_group_rule smtp {
+			security_group_id = scaleway_instance_security_group.sg01.id
+			direction         = "outbound"
+			action            = "drop"
+			port_range        = "25-26"
+
+			depends_on = [scaleway_instance_security_group_rule.http]
+		}
+	`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             isSecurityGroupDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config: config + `
+					data scaleway_instance_security_group_rules all {
+						security_group_id = scaleway_instance_security_group.sg01.id
+					}
+
+					data scaleway_instance_security_group_rules inbound {
+						security_group_id = scaleway_instance_security_group.sg01.id
+						direction         = "inbound"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_security_group_rules.all", "id", "scaleway_instance_security_group.sg01", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.scaleway_instance_security_group_rules.all", "rules.*", map[string]string{
+						"direction":      "inbound",
+						"action":         "accept",
+						"protocol":       "TCP",
+						"dest_port_from": "80",
+						"editable":       "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.scaleway_instance_security_group_rules.all", "rules.*", map[string]string{
+						"direction":      "outbound",
+						"action":         "drop",
+						"dest_port_from": "25",
+						"dest_port_to":   "26",
+					}),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_group_rules.inbound", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_group_rules.inbound", "rules.0.direction", "inbound"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_group_rules.inbound", "rules.0.dest_port_from", "80"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_security_group_rules.inbound", "rules.0.id", "scaleway_instance_security_group_rule.http", "id"),
+				),
+			},
+		},
+	})
+}
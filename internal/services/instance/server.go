@@ -37,6 +37,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/instancehelpers"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -69,7 +70,9 @@ func ResourceServer() *schema.Resource {
 			),
 			customDiffInstanceServerType,
 			customDiffInstanceServerImage,
+			customDiffInstanceRootVolumeType,
 			customDiffInstanceRootVolumeSize,
+			tags.CustomizeDiff,
 		),
 	}
 }
@@ -115,6 +118,7 @@ func serverSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the server",
 		},
+		"tags_all": tags.AllSchema(),
 		"security_group_id": {
 			Type:             schema.TypeString,
 			Optional:         true,
@@ -133,6 +137,16 @@ func serverSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "True when the placement group policy is respected",
 		},
+		"requires_stop": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True in a plan when applying it stops the server, e.g. to migrate its root volume to SBS",
+		},
+		"stop_action": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Set in a plan when applying it stops the server: the soft stop of the shutdown policy, or poweroff",
+		},
 		"root_volume": {
 			Type:        schema.TypeList,
 			MaxItems:    1,
@@ -157,8 +171,7 @@ func serverSchema() map[string]*schema.Schema {
 						Type:        schema.TypeString,
 						Optional:    true,
 						Computed:    true,
-						ForceNew:    true,
-						Description: "Volume type of the root volume",
+						Description: "Volume type of the root volume. Changing it re-creates the server, unless migrating from l_ssd to sbs_volume with migrate_to_sbs",
 						ValidateDiagFunc: func(i any, path cty.Path) diag.Diagnostics {
 							diags := verify.ValidateEnum[instanceSDK.VolumeVolumeType]()(i, path)
 							if i.(string) == "b_ssd" {
@@ -198,6 +211,12 @@ func serverSchema() map[string]*schema.Schema {
 						Optional:    true,
 						Description: "SBS Volume IOPS, only with volume_type as sbs_volume",
 					},
+					"migrate_to_sbs": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "If true, changing volume_type from l_ssd to sbs_volume migrates the root volume to block storage instead of re-creating the server. The server is stopped during the migration",
+					},
 				},
 			},
 		},
@@ -260,12 +279,50 @@ func serverSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Computed:    true,
 			Description: "The state of the server should be: started, stopped, standby",
+			// A server stopped in place by the soft stop of its shutdown policy is considered stopped
+			DiffSuppressFunc: func(_, oldValue, newValue string, d *schema.ResourceData) bool {
+				if oldValue != InstanceServerStateStandby || newValue != InstanceServerStateStopped {
+					return false
+				}
+
+				shutdown, err := expandShutdownPolicy(d.Get("shutdown_policy"))
+
+				return err == nil && shutdown.softStops()
+			},
 			ValidateFunc: validation.StringInSlice([]string{
 				InstanceServerStateStarted,
 				InstanceServerStateStopped,
 				InstanceServerStateStandby,
 			}, false),
 		},
+		"shutdown_policy": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "How the server is powered off when it has to be stopped, a soft stop is tried before falling back to a hard power off",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"graceful_timeout": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          "5m",
+						DiffSuppressFunc: dsf.Duration,
+						ValidateDiagFunc: verify.IsDuration(),
+						Description:      "Time given to the soft stop before the server is powered off",
+					},
+					"pre_stop_action": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  instanceSDK.ServerActionStopInPlace.String(),
+						ValidateFunc: validation.StringInSlice([]string{
+							instanceSDK.ServerActionStopInPlace.String(),
+							InstanceServerPreStopActionNone,
+						}, false),
+						Description: "Soft stop tried before powering off the server, stop_in_place or none to power it off right away",
+					},
+				},
+			},
+		},
 		"boot_type": {
 			Type:             schema.TypeString,
 			Optional:         true,
@@ -436,7 +493,7 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 		CommercialType:    commercialType,
 		SecurityGroup:     types.ExpandStringPtr(zonal.ExpandID(d.Get("security_group_id")).ID),
 		DynamicIPRequired: new(d.Get("enable_dynamic_ip").(bool)),
-		Tags:              types.ExpandStrings(d.Get("tags")),
+		Tags:              tags.Expand(d, m),
 		Protected:         d.Get("protected").(bool),
 	}
 
@@ -582,7 +639,12 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	err = reachState(ctx, api, zone, res.Server.ID, targetState)
+	shutdown, err := expandShutdownPolicy(d.Get("shutdown_policy"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = reachStateWithShutdownPolicy(ctx, api, zone, res.Server.ID, targetState, shutdown)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -654,7 +716,6 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 	return append(diags, setServerState(ctx, d, m, api, res.Server.Zone, res.Server.ID)...)
 }
 
-//gocyclo:ignore
 func setServerState(ctx context.Context, d *schema.ResourceData, m any, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, id string) diag.Diagnostics {
 	server, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutRead))
 	if err != nil {
@@ -668,6 +729,16 @@ func setServerState(ctx context.Context, d *schema.ResourceData, m any, api *ins
 		return diag.FromErr(err)
 	}
 
+	return setServerStateFromServer(ctx, d, m, api, zone, server)
+}
+
+// setServerStateFromServer sets the state of an already fetched server in a stable state,
+// only fetching the server's user data, private NICs and private IPs.
+//
+//gocyclo:ignore
+func setServerStateFromServer(ctx context.Context, d *schema.ResourceData, m any, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, server *instanceSDK.Server) diag.Diagnostics {
+	id := server.ID
+
 	state, err := serverStateFlatten(server.State)
 	if err != nil {
 		return diag.FromErr(err)
@@ -679,9 +750,7 @@ func setServerState(ctx context.Context, d *schema.ResourceData, m any, api *ins
 	_ = d.Set("boot_type", server.BootType)
 
 	_ = d.Set("type", server.CommercialType)
-	if len(server.Tags) > 0 {
-		_ = d.Set("tags", server.Tags)
-	}
+	tags.Set(d, m, server.Tags)
 
 	if server.Filesystems != nil {
 		_ = d.Set("filesystems", flattenServerFileSystem(server.Zone, server.Filesystems))
@@ -692,6 +761,8 @@ func setServerState(ctx context.Context, d *schema.ResourceData, m any, api *ins
 	_ = d.Set("organization_id", server.Organization)
 	_ = d.Set("project_id", server.Project)
 	_ = d.Set("protected", server.Protected)
+	_ = d.Set("requires_stop", false)
+	_ = d.Set("stop_action", "")
 
 	// Image could be empty in an import context.
 	image := regional.ExpandID(d.Get("image").(string))
@@ -768,6 +839,7 @@ func setServerState(ctx context.Context, d *schema.ResourceData, m any, api *ins
 
 			_, rootVolumeAttributeSet := d.GetOk("root_volume") // Related to https://github.com/hashicorp/terraform-plugin-sdk/issues/142
 			rootVolume["delete_on_termination"] = d.Get("root_volume.0.delete_on_termination").(bool) || !rootVolumeAttributeSet
+			rootVolume["migrate_to_sbs"] = d.Get("root_volume.0.migrate_to_sbs").(bool)
 		} else {
 			additionalVolumesIDs = append(additionalVolumesIDs, zonal.NewID(zone, serverVolume.ID).String())
 		}
@@ -951,9 +1023,9 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 		updateRequest.Name = types.ExpandStringPtr(d.Get("name"))
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		serverShouldUpdate = true
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("security_group_id") {
@@ -974,8 +1046,22 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 		updateRequest.Protected = types.ExpandBoolPtr(d.Get("protected").(bool))
 	}
 
+	rootVolumeID := zonal.ExpandID(d.Get("root_volume.0.volume_id")).ID
+
+	// customDiffInstanceRootVolumeType only allows an in-place change of volume_type for the migration to SBS
+	if d.HasChange("root_volume.0.volume_type") {
+		migratedVolumeID, diags := instanceServerMigrateRootVolumeToSBS(ctx, d, api, zone, server, d.Timeout(schema.TimeoutUpdate))
+		warnings = append(warnings, diags...)
+
+		if diags.HasError() {
+			return warnings
+		}
+
+		rootVolumeID = migratedVolumeID
+	}
+
 	if d.HasChanges("additional_volume_ids", "root_volume") {
-		volumes, err := instanceServerVolumesUpdate(ctx, d, api, zone, isStopped)
+		volumes, err := instanceServerVolumesUpdate(ctx, d, api, zone, rootVolumeID, isStopped, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1162,8 +1248,14 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		shutdown, err := expandShutdownPolicy(d.Get("shutdown_policy"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		// reach expected state
-		err = reachState(ctx, api, zone, id, targetState)
+		err = reachStateWithShutdownPolicy(ctx, api, zone, id, targetState, shutdown)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1293,12 +1385,25 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	err = terminateServer(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
+	shutdown, err := expandShutdownPolicy(d.Get("shutdown_policy"))
 	if err != nil {
-		err = deleteServer(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
+		return diag.FromErr(err)
+	}
+
+	// The terminate action powers the server off right away, a server with a shutdown policy is stopped according to it before being deleted
+	if shutdown != nil {
+		err = deleteServer(ctx, api, zone, id, shutdown, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+	} else {
+		err = terminateServer(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			err = deleteServer(ctx, api, zone, id, nil, d.Timeout(schema.TimeoutDelete))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	// Related to https://github.com/hashicorp/terraform-plugin-sdk/issues/142
@@ -1322,8 +1427,8 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 }
 
 func terminateServer(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, id string, timeout time.Duration) error {
-	// reach running state (mandatory for termination)
-	err := reachState(ctx, api, zone, id, instanceSDK.ServerStateRunning)
+	// reach running state (mandatory for termination), the server is only powered on so no shutdown policy is needed
+	err := reachStateWithShutdownPolicy(ctx, api, zone, id, instanceSDK.ServerStateRunning, nil)
 	if err != nil && !httperrors.Is404(err) {
 		return err
 	}
@@ -1341,14 +1446,14 @@ func terminateServer(ctx context.Context, api *instancehelpers.BlockAndInstanceA
 	return nil
 }
 
-func deleteServer(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, id string, timeout time.Duration) error {
+func deleteServer(ctx context.Context, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, id string, shutdown *shutdownPolicy, timeout time.Duration) error {
 	_, err := waitForServer(ctx, api.API, zone, id, timeout)
 	if err != nil && !httperrors.Is404(err) {
 		return err
 	}
 
 	// reach stopped state
-	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped)
+	err = reachStateWithShutdownPolicy(ctx, api, zone, id, instanceSDK.ServerStateStopped, shutdown)
 	if err != nil && !httperrors.Is404(err) {
 		return err
 	}
@@ -1416,15 +1521,66 @@ func customDiffInstanceRootVolumeSize(_ context.Context, diff *schema.ResourceDi
 		return fmt.Errorf("failed to check server root volume type: %w", err)
 	}
 
-	if rootVolume, hasRootVolume := resp.Server.Volumes["0"]; hasRootVolume {
-		if rootVolume.VolumeType == instanceSDK.VolumeServerVolumeTypeLSSD {
-			return diff.ForceNew("root_volume.0.size_in_gb")
-		}
+	rootVolume, hasRootVolume := resp.Server.Volumes["0"]
+	if !hasRootVolume {
+		return nil
+	}
+
+	// Local volumes cannot be resized, unless they are migrated to SBS along with the resize
+	if rootVolume.VolumeType == instanceSDK.VolumeServerVolumeTypeLSSD && !rootVolumeMigratesToSBS(diff) {
+		return diff.ForceNew("root_volume.0.size_in_gb")
+	}
+
+	// Block volumes can only grow
+	oldSize, newSize := diff.GetChange("root_volume.0.size_in_gb")
+	if newSize.(int) < oldSize.(int) {
+		return diff.ForceNew("root_volume.0.size_in_gb")
 	}
 
 	return nil
 }
 
+// rootVolumeMigratesToSBS returns true if the diff requests the migration of a local root volume to SBS
+func rootVolumeMigratesToSBS(diff *schema.ResourceDiff) bool {
+	oldType, newType := diff.GetChange("root_volume.0.volume_type")
+
+	return oldType.(string) == instanceSDK.VolumeVolumeTypeLSSD.String() &&
+		newType.(string) == instanceSDK.VolumeVolumeTypeSbsVolume.String() &&
+		diff.Get("root_volume.0.migrate_to_sbs").(bool)
+}
+
+func customDiffInstanceRootVolumeType(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if !diff.HasChange("root_volume.0.volume_type") || diff.Id() == "" {
+		return nil
+	}
+
+	if rootVolumeMigratesToSBS(diff) {
+		return setServerRequiresStop(diff)
+	}
+
+	return diff.ForceNew("root_volume.0.volume_type")
+}
+
+// setServerRequiresStop shows in the plan that applying it stops the server and how, unless the server is already stopped
+func setServerRequiresStop(diff *schema.ResourceDiff) error {
+	shutdown, err := expandShutdownPolicy(diff.Get("shutdown_policy"))
+	if err != nil {
+		return err
+	}
+
+	oldState, _ := diff.GetChange("state")
+	if oldState.(string) == InstanceServerStateStopped || (oldState.(string) == InstanceServerStateStandby && shutdown.softStops()) {
+		return nil
+	}
+
+	err = diff.SetNew("stop_action", shutdown.stopAction().String())
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNew("requires_stop", true)
+}
+
 func customDiffInstanceServerType(_ context.Context, diff *schema.ResourceDiff, meta any) error {
 	if !diff.HasChange("type") || diff.Id() == "" {
 		return nil
@@ -1455,7 +1611,8 @@ func customDiffInstanceServerType(_ context.Context, diff *schema.ResourceDiff,
 		return fmt.Errorf("cannot change server type: %w", err)
 	}
 
-	return nil
+	// The server is stopped to change its type
+	return setServerRequiresStop(diff)
 }
 
 func customDiffInstanceServerImage(ctx context.Context, diff *schema.ResourceDiff, m any) error {
@@ -1521,7 +1678,12 @@ func ResourceInstanceServerMigrate(ctx context.Context, d *schema.ResourceData,
 
 	beginningState := server.State
 
-	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped)
+	shutdown, err := expandShutdownPolicy(d.Get("shutdown_policy"))
+	if err != nil {
+		return err
+	}
+
+	err = reachStateWithShutdownPolicy(ctx, api, zone, id, instanceSDK.ServerStateStopped, shutdown)
 	if err != nil {
 		return fmt.Errorf("failed to stop server before changing server type: %w", err)
 	}
@@ -1535,7 +1697,7 @@ func ResourceInstanceServerMigrate(ctx context.Context, d *schema.ResourceData,
 		return errors.New("failed to change server type server")
 	}
 
-	err = reachState(ctx, api, zone, id, beginningState)
+	err = reachStateWithShutdownPolicy(ctx, api, zone, id, beginningState, shutdown)
 	if err != nil {
 		return fmt.Errorf("failed to start server after changing server type: %w", err)
 	}
@@ -1642,25 +1804,254 @@ func ResourceInstanceServerUpdateRootVolumeIOPS(ctx context.Context, api *instan
 	return nil
 }
 
+// instanceServerMigrateRootVolumeToSBS replaces the local root volume of a server with a block volume holding the same data.
+// The server is stopped during the migration and brought back to its previous state afterward. It returns the ID of the new root volume.
+// When the migration fails, the snapshot and the block volume it created are deleted and the server keeps its local root volume.
+func instanceServerMigrateRootVolumeToSBS(ctx context.Context, d *schema.ResourceData, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, server *instanceSDK.Server, timeout time.Duration) (string, diag.Diagnostics) {
+	localVolume, exists := server.Volumes["0"]
+	if !exists {
+		return "", diag.Errorf("failed to find the root volume of server %s", server.ID)
+	}
+
+	initialState := server.State
+
+	shutdown, err := expandShutdownPolicy(d.Get("shutdown_policy"))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+
+	var (
+		snapshotID       string
+		snapshotMigrated bool
+		blockVolumeID    string
+	)
+
+	// rollback deletes what the migration created before the block volume was attached and restores the state of the server
+	rollback := func(diags diag.Diagnostics) (string, diag.Diagnostics) {
+		if blockVolumeID != "" {
+			err := api.BlockAPI.DeleteVolume(&block.DeleteVolumeRequest{
+				Zone:     zone,
+				VolumeID: blockVolumeID,
+			}, scw.WithContext(ctx))
+			if err != nil && !httperrors.Is404(err) {
+				diags = append(diags, migrationWarning("Failed to delete the block volume created to migrate the root volume to SBS",
+					fmt.Sprintf("Volume %s should be deleted manually: %s", blockVolumeID, err)))
+			}
+		}
+
+		if snapshotID != "" {
+			var err error
+			if snapshotMigrated {
+				err = api.BlockAPI.DeleteSnapshot(&block.DeleteSnapshotRequest{
+					Zone:       zone,
+					SnapshotID: snapshotID,
+				}, scw.WithContext(ctx))
+			} else {
+				err = api.DeleteSnapshot(&instanceSDK.DeleteSnapshotRequest{
+					Zone:       zone,
+					SnapshotID: snapshotID,
+				}, scw.WithContext(ctx))
+			}
+
+			if err != nil && !httperrors.Is404(err) {
+				diags = append(diags, migrationWarning("Failed to delete the snapshot created to migrate the root volume to SBS",
+					fmt.Sprintf("Snapshot %s should be deleted manually: %s", snapshotID, err)))
+			}
+		}
+
+		err := reachStateWithShutdownPolicy(ctx, api, zone, server.ID, initialState, shutdown)
+		if err != nil {
+			diags = append(diags, migrationWarning("Failed to restore the state of the server after the failed migration of its root volume",
+				fmt.Sprintf("Server %s should be %s: %s", server.ID, initialState, err)))
+		}
+
+		return "", diags
+	}
+
+	// Local volumes can only be snapshotted consistently when the server is stopped
+	err = reachStateWithShutdownPolicy(ctx, api, zone, server.ID, instanceSDK.ServerStateStopped, shutdown)
+	if err != nil {
+		return rollback(diag.Errorf("failed to stop server before migrating its root volume: %s", err))
+	}
+
+	snapshotResp, err := api.CreateSnapshot(&instanceSDK.CreateSnapshotRequest{
+		Zone:     zone,
+		Name:     types.NewRandomName("snp"),
+		VolumeID: &localVolume.ID,
+		Project:  &server.Project,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return rollback(diag.Errorf("failed to snapshot root volume %s: %s", localVolume.ID, err))
+	}
+
+	snapshotID = snapshotResp.Snapshot.ID
+
+	_, err = waitForSnapshot(ctx, api.API, zone, snapshotID, timeout)
+	if err != nil {
+		return rollback(diag.FromErr(err))
+	}
+
+	plan, err := api.PlanBlockMigration(&instanceSDK.PlanBlockMigrationRequest{
+		Zone:       zone,
+		SnapshotID: &snapshotID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return rollback(diag.Errorf("failed to plan the migration of snapshot %s to SBS: %s", snapshotID, err))
+	}
+
+	err = api.ApplyBlockMigration(&instanceSDK.ApplyBlockMigrationRequest{
+		Zone:          zone,
+		SnapshotID:    &snapshotID,
+		ValidationKey: plan.ValidationKey,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return rollback(diag.Errorf("failed to migrate snapshot %s to SBS: %s", snapshotID, err))
+	}
+
+	snapshotMigrated = true
+
+	_, err = instancehelpers.WaitForBlockSnapshotToBeAvailable(ctx, api.BlockAPI, zone, snapshotID, timeout)
+	if err != nil {
+		return rollback(diag.FromErr(err))
+	}
+
+	volumeName := types.NewRandomName("vol")
+	if localVolume.Name != nil && *localVolume.Name != "" {
+		volumeName = *localVolume.Name
+	}
+
+	createVolumeRequest := &block.CreateVolumeRequest{
+		Zone:      zone,
+		Name:      volumeName,
+		ProjectID: server.Project,
+		Tags:      server.Tags,
+		FromSnapshot: &block.CreateVolumeRequestFromSnapshot{
+			SnapshotID: snapshotID,
+		},
+	}
+
+	if iops, ok := d.GetOk("root_volume.0.sbs_iops"); ok {
+		createVolumeRequest.PerfIops = types.ExpandUint32Ptr(iops)
+	}
+
+	blockVolume, err := api.BlockAPI.CreateVolume(createVolumeRequest, scw.WithContext(ctx))
+	if err != nil {
+		return rollback(diag.Errorf("failed to create block volume from snapshot %s: %s", snapshotID, err))
+	}
+
+	blockVolumeID = blockVolume.ID
+
+	_, err = instancehelpers.WaitForBlockVolumeToBeAvailable(ctx, api.BlockAPI, zone, blockVolumeID, timeout)
+	if err != nil {
+		return rollback(diag.FromErr(err))
+	}
+
+	// Swap the root volume, the other volumes of the server are kept as is
+	volumes := make(map[string]*instanceSDK.VolumeServerTemplate, len(server.Volumes))
+	volumes["0"] = (&instancehelpers.UnknownVolume{
+		ID:                 blockVolumeID,
+		Boot:               types.ExpandBoolPtr(d.Get("root_volume.0.boot")),
+		InstanceVolumeType: instanceSDK.VolumeVolumeTypeSbsVolume,
+	}).VolumeTemplate()
+
+	for key, serverVolume := range server.Volumes {
+		if key == "0" {
+			continue
+		}
+
+		volume, err := api.GetUnknownVolume(&instancehelpers.GetUnknownVolumeRequest{
+			VolumeID: serverVolume.ID,
+			Zone:     zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return rollback(diag.Errorf("failed to get volume %s: %s", serverVolume.ID, err))
+		}
+
+		volumes[key] = volume.VolumeTemplate()
+	}
+
+	_, err = api.UpdateServer(&instanceSDK.UpdateServerRequest{
+		Zone:     zone,
+		ServerID: server.ID,
+		Volumes:  &volumes,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return rollback(diag.Errorf("failed to attach block volume %s as root volume: %s", blockVolumeID, err))
+	}
+
+	// The block volume is now the root volume of the server, it must be kept even if the migration fails later on
+	blockVolumeID = ""
+
+	_, err = waitForServer(ctx, api.API, zone, server.ID, timeout)
+	if err != nil {
+		return rollback(diag.FromErr(err))
+	}
+
+	warnings := diag.Diagnostics{migrationWarning("The server was stopped to migrate its root volume to SBS",
+		"migrate_to_sbs can be removed now that the root volume of the server is a block volume.")}
+
+	err = api.DeleteVolume(&instanceSDK.DeleteVolumeRequest{
+		Zone:     zone,
+		VolumeID: localVolume.ID,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		warnings = append(warnings, migrationWarning("Failed to delete the local root volume after its migration to SBS",
+			fmt.Sprintf("Volume %s is detached and should be deleted manually: %s", localVolume.ID, err)))
+	}
+
+	err = api.BlockAPI.DeleteSnapshot(&block.DeleteSnapshotRequest{
+		Zone:       zone,
+		SnapshotID: snapshotID,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		warnings = append(warnings, migrationWarning("Failed to delete the snapshot used to migrate the root volume to SBS",
+			fmt.Sprintf("Snapshot %s should be deleted manually: %s", snapshotID, err)))
+	}
+
+	err = reachStateWithShutdownPolicy(ctx, api, zone, server.ID, initialState, shutdown)
+	if err != nil {
+		return "", append(warnings, diag.Errorf("failed to restart server after migrating its root volume: %s", err)...)
+	}
+
+	return blockVolume.ID, warnings
+}
+
+func migrationWarning(summary string, detail string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: cty.GetAttrPath("root_volume").IndexInt(0).GetAttr("volume_type"),
+	}
+}
+
 // instanceServerVolumesUpdate updates root_volume size and returns the list of volumes templates that should be updated for the server.
 // It uses root_volume and additional_volume_ids to build the volumes templates.
-func instanceServerVolumesUpdate(ctx context.Context, d *schema.ResourceData, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, serverIsStopped bool) (map[string]*instanceSDK.VolumeServerTemplate, error) {
+func instanceServerVolumesUpdate(ctx context.Context, d *schema.ResourceData, api *instancehelpers.BlockAndInstanceAPI, zone scw.Zone, rootVolumeID string, serverIsStopped bool, timeout time.Duration) (map[string]*instanceSDK.VolumeServerTemplate, error) {
 	volumes := map[string]*instanceSDK.VolumeServerTemplate{}
 	raw, hasAdditionalVolumes := d.GetOk("additional_volume_ids")
 
 	if d.HasChange("root_volume.0.size_in_gb") {
 		err := api.ResizeUnknownVolume(&instancehelpers.ResizeUnknownVolumeRequest{
-			VolumeID: zonal.ExpandID(d.Get("root_volume.0.volume_id")).ID,
+			VolumeID: rootVolumeID,
 			Zone:     zone,
 			Size:     new(scw.Size(d.Get("root_volume.0.size_in_gb").(int)) * scw.GB),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return nil, err
 		}
+
+		// Block volumes are resized in place, wait for the new size to be available
+		if d.Get("root_volume.0.volume_type").(string) == instanceSDK.VolumeVolumeTypeSbsVolume.String() {
+			_, err = instancehelpers.WaitForBlockVolume(ctx, api.BlockAPI, zone, rootVolumeID, timeout)
+			if err != nil {
+				return nil, fmt.Errorf("failed to wait for root volume resize: %w", err)
+			}
+		}
 	}
 
 	volumes["0"] = &instanceSDK.VolumeServerTemplate{
-		ID:   new(zonal.ExpandID(d.Get("root_volume.0.volume_id")).ID),
+		ID:   &rootVolumeID,
 		Name: new(types.NewRandomName("vol")), // name is ignored by the API, any name will work here
 		Boot: types.ExpandBoolPtr(d.Get("root_volume.0.boot")),
 	}
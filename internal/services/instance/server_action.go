@@ -4,10 +4,12 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
@@ -25,6 +27,7 @@ var (
 
 type ServerAction struct {
 	instanceAPI *instance.API
+	meta        *meta.Meta
 }
 
 func (a *ServerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
@@ -42,6 +45,7 @@ func (a *ServerAction) Configure(ctx context.Context, req action.ConfigureReques
 		return
 	}
 
+	a.meta = m
 	client := m.ScwClient()
 	a.instanceAPI = instance.NewAPI(client)
 }
@@ -51,10 +55,16 @@ func (a *ServerAction) Metadata(ctx context.Context, req action.MetadataRequest,
 }
 
 type ServerActionModel struct {
-	ServerID types.String `tfsdk:"server_id"`
-	Zone     types.String `tfsdk:"zone"`
-	Action   types.String `tfsdk:"action"`
-	Wait     types.Bool   `tfsdk:"wait"`
+	ServerID       types.String                     `tfsdk:"server_id"`
+	Zone           types.String                     `tfsdk:"zone"`
+	Action         types.String                     `tfsdk:"action"`
+	Wait           types.Bool                       `tfsdk:"wait"`
+	ShutdownPolicy *ServerActionShutdownPolicyModel `tfsdk:"shutdown_policy"`
+}
+
+type ServerActionShutdownPolicyModel struct {
+	GracefulTimeout types.String `tfsdk:"graceful_timeout"`
+	PreStopAction   types.String `tfsdk:"pre_stop_action"`
 }
 
 func NewServerAction() action.Action {
@@ -96,6 +106,24 @@ func (a *ServerAction) Schema(ctx context.Context, req action.SchemaRequest, res
 				Description: "Wait for server to finish action",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"shutdown_policy": schema.SingleNestedBlock{
+				Description: "How the server is powered off with the poweroff action, a soft stop is tried before falling back to a hard power off. The action then waits for the server to be stopped, or stopped in place by the soft stop",
+				Attributes: map[string]schema.Attribute{
+					"graceful_timeout": schema.StringAttribute{
+						Optional:    true,
+						Description: "Time given to the soft stop before the server is powered off. Defaults to 5m",
+					},
+					"pre_stop_action": schema.StringAttribute{
+						Optional:    true,
+						Description: "Soft stop tried before powering off the server, stop_in_place or none to power it off right away. Defaults to stop_in_place",
+						Validators: []validator.String{
+							stringvalidator.OneOf(instance.ServerActionStopInPlace.String(), InstanceServerPreStopActionNone),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -134,6 +162,27 @@ func (a *ServerAction) Invoke(ctx context.Context, req action.InvokeRequest, res
 		}
 	}
 
+	if strings.EqualFold(data.Action.ValueString(), instance.ServerActionPoweroff.String()) && data.ShutdownPolicy != nil {
+		policy, err := newShutdownPolicy(data.ShutdownPolicy.PreStopAction.ValueString(), data.ShutdownPolicy.GracefulTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("shutdown_policy").AtName("graceful_timeout"),
+				"invalid shutdown policy",
+				err.Error())
+
+			return
+		}
+
+		err = stopServerGracefully(ctx, a.instanceAPI, scw.Zone(zone), serverID, policy)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"error in server action",
+				err.Error())
+		}
+
+		return
+	}
+
 	actionReq := &instance.ServerActionRequest{
 		ServerID: serverID,
 		Zone:     scw.Zone(zone),
@@ -153,6 +202,7 @@ func (a *ServerAction) Invoke(ctx context.Context, req action.InvokeRequest, res
 		server, err := a.instanceAPI.WaitForServer(&instance.WaitForServerRequest{
 			ServerID: serverID,
 			Zone:     scw.Zone(zone),
+			Timeout:  a.meta.DefaultTimeouts().Update,
 		}, scw.WithContext(ctx))
 		if err != nil && data.Action.ValueString() != instance.ServerActionTerminate.String() {
 			resp.Diagnostics.AddError(
@@ -165,6 +215,7 @@ func (a *ServerAction) Invoke(ctx context.Context, req action.InvokeRequest, res
 				_, err := a.instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
 					VolumeID: volume.ID,
 					Zone:     scw.Zone(zone),
+					Timeout:  a.meta.DefaultTimeouts().Update,
 				}, scw.WithContext(ctx))
 				if err != nil {
 					resp.Diagnostics.AddError(
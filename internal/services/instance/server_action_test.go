@@ -269,6 +269,57 @@ func TestAccActionServer_On_Off(t *testing.T) {
 	})
 }
 
+func TestAccActionServer_ShutdownPolicy(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccActionServer_ShutdownPolicy because action are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_server" "main" {
+						name = "test-terraform-action-server-shutdown-policy"
+						type = "DEV1-S"
+						image = "ubuntu_jammy"
+
+					  	lifecycle {
+							action_trigger {
+						  		events  = [after_create]
+						  		actions = [action.scaleway_instance_server_action.poweroff]
+							}
+					  	}
+					}
+
+					action "scaleway_instance_server_action" "poweroff" {
+						config {
+						  	action = "%s"
+							server_id = scaleway_instance_server.main.id
+
+							shutdown_policy {
+								graceful_timeout = "2m"
+								pre_stop_action  = "%s"
+							}
+						}
+					}`, instanceSDK.ServerActionPoweroff, instanceSDK.ServerActionStopInPlace),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					// the server stopped in place by the soft stop is not powered off
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "state", instance.InstanceServerStateStandby),
+					readActualServerState(tt, "scaleway_instance_server.main", instanceSDK.ServerStateStoppedInPlace.String()),
+				),
+			},
+		},
+	})
+}
+
 func TestAccActionServer_Backup(t *testing.T) {
 	if acctest.IsRunningOpenTofu() {
 		t.Skip("Skipping TestAccActionServer_Backup because action are not yet supported on OpenTofu")
@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/instancehelpers"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -17,6 +18,9 @@ import (
 func DataSourceServer() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasource.SchemaFromResourceSchema(ResourceServer().SchemaFunc())
+	// requires_stop and stop_action are only set in plans
+	delete(dsSchema, "requires_stop")
+	delete(dsSchema, "stop_action")
 
 	// Set 'Optional' schema elements
 	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "zone", "project_id")
@@ -79,5 +83,8 @@ func DataSourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(zonedID)
 	_ = d.Set("server_id", zonedID)
 
-	return setServerState(ctx, d, m, api, zone, uuid)
+	diags := setServerState(ctx, d, m, api, zone, uuid)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
@@ -0,0 +1,238 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/instancehelpers"
+)
+
+var (
+	_ list.ListResource                 = (*ServerListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*ServerListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*ServerListResource)(nil)
+)
+
+type ServerListResource struct {
+	meta *meta.Meta
+	api  *instancehelpers.BlockAndInstanceAPI
+}
+
+func (r *ServerListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.api = instancehelpers.NewBlockAndInstanceAPI(meta.ExtractScwClient(m))
+}
+
+func NewServerListResource() list.ListResource {
+	return &ServerListResource{}
+}
+
+func (r *ServerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":            listscw.NameAttribute("Name of the server to filter for"),
+			"tags":            listscw.TagsAttribute("Tags of the server to filter for"),
+			"states":          listscw.StatesAttribute[instanceSDK.ServerState]("States of the server to filter for (e.g. running, stopped)"),
+			"organization_id": listscw.OrganizationIDAttribute("Organization ID to filter for"),
+			"project_ids":     listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"zones":           listscw.ZonesAttribute("Zones to filter for."),
+		},
+	}
+}
+
+func (r *ServerListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	serverResource := ResourceServer()
+
+	resp.ProtoV6Schema = translate.Schema(serverResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(serverResource.ProtoIdentitySchema(ctx)())
+}
+
+type ServerListResourceModel struct {
+	Tags           types.List   `tfsdk:"tags"`
+	States         types.List   `tfsdk:"states"`
+	Zones          types.List   `tfsdk:"zones"`
+	ProjectIDs     types.List   `tfsdk:"project_ids"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (m *ServerListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *ServerListResourceModel) GetStates() types.List   { return m.States }
+func (m *ServerListResourceModel) GetZones() types.List    { return m.Zones }
+func (m *ServerListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *ServerListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_server"
+}
+
+func (r *ServerListResource) FetchServers(ctx context.Context, zone scw.Zone, project *string, tags, states []string, data ServerListResourceModel) ([]*instanceSDK.Server, error) {
+	response, err := r.api.ListServers(&instanceSDK.ListServersRequest{
+		Zone:         zone,
+		Name:         data.Name.ValueStringPointer(),
+		Tags:         tags,
+		Organization: data.OrganizationID.ValueStringPointer(),
+		Project:      project,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make([]*instanceSDK.Server, 0, len(response.Servers))
+
+	for _, server := range response.Servers {
+		if listscw.MatchStates(states, server.State.String()) {
+			servers = append(servers, server)
+		}
+	}
+
+	return servers, nil
+}
+
+func (r *ServerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ServerListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	tags, diags := listscw.ExtractTags(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	states, diags := listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	zones, err := listscw.ExtractZones(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing zones", "An error was encountered when listing zones: "+err.Error()),
+		})
+
+		return
+	}
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allServers, err := listscw.FetchConcurrently(ctx, listscw.ZonalProjectTargets(zones, projects),
+		func(ctx context.Context, target listscw.ZonalFetchTarget) ([]*instanceSDK.Server, error) {
+			return r.FetchServers(ctx, target.Zone, &target.ProjectID, tags, states, data)
+		},
+		func(a, b *instanceSDK.Server) int {
+			return listscw.CompareZonalProjectItems(a.Project, b.Project, a.Zone, b.Zone, a.ID, b.ID)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing servers", "Failed to list servers: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, server := range allServers {
+			result := req.NewListResult(ctx)
+			result.DisplayName = server.Name
+
+			serverResource := ResourceServer()
+			resourceData := serverResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetZonalIdentity(resourceData, server.Zone, server.ID)
+			if err != nil {
+				result.Diagnostics.AddError("Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			var sdkDiags sdkdiag.Diagnostics
+
+			// Listed servers are used as is, only servers with an ongoing action are fetched again once it is done.
+			if _, err := serverStateFlatten(server.State); err == nil {
+				sdkDiags = setServerStateFromServer(ctx, resourceData, r.meta, r.api, server.Zone, server)
+			} else {
+				sdkDiags = setServerState(ctx, resourceData, r.meta, r.api, server.Zone, server.ID)
+			}
+
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting server state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
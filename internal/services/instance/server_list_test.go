@@ -0,0 +1,54 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	instancetestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+)
+
+func TestAccListInstanceServers_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListInstanceServers_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             instancetestfuncs.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+					  name  = "tf-acc-server-list"
+					  type  = "DEV1-S"
+					  image = "ubuntu_jammy"
+					  tags  = ["tf-acc-instance-server-list"]
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_instance_server" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    zones       = ["fr-par-1"]
+					    project_ids = [scaleway_instance_server.main.project_id]
+					    states      = ["running"]
+					    tags        = ["tf-acc-instance-server-list"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_instance_server.by_tag", 1),
+				},
+			},
+		},
+	})
+}
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	iamchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam/testfuncs"
 	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
@@ -267,6 +270,114 @@ func TestAccServer_State_Start(t *testing.T) {
 	})
 }
 
+func TestAccServer_State_ShutdownPolicy(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	serverID := ""
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				// started
+				Config: `
+					resource "scaleway_instance_server" "base" {
+					  name  = "tf-acc-server-state-shutdown-policy"
+					  image = "ubuntu_jammy"
+					  type  = "DEV1-S"
+					  state = "started"
+					  tags  = [ "terraform-test", "scaleway_instance_server", "state_shutdown_policy" ]
+
+					  shutdown_policy {
+					    graceful_timeout = "2m"
+					  }
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.IsServerPresent(tt, "scaleway_instance_server.base"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "shutdown_policy.0.graceful_timeout", "2m"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "shutdown_policy.0.pre_stop_action", "stop_in_place"),
+					acctest.CheckResourceIDPersisted("scaleway_instance_server.base", &serverID),
+				),
+			},
+			{
+				// stopped with a soft stop first
+				Config: `
+					resource "scaleway_instance_server" "base" {
+					  name  = "tf-acc-server-state-shutdown-policy"
+					  image = "ubuntu_jammy"
+					  type  = "DEV1-S"
+					  state = "stopped"
+					  tags  = [ "terraform-test", "scaleway_instance_server", "state_shutdown_policy" ]
+
+					  shutdown_policy {
+					    graceful_timeout = "2m"
+					  }
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.IsServerPresent(tt, "scaleway_instance_server.base"),
+					// the server stopped in place by the soft stop is considered stopped and is not powered off
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "state", "standby"),
+					acctest.CheckResourceIDPersisted("scaleway_instance_server.base", &serverID),
+				),
+			},
+			{
+				// started
+				Config: `
+					resource "scaleway_instance_server" "base" {
+					  name  = "tf-acc-server-state-shutdown-policy"
+					  image = "ubuntu_jammy"
+					  type  = "DEV1-S"
+					  state = "started"
+					  tags  = [ "terraform-test", "scaleway_instance_server", "state_shutdown_policy" ]
+
+					  shutdown_policy {
+					    pre_stop_action = "none"
+					  }
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.IsServerPresent(tt, "scaleway_instance_server.base"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "shutdown_policy.0.graceful_timeout", "5m"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "shutdown_policy.0.pre_stop_action", "none"),
+					acctest.CheckResourceIDPersisted("scaleway_instance_server.base", &serverID),
+				),
+			},
+			{
+				// the type change stops the server according to the shutdown policy
+				Config: `
+					resource "scaleway_instance_server" "base" {
+					  name  = "tf-acc-server-state-shutdown-policy"
+					  image = "ubuntu_jammy"
+					  type  = "DEV1-M"
+					  state = "started"
+					  tags  = [ "terraform-test", "scaleway_instance_server", "state_shutdown_policy" ]
+
+					  shutdown_policy {
+					    graceful_timeout = "1m"
+					  }
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.IsServerPresent(tt, "scaleway_instance_server.base"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "type", "DEV1-M"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "requires_stop", "false"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "stop_action", ""),
+					acctest.CheckResourceIDPersisted("scaleway_instance_server.base", &serverID),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("scaleway_instance_server.base", tfjsonpath.New("requires_stop"), knownvalue.Bool(true)),
+						plancheck.ExpectKnownValue("scaleway_instance_server.base", tfjsonpath.New("stop_action"), knownvalue.StringExact("stop_in_place")),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccServer_WithPlacementGroup(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
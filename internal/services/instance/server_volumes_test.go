@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	blocktestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/block/testfuncs"
@@ -222,6 +225,94 @@ func TestAccServer_RootVolumeFromImage_Local(t *testing.T) {
 	})
 }
 
+func TestAccServer_RootVolume_MigrateToSBS(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	serverID := ""
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			instancechecks.IsServerDestroyed(tt),
+			blocktestfuncs.IsVolumeDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_server" "base" {
+						name = "tf-acc-server-root-volume-migrate-to-sbs"
+						image = "%s"
+						type  = "DEV1-S"
+						root_volume {
+							volume_type = "l_ssd"
+							size_in_gb = 20
+						}
+						tags = [ "terraform-test", "scaleway_instance_server", "root_volume_migrate_to_sbs" ]
+					}`, ubuntuFocalImageLabel),
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.IsServerPresent(tt, "scaleway_instance_server.base"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "root_volume.0.volume_type", "l_ssd"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "root_volume.0.migrate_to_sbs", "false"),
+					acctest.CheckResourceIDPersisted("scaleway_instance_server.base", &serverID),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_server" "base" {
+						name = "tf-acc-server-root-volume-migrate-to-sbs"
+						image = "%s"
+						type  = "DEV1-S"
+						root_volume {
+							volume_type = "sbs_volume"
+							size_in_gb = 30
+							sbs_iops = 5000
+							migrate_to_sbs = true
+						}
+						tags = [ "terraform-test", "scaleway_instance_server", "root_volume_migrate_to_sbs" ]
+					}`, ubuntuFocalImageLabel),
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.IsServerPresent(tt, "scaleway_instance_server.base"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "root_volume.0.volume_type", "sbs_volume"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "root_volume.0.size_in_gb", "30"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "root_volume.0.sbs_iops", "5000"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "root_volume.0.migrate_to_sbs", "true"),
+					acctest.CheckResourceIDPersisted("scaleway_instance_server.base", &serverID), // Server should have been kept as its root volume was migrated.
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "requires_stop", "false"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scaleway_instance_server.base", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("scaleway_instance_server.base", tfjsonpath.New("requires_stop"), knownvalue.Bool(true)),
+					},
+				},
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_server" "base" {
+						name = "tf-acc-server-root-volume-migrate-to-sbs"
+						image = "%s"
+						type  = "DEV1-S"
+						root_volume {
+							volume_type = "sbs_volume"
+							size_in_gb = 20
+							sbs_iops = 5000
+						}
+						tags = [ "terraform-test", "scaleway_instance_server", "root_volume_migrate_to_sbs" ]
+					}`, ubuntuFocalImageLabel),
+				Check: resource.ComposeTestCheckFunc(
+					instancechecks.IsServerPresent(tt, "scaleway_instance_server.base"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "root_volume.0.size_in_gb", "20"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "root_volume.0.migrate_to_sbs", "false"),
+					acctest.CheckResourceIDChanged("scaleway_instance_server.base", &serverID), // Server should have been re-created as block volumes cannot be shrunk.
+				),
+			},
+		},
+	})
+}
+
 func TestAccServer_RootVolumeFromID(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
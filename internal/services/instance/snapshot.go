@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -17,6 +18,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -39,7 +41,10 @@ func ResourceSnapshot() *schema.Resource {
 		SchemaVersion: 0,
 		SchemaFunc:    snapshotSchema,
 		Identity:      identity.DefaultZonal(),
-		CustomizeDiff: cdf.LocalityCheck("volume_id"),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("volume_id"),
+			tags.CustomizeDiff,
+		),
 	}
 }
 
@@ -80,6 +85,7 @@ func snapshotSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the snapshot",
 		},
+		"tags_all": tags.AllSchema(),
 		"import": {
 			Type:     schema.TypeList,
 			ForceNew: true,
@@ -141,7 +147,9 @@ func ResourceInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	req.Tags = types.ExpandStringsPtr(d.Get("tags"))
+	if snapshotTags := tags.Expand(d, m); len(snapshotTags) > 0 {
+		req.Tags = &snapshotTags
+	}
 
 	if volumeID, volumeIDExist := d.GetOk("volume_id"); volumeIDExist {
 		req.VolumeID = new(zonal.ExpandID(volumeID).ID)
@@ -169,26 +177,29 @@ func ResourceInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 	snapshot, err := instanceAPI.WaitForSnapshot(&instanceSDK.WaitForSnapshotRequest{
 		SnapshotID:    res.Snapshot.ID,
 		Zone:          zone,
-		RetryInterval: transport.DefaultWaitRetryInterval,
+		RetryInterval: transport.WaitRetryInterval(ctx),
 		Timeout:       new(d.Timeout(schema.TimeoutCreate)),
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return setSnapshotState(d, snapshot)
+	return setSnapshotState(d, m, snapshot)
 }
 
-func setSnapshotState(d *schema.ResourceData, snapshot *instanceSDK.Snapshot) diag.Diagnostics {
+func setSnapshotState(d *schema.ResourceData, m any, snapshot *instanceSDK.Snapshot) diag.Diagnostics {
 	diags := handleDeprecatedSnapshotVolumeType(d)
 	if diags.HasError() {
 		return diags
 	}
 
 	_ = d.Set("name", snapshot.Name)
+	_ = d.Set("zone", snapshot.Zone)
+	_ = d.Set("organization_id", snapshot.Organization)
+	_ = d.Set("project_id", snapshot.Project)
 	_ = d.Set("created_at", snapshot.CreationDate.Format(time.RFC3339))
 	_ = d.Set("type", snapshot.VolumeType.String())
-	_ = d.Set("tags", snapshot.Tags)
+	tags.Set(d, m, snapshot.Tags)
 
 	if snapshot.BaseVolume != nil {
 		_ = d.Set("volume_id", zonal.NewIDString(snapshot.Zone, snapshot.BaseVolume.ID))
@@ -222,7 +233,7 @@ func ResourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	return setSnapshotState(d, snapshot.Snapshot)
+	return setSnapshotState(d, m, snapshot.Snapshot)
 }
 
 func ResourceInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -238,9 +249,8 @@ func ResourceInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData,
 		Tags:       new([]string{}),
 	}
 
-	tags := types.ExpandStrings(d.Get("tags"))
-	if d.HasChange("tags") && len(tags) > 0 {
-		req.Tags = new(types.ExpandStrings(d.Get("tags")))
+	if snapshotTags := tags.Expand(d, m); tags.HasChange(d) && len(snapshotTags) > 0 {
+		req.Tags = &snapshotTags
 	}
 
 	_, err = instanceAPI.UpdateSnapshot(req, scw.WithContext(ctx))
@@ -24,6 +24,7 @@ var (
 
 type CreateSnapshot struct {
 	blockAndInstanceAPI *instancehelpers.BlockAndInstanceAPI
+	meta                *meta.Meta
 }
 
 func (c *CreateSnapshot) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
@@ -41,6 +42,7 @@ func (c *CreateSnapshot) Configure(_ context.Context, req action.ConfigureReques
 		return
 	}
 
+	c.meta = m
 	client := m.ScwClient()
 	c.blockAndInstanceAPI = instancehelpers.NewBlockAndInstanceAPI(client)
 }
@@ -177,6 +179,7 @@ func (c *CreateSnapshot) Invoke(ctx context.Context, req action.InvokeRequest, r
 			_, errWait := c.blockAndInstanceAPI.WaitForSnapshot(&instance.WaitForSnapshotRequest{
 				SnapshotID: snapshot.Snapshot.ID,
 				Zone:       scw.Zone(zone),
+				Timeout:    c.meta.DefaultTimeouts().Update,
 			}, scw.WithContext(ctx))
 			if errWait != nil {
 				resp.Diagnostics.AddError(
@@ -220,6 +223,7 @@ func (c *CreateSnapshot) Invoke(ctx context.Context, req action.InvokeRequest, r
 			_, errWait := api.WaitForSnapshot(&block.WaitForSnapshotRequest{
 				SnapshotID: snapshot.ID,
 				Zone:       scw.Zone(zone),
+				Timeout:    c.meta.DefaultTimeouts().Update,
 			}, scw.WithContext(ctx))
 			if errWait != nil {
 				resp.Diagnostics.AddError(
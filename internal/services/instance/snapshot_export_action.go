@@ -23,6 +23,7 @@ var (
 
 type ExportSnapshot struct {
 	blockAndInstanceAPI *instancehelpers.BlockAndInstanceAPI
+	meta                *meta.Meta
 }
 
 func (e *ExportSnapshot) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
@@ -40,6 +41,7 @@ func (e *ExportSnapshot) Configure(_ context.Context, req action.ConfigureReques
 		return
 	}
 
+	e.meta = m
 	client := m.ScwClient()
 	e.blockAndInstanceAPI = instancehelpers.NewBlockAndInstanceAPI(client)
 }
@@ -165,6 +167,7 @@ func (e *ExportSnapshot) Invoke(ctx context.Context, req action.InvokeRequest, r
 			_, err = e.blockAndInstanceAPI.WaitForSnapshot(&instance.WaitForSnapshotRequest{
 				SnapshotID: snapshotID,
 				Zone:       scw.Zone(zone),
+				Timeout:    e.meta.DefaultTimeouts().Update,
 			}, scw.WithContext(ctx))
 			if err != nil {
 				resp.Diagnostics.AddError(
@@ -198,6 +201,7 @@ func (e *ExportSnapshot) Invoke(ctx context.Context, req action.InvokeRequest, r
 			_, err = api.WaitForSnapshot(&block.WaitForSnapshotRequest{
 				SnapshotID: snapshotID,
 				Zone:       scw.Zone(zone),
+				Timeout:    e.meta.DefaultTimeouts().Update,
 			}, scw.WithContext(ctx))
 			if err != nil {
 				resp.Diagnostics.AddError(
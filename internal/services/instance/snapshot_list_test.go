@@ -0,0 +1,58 @@
+package instance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	instancetestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+)
+
+func TestAccListInstanceSnapshots_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListInstanceSnapshots_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             instancetestfuncs.IsSnapshotDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_volume" "main" {
+					  type       = "l_ssd"
+					  size_in_gb = 10
+					}
+
+					resource "scaleway_instance_snapshot" "main" {
+					  name      = "tf-acc-snapshot-list"
+					  volume_id = scaleway_instance_volume.main.id
+					  tags      = ["tf-acc-instance-snapshot-list"]
+					}
+				`,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_instance_snapshot" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    zones       = ["fr-par-1"]
+					    project_ids = [scaleway_instance_snapshot.main.project_id]
+					    states      = ["available"]
+					    tags        = ["tf-acc-instance-snapshot-list"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_instance_snapshot.by_tag", 1),
+				},
+			},
+		},
+	})
+}
@@ -9,32 +9,32 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_instance_image", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_image", &resource.Sweeper{
 		Name:         "scaleway_instance_image",
 		Dependencies: []string{"scaleway_instance_server"},
 		F:            testSweepImage,
 	})
-	resource.AddTestSweepers("scaleway_instance_ip", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_ip", &resource.Sweeper{
 		Name: "scaleway_instance_ip",
 		F:    testSweepIP,
 	})
-	resource.AddTestSweepers("scaleway_instance_placement_group", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_placement_group", &resource.Sweeper{
 		Name: "scaleway_instance_placement_group",
 		F:    testSweepPlacementGroup,
 	})
-	resource.AddTestSweepers("scaleway_instance_security_group", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_security_group", &resource.Sweeper{
 		Name: "scaleway_instance_security_group",
 		F:    testSweepSecurityGroup,
 	})
-	resource.AddTestSweepers("scaleway_instance_server", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_server", &resource.Sweeper{
 		Name: "scaleway_instance_server",
 		F:    testSweepServer,
 	})
-	resource.AddTestSweepers("scaleway_instance_snapshot", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_snapshot", &resource.Sweeper{
 		Name: "scaleway_instance_snapshot",
 		F:    testSweepSnapshot,
 	})
-	resource.AddTestSweepers("scaleway_instance_volume", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_volume", &resource.Sweeper{
 		Name: "scaleway_instance_volume",
 		F:    testSweepVolume,
 	})
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -17,6 +18,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/instancehelpers"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -37,9 +39,12 @@ func ResourceVolume() *schema.Resource {
 			Delete:  schema.DefaultTimeout(defaultInstanceVolumeDeleteTimeout),
 			Default: schema.DefaultTimeout(defaultInstanceVolumeDeleteTimeout),
 		},
-		SchemaFunc:    volumeSchema,
-		Identity:      identity.DefaultZonal(),
-		CustomizeDiff: cdf.LocalityCheck("from_snapshot_id"),
+		SchemaFunc: volumeSchema,
+		Identity:   identity.DefaultZonal(),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("from_snapshot_id"),
+			tags.CustomizeDiff,
+		),
 	}
 }
 
@@ -85,6 +90,7 @@ func volumeSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the volume",
 		},
+		"tags_all": tags.AllSchema(),
 		"migrate_to_sbs": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -109,10 +115,8 @@ func ResourceInstanceVolumeCreate(ctx context.Context, d *schema.ResourceData, m
 		VolumeType: instanceSDK.VolumeVolumeType(d.Get("type").(string)),
 		Project:    types.ExpandStringPtr(d.Get("project_id")),
 	}
-	tags := types.ExpandStrings(d.Get("tags"))
-
-	if len(tags) > 0 {
-		createVolumeRequest.Tags = tags
+	if volumeTags := tags.Expand(d, m); len(volumeTags) > 0 {
+		createVolumeRequest.Tags = volumeTags
 	}
 
 	if size, ok := d.GetOk("size_in_gb"); ok {
@@ -136,23 +140,23 @@ func ResourceInstanceVolumeCreate(ctx context.Context, d *schema.ResourceData, m
 	volume, err := instanceAPI.WaitForVolume(&instanceSDK.WaitForVolumeRequest{
 		VolumeID:      res.Volume.ID,
 		Zone:          zone,
-		RetryInterval: transport.DefaultWaitRetryInterval,
+		RetryInterval: transport.WaitRetryInterval(ctx),
 		Timeout:       new(d.Timeout(schema.TimeoutCreate)),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return setVolumeState(d, volume)
+	return setVolumeState(d, m, volume)
 }
 
-func setVolumeState(d *schema.ResourceData, volume *instanceSDK.Volume) diag.Diagnostics {
+func setVolumeState(d *schema.ResourceData, m any, volume *instanceSDK.Volume) diag.Diagnostics {
 	_ = d.Set("name", volume.Name)
 	_ = d.Set("organization_id", volume.Organization)
 	_ = d.Set("project_id", volume.Project)
 	_ = d.Set("zone", volume.Zone)
 	_ = d.Set("type", volume.VolumeType.String())
-	_ = d.Set("tags", volume.Tags)
+	tags.Set(d, m, volume.Tags)
 
 	_, fromSnapshot := d.GetOk("from_snapshot_id")
 	if !fromSnapshot {
@@ -208,7 +212,7 @@ func ResourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m a
 		return diag.FromErr(err)
 	}
 
-	return setVolumeState(d, res.Volume)
+	return setVolumeState(d, m, res.Volume)
 }
 
 func ResourceInstanceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -227,9 +231,8 @@ func ResourceInstanceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m
 		req.Name = new(d.Get("name").(string))
 	}
 
-	tags := types.ExpandStrings(d.Get("tags"))
-	if d.HasChange("tags") && len(tags) > 0 {
-		req.Tags = new(types.ExpandStrings(d.Get("tags")))
+	if volumeTags := tags.Expand(d, m); tags.HasChange(d) && len(volumeTags) > 0 {
+		req.Tags = &volumeTags
 	}
 
 	if d.HasChange("size_in_gb") {
@@ -278,7 +281,7 @@ func ResourceInstanceVolumeDelete(ctx context.Context, d *schema.ResourceData, m
 	volume, err := instanceAPI.WaitForVolume(&instanceSDK.WaitForVolumeRequest{
 		Zone:          zone,
 		VolumeID:      id,
-		RetryInterval: transport.DefaultWaitRetryInterval,
+		RetryInterval: transport.WaitRetryInterval(ctx),
 		Timeout:       new(d.Timeout(schema.TimeoutDelete)),
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -13,8 +13,8 @@ import (
 
 func waitForSnapshot(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Snapshot, error) {
 	retryInterval := instancehelpers.DefaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	snapshot, err := api.WaitForSnapshot(&instance.WaitForSnapshotRequest{
@@ -29,8 +29,8 @@ func waitForSnapshot(ctx context.Context, api *instance.API, zone scw.Zone, id s
 
 func waitForServer(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Server, error) {
 	retryInterval := instancehelpers.DefaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	server, err := api.WaitForServer(&instance.WaitForServerRequest{
@@ -45,8 +45,8 @@ func waitForServer(ctx context.Context, api *instance.API, zone scw.Zone, id str
 
 func waitForPrivateNIC(ctx context.Context, instanceAPI *instanceV2.API, zone scw.Zone, privateNICID string, timeout time.Duration) (*instanceV2.PrivateNetworkInterface, error) {
 	retryInterval := instancehelpers.DefaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	nic, err := instanceAPI.WaitForPrivateNetworkInterface(&instanceV2.WaitForPrivateNetworkInterfaceRequest{
@@ -61,8 +61,8 @@ func waitForPrivateNIC(ctx context.Context, instanceAPI *instanceV2.API, zone sc
 
 func waitForMACAddress(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, serverID string, privateNICID string, timeout time.Duration) error {
 	retryInterval := instancehelpers.DefaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	_, err := instanceAPI.WaitForMACAddress(&instance.WaitForMACAddressRequest{
@@ -78,8 +78,8 @@ func waitForMACAddress(ctx context.Context, instanceAPI *instance.API, zone scw.
 
 func waitForImage(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Image, error) {
 	retryInterval := instancehelpers.DefaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	image, err := api.WaitForImage(&instance.WaitForImageRequest{
@@ -94,8 +94,8 @@ func waitForImage(ctx context.Context, api *instance.API, zone scw.Zone, id stri
 
 func waitForFilesystems(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Server, error) {
 	retryInterval := instancehelpers.DefaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	server, err := api.WaitForServerFileSystem(&instance.WaitForServerFileSystemRequest{
@@ -11,6 +11,7 @@ import (
 	interlink "github.com/scaleway/scaleway-sdk-go/api/interlink/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -150,7 +151,7 @@ func dataSourceDedicatedConnectionReadByID(ctx context.Context, d *schema.Resour
 
 	d.SetId(regional.NewIDString(conn.Region, conn.ID))
 
-	return setDedicatedConnectionState(d, conn)
+	return setDedicatedConnectionState(d, m, conn)
 }
 
 func dataSourceDedicatedConnectionReadByFilters(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -190,13 +191,13 @@ func dataSourceDedicatedConnectionReadByFilters(ctx context.Context, d *schema.R
 	conn := matches[0]
 	d.SetId(regional.NewIDString(conn.Region, conn.ID))
 
-	return setDedicatedConnectionState(d, conn)
+	return setDedicatedConnectionState(d, m, conn)
 }
 
-func setDedicatedConnectionState(d *schema.ResourceData, conn *interlink.DedicatedConnection) diag.Diagnostics {
+func setDedicatedConnectionState(d *schema.ResourceData, m any, conn *interlink.DedicatedConnection) diag.Diagnostics {
 	_ = d.Set("name", conn.Name)
 	_ = d.Set("status", conn.Status.String())
-	_ = d.Set("tags", conn.Tags)
+	_ = d.Set("tags", tags.Flatten(m, conn.Tags))
 	_ = d.Set("pop_id", regional.NewIDString(conn.Region, conn.PopID))
 	_ = d.Set("bandwidth_mbps", int(conn.BandwidthMbps))
 	_ = d.Set("project_id", conn.ProjectID)
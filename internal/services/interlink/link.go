@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -39,6 +40,7 @@ func ResourceLink() *schema.Resource {
 		Identity:      identity.DefaultRegional(),
 		SchemaVersion: 0,
 		SchemaFunc:    linkSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -58,6 +60,7 @@ func linkSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"pop_id": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -220,7 +223,7 @@ func ResourceLinkCreate(ctx context.Context, d *schema.ResourceData, m any) diag
 		Region:        region,
 		ProjectID:     d.Get("project_id").(string),
 		Name:          types.ExpandOrGenerateString(d.Get("name").(string), "link"),
-		Tags:          types.ExpandStrings(d.Get("tags")),
+		Tags:          tags.Expand(d, m),
 		PopID:         regional.ExpandID(d.Get("pop_id").(string)).ID,
 		BandwidthMbps: uint64(d.Get("bandwidth_mbps").(int)),
 	}
@@ -318,7 +321,7 @@ func ResourceLinkRead(ctx context.Context, d *schema.ResourceData, m any) diag.D
 		return diag.FromErr(err)
 	}
 
-	diags := setLinkState(d, link)
+	diags := setLinkState(d, m, link)
 
 	err = identity.SetRegionalIdentity(d, link.Region, link.ID)
 	if err != nil {
@@ -328,12 +331,12 @@ func ResourceLinkRead(ctx context.Context, d *schema.ResourceData, m any) diag.D
 	return diags
 }
 
-func setLinkState(d *schema.ResourceData, link *interlink.Link) diag.Diagnostics {
+func setLinkState(d *schema.ResourceData, m any, link *interlink.Link) diag.Diagnostics {
 	_ = d.Set("name", link.Name)
 	_ = d.Set("region", link.Region)
 	_ = d.Set("project_id", link.ProjectID)
 	_ = d.Set("organization_id", link.OrganizationID)
-	_ = d.Set("tags", link.Tags)
+	tags.Set(d, m, link.Tags)
 	_ = d.Set("pop_id", regional.NewIDString(link.Region, link.PopID))
 	_ = d.Set("bandwidth_mbps", int(link.BandwidthMbps))
 	_ = d.Set("status", link.Status.String())
@@ -408,8 +411,8 @@ func ResourceLinkUpdate(ctx context.Context, d *schema.ResourceData, m any) diag
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
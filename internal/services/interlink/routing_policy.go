@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -32,6 +33,7 @@ func ResourceRoutingPolicy() *schema.Resource {
 		Identity:      identity.DefaultRegional(),
 		SchemaVersion: 0,
 		SchemaFunc:    routingPolicySchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -51,6 +53,7 @@ func routingPolicySchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"is_ipv6": {
 			Type:        schema.TypeBool,
 			Computed:    true,
@@ -116,7 +119,7 @@ func ResourceRoutingPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 		Region:          region,
 		ProjectID:       d.Get("project_id").(string),
 		Name:            types.ExpandOrGenerateString(d.Get("name").(string), "routing-policy"),
-		Tags:            types.ExpandStrings(d.Get("tags")),
+		Tags:            tags.Expand(d, m),
 		IsIPv6:          d.Get("is_ipv6").(bool),
 		PrefixFilterIn:  prefixFilterIn,
 		PrefixFilterOut: prefixFilterOut,
@@ -155,7 +158,7 @@ func ResourceRoutingPolicyRead(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	diags := setRoutingPolicyState(d, policy)
+	diags := setRoutingPolicyState(d, m, policy)
 
 	err = identity.SetRegionalIdentity(d, policy.Region, policy.ID)
 	if err != nil {
@@ -165,7 +168,7 @@ func ResourceRoutingPolicyRead(ctx context.Context, d *schema.ResourceData, m an
 	return diags
 }
 
-func setRoutingPolicyState(d *schema.ResourceData, policy *interlink.RoutingPolicy) diag.Diagnostics {
+func setRoutingPolicyState(d *schema.ResourceData, m any, policy *interlink.RoutingPolicy) diag.Diagnostics {
 	prefixFilterIn, err := flattenPrefixFilters(policy.PrefixFilterIn)
 	if err != nil {
 		return diag.FromErr(err)
@@ -180,7 +183,7 @@ func setRoutingPolicyState(d *schema.ResourceData, policy *interlink.RoutingPoli
 	_ = d.Set("region", policy.Region)
 	_ = d.Set("project_id", policy.ProjectID)
 	_ = d.Set("organization_id", policy.OrganizationID)
-	_ = d.Set("tags", policy.Tags)
+	tags.Set(d, m, policy.Tags)
 	_ = d.Set("created_at", types.FlattenTime(policy.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(policy.UpdatedAt))
 	_ = d.Set("is_ipv6", policy.IsIPv6)
@@ -208,8 +211,8 @@ func ResourceRoutingPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
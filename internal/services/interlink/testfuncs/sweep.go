@@ -9,12 +9,12 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_interlink_link", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_interlink_link", &resource.Sweeper{
 		Name: "scaleway_interlink_link",
 		F:    testSweepLink,
 	})
 
-	resource.AddTestSweepers("scaleway_interlink_routing_policy", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_interlink_routing_policy", &resource.Sweeper{
 		Name:         "scaleway_interlink_routing_policy",
 		F:            testSweepRoutingPolicy,
 		Dependencies: []string{"scaleway_interlink_link"},
@@ -16,8 +16,8 @@ const (
 
 func waitForLink(ctx context.Context, api *interlink.API, region scw.Region, linkID string, timeout time.Duration) (*interlink.Link, error) {
 	retryInterval := defaultLinkRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	link, err := api.WaitForLink(&interlink.WaitForLinkRequest{
@@ -39,8 +39,8 @@ func NewAPIWithRegionAndID(m any, id string) (*iot.API, scw.Region, string, erro
 
 func waitIotHub(ctx context.Context, api *iot.API, region scw.Region, id string, timeout time.Duration) (*iot.Hub, error) {
 	retryInterval := defaultIoTRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	hub, err := api.WaitForHub(&iot.WaitForHubRequest{
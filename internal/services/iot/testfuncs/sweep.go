@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_iot_hub", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iot_hub", &resource.Sweeper{
 		Name: "scaleway_iot_hub",
 		F:    testSweepHub,
 	})
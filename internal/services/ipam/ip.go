@@ -18,6 +18,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -33,6 +34,7 @@ func ResourceIP() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    ipSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -115,6 +117,7 @@ func ipSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all":   tags.AllSchema(),
 		"project_id": account.ProjectIDSchema(),
 		"region":     regional.Schema(),
 		// Computed elements
@@ -190,7 +193,7 @@ func ResourceIPAMIPCreate(ctx context.Context, d *schema.ResourceData, m any) di
 		Region:    region,
 		ProjectID: d.Get("project_id").(string),
 		IsIPv6:    d.Get("is_ipv6").(bool),
-		Tags:      types.ExpandStrings(d.Get("tags")),
+		Tags:      tags.Expand(d, m),
 	}
 
 	address, addressOk := d.GetOk("address")
@@ -283,7 +286,7 @@ func ResourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m any) diag
 		}
 	}
 
-	diags := setIPAMIPState(d, res, privateNetworkID)
+	diags := setIPAMIPState(d, m, res, privateNetworkID)
 
 	err = identity.SetRegionalIdentity(d, res.Region, res.ID)
 	if err != nil {
@@ -293,7 +296,7 @@ func ResourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m any) diag
 	return diags
 }
 
-func setIPAMIPState(d *schema.ResourceData, ip *ipam.IP, privateNetworkID string) diag.Diagnostics {
+func setIPAMIPState(d *schema.ResourceData, m any, ip *ipam.IP, privateNetworkID string) diag.Diagnostics {
 	addressCidr, err := types.FlattenIPNet(ip.Address)
 	if err != nil {
 		return diag.FromErr(err)
@@ -314,9 +317,7 @@ func setIPAMIPState(d *schema.ResourceData, ip *ipam.IP, privateNetworkID string
 		_ = d.Set("zone", ip.Zone.String())
 	}
 
-	if len(ip.Tags) > 0 {
-		_ = d.Set("tags", ip.Tags)
-	}
+	tags.Set(d, m, ip.Tags)
 
 	_ = d.Set("reverses", flattenIPReverses(ip.Reverses))
 
@@ -345,11 +346,11 @@ func ResourceIPAMIPUpdate(ctx context.Context, d *schema.ResourceData, m any) di
 		}
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		_, err = ipamAPI.UpdateIP(&ipam.UpdateIPRequest{
 			IPID:   ID,
 			Region: region,
-			Tags:   types.ExpandUpdatedStringsPtr(d.Get("tags")),
+			Tags:   tags.ExpandUpdated(d, m),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
@@ -296,7 +296,7 @@ func (r *IPListResource) List(ctx context.Context, req list.ListRequest, stream
 				privateNetworkID = regional.NewIDString(ip.Region, *ip.Source.PrivateNetworkID)
 			}
 
-			sdkDiags := setIPAMIPState(resourceData, ip, privateNetworkID)
+			sdkDiags := setIPAMIPState(resourceData, r.meta, ip, privateNetworkID)
 			if sdkDiags.HasError() {
 				tflog.Error(ctx, "error from setting IPAM IP state")
 
@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_ipam_ip", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_ipam_ip", &resource.Sweeper{
 		Name: "scaleway_ipam_ip",
 		F:    testSweepIPAMIP,
 	})
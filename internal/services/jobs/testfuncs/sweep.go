@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_job_definition", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_job_definition", &resource.Sweeper{
 		Name: "scaleway_job_definition",
 		F:    testSweepJobDefinition,
 	})
@@ -23,6 +23,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -143,6 +144,7 @@ func ResourceCluster() *schema.Resource {
 
 				return nil
 			},
+			tags.CustomizeDiff,
 		),
 	}
 }
@@ -195,6 +197,7 @@ func clusterSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the cluster",
 		},
+		"tags_all": tags.AllSchema(),
 		"autoscaler_config": {
 			Type:        schema.TypeList,
 			MaxItems:    1,
@@ -416,7 +419,7 @@ func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m any
 		Type:              clusterType.(string),
 		Description:       description.(string),
 		Cni:               k8s.CNI(d.Get("cni").(string)),
-		Tags:              types.ExpandStrings(d.Get("tags")),
+		Tags:              tags.Expand(d, m),
 		FeatureGates:      types.ExpandStrings(d.Get("feature_gates")),
 		AdmissionPlugins:  types.ExpandStrings(d.Get("admission_plugins")),
 		ApiserverCertSans: types.ExpandStrings(d.Get("apiserver_cert_sans")),
@@ -643,7 +646,7 @@ func ResourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	diagnostics := setClusterState(ctx, d, cluster, k8sAPI)
+	diagnostics := setClusterState(ctx, d, m, cluster, k8sAPI)
 	if diagnostics.HasError() {
 		return diagnostics
 	}
@@ -656,7 +659,7 @@ func ResourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m any)
 	return nil
 }
 
-func setClusterState(ctx context.Context, d *schema.ResourceData, cluster *k8s.Cluster, k8sAPI *k8s.API) diag.Diagnostics {
+func setClusterState(ctx context.Context, d *schema.ResourceData, m any, cluster *k8s.Cluster, k8sAPI *k8s.API) diag.Diagnostics {
 	_ = d.Set("region", cluster.Region)
 	_ = d.Set("name", cluster.Name)
 	_ = d.Set("type", cluster.Type)
@@ -664,7 +667,7 @@ func setClusterState(ctx context.Context, d *schema.ResourceData, cluster *k8s.C
 	_ = d.Set("project_id", cluster.ProjectID)
 	_ = d.Set("description", cluster.Description)
 	_ = d.Set("cni", cluster.Cni)
-	_ = d.Set("tags", cluster.Tags)
+	tags.Set(d, m, cluster.Tags)
 	_ = d.Set("apiserver_cert_sans", cluster.ApiserverCertSans)
 	_ = d.Set("created_at", cluster.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", cluster.UpdatedAt.Format(time.RFC3339))
@@ -783,8 +786,8 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m any
 		updateRequest.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("apiserver_cert_sans") {
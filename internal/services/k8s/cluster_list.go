@@ -0,0 +1,299 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+var (
+	_ list.ListResource                 = (*ClusterListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*ClusterListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*ClusterListResource)(nil)
+)
+
+type ClusterListResource struct {
+	meta   *meta.Meta
+	k8sAPI *k8s.API
+}
+
+func (r *ClusterListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.k8sAPI = k8s.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewClusterListResource() list.ListResource {
+	return &ClusterListResource{}
+}
+
+func (r *ClusterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":     listscw.NameAttribute("Name of the cluster to filter for"),
+			"tags":     listscw.TagsAttribute("Tags of the cluster to filter for"),
+			"statuses": listscw.StatesAttribute[k8s.ClusterStatus]("Statuses of the cluster to filter for (e.g. ready, pool_required)"),
+			"types": schema.ListAttribute{
+				Description: "Types of the cluster to filter for (e.g. kapsule, multicloud)",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"versions": schema.ListAttribute{
+				Description: "Kubernetes versions of the cluster to filter for, either minor (e.g. 1.32) or full (e.g. 1.32.3) versions",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"organization_id": listscw.OrganizationIDAttribute("Organization ID to filter for"),
+			"project_ids":     listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":         listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *ClusterListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	clusterResource := ResourceCluster()
+
+	resp.ProtoV6Schema = translate.Schema(clusterResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(clusterResource.ProtoIdentitySchema(ctx)())
+}
+
+type ClusterListResourceModel struct {
+	Tags           types.List   `tfsdk:"tags"`
+	Statuses       types.List   `tfsdk:"statuses"`
+	Types          types.List   `tfsdk:"types"`
+	Versions       types.List   `tfsdk:"versions"`
+	Regions        types.List   `tfsdk:"regions"`
+	ProjectIDs     types.List   `tfsdk:"project_ids"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (m *ClusterListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *ClusterListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *ClusterListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *ClusterListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *ClusterListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_k8s_cluster"
+}
+
+// clusterFilters are the filters of the cluster list resource which are not supported by the API.
+type clusterFilters struct {
+	tags     []string
+	statuses []string
+	types    []string
+	versions []string
+}
+
+// extractClusterFilters reads the filters of the cluster list resource which are not supported by the API.
+func extractClusterFilters(ctx context.Context, data *ClusterListResourceModel) (clusterFilters, diag.Diagnostics) {
+	filters := clusterFilters{}
+
+	var diags diag.Diagnostics
+
+	filters.tags, diags = listscw.ExtractTags(ctx, data)
+	if diags.HasError() {
+		return filters, diags
+	}
+
+	filters.statuses, diags = listscw.ExtractStates(ctx, data)
+	if diags.HasError() {
+		return filters, diags
+	}
+
+	filters.types, diags = listscw.ExtractStrings(ctx, data.Types)
+	if diags.HasError() {
+		return filters, diags
+	}
+
+	filters.versions, diags = listscw.ExtractStrings(ctx, data.Versions)
+
+	return filters, diags
+}
+
+func (f clusterFilters) match(cluster *k8s.Cluster) bool {
+	return listscw.MatchTags(f.tags, cluster.Tags) &&
+		listscw.MatchStates(f.statuses, cluster.Status.String()) &&
+		listscw.MatchStates(f.types, cluster.Type) &&
+		matchVersions(f.versions, cluster.Version)
+}
+
+// matchVersions reports whether version is one of versions, given as minor (x.y) or full (x.y.z) versions.
+// Every version matches when versions is empty.
+func matchVersions(versions []string, version string) bool {
+	if len(versions) == 0 {
+		return true
+	}
+
+	for _, v := range versions {
+		if version == v || strings.HasPrefix(version, v+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fetchClusters lists the clusters of a project in a region, keeping the ones matching the filters.
+func fetchClusters(ctx context.Context, k8sAPI *k8s.API, region scw.Region, project, name, organizationID *string, filters clusterFilters) ([]*k8s.Cluster, error) {
+	listRequest := &k8s.ListClustersRequest{
+		Region:         region,
+		Name:           name,
+		OrganizationID: organizationID,
+		ProjectID:      project,
+	}
+
+	// The API filters on a single type, the other ones are filtered afterward
+	if len(filters.types) == 1 {
+		listRequest.Type = &filters.types[0]
+	}
+
+	response, err := k8sAPI.ListClusters(listRequest, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]*k8s.Cluster, 0, len(response.Clusters))
+
+	for _, cluster := range response.Clusters {
+		if filters.match(cluster) {
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	return clusters, nil
+}
+
+func (r *ClusterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ClusterListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	filters, diags := extractClusterFilters(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	regions, err := listscw.ExtractRegions(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing regions", "An error was encountered when listing regions: "+err.Error()),
+		})
+
+		return
+	}
+
+	projects, err := listscw.ExtractProjects(ctx, &data, r.meta)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing projects", "An error was encountered when listing projects: "+err.Error()),
+		})
+
+		return
+	}
+
+	allClusters, err := listscw.FetchConcurrently(ctx, listscw.RegionalProjectTargets(regions, projects),
+		func(ctx context.Context, target listscw.RegionalFetchTarget) ([]*k8s.Cluster, error) {
+			return fetchClusters(ctx, r.k8sAPI, target.Region, &target.ProjectID, data.Name.ValueStringPointer(), data.OrganizationID.ValueStringPointer(), filters)
+		},
+		func(a, b *k8s.Cluster) int {
+			return listscw.CompareRegionalProjectItems(a.ProjectID, b.ProjectID, a.Region, b.Region, a.ID, b.ID)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing clusters", "Failed to list clusters: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, cluster := range allClusters {
+			result := req.NewListResult(ctx)
+			result.DisplayName = cluster.Name
+
+			clusterResource := ResourceCluster()
+			resourceData := clusterResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, cluster.Region, cluster.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			sdkDiags := setClusterState(ctx, resourceData, r.meta, cluster, r.k8sAPI)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting cluster state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
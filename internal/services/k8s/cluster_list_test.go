@@ -0,0 +1,69 @@
+package k8s_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	vpcchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc/testfuncs"
+)
+
+func TestAccListK8SClusters_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListK8SClusters_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	latestK8SVersion := testAccK8SClusterGetLatestK8SVersion(tt)
+
+	clusterConfig := fmt.Sprintf(`
+		resource "scaleway_vpc_private_network" "main" {
+		  name = "tf-acc-k8s-cluster-list"
+		}
+
+		resource "scaleway_k8s_cluster" "main" {
+		  name                        = "tf-acc-k8s-cluster-list"
+		  version                     = %q
+		  cni                         = "cilium"
+		  private_network_id          = scaleway_vpc_private_network.main.id
+		  delete_additional_resources = true
+		  tags                        = ["tf-acc-k8s-cluster-list"]
+		}
+	`, latestK8SVersion)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckK8SClusterDestroy(tt),
+			vpcchecks.CheckPrivateNetworkDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: clusterConfig,
+			},
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+					list "scaleway_k8s_cluster" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    project_ids = [scaleway_k8s_cluster.main.project_id]
+					    tags        = ["tf-acc-k8s-cluster-list"]
+					    types       = ["kapsule"]
+					    versions    = [%q]
+					    statuses    = ["pool_required"]
+					  }
+					}
+				`, latestK8SVersion),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_k8s_cluster.by_tag", 1),
+				},
+			},
+		},
+	})
+}
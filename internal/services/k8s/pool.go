@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
@@ -21,6 +22,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -40,7 +42,10 @@ func ResourcePool() *schema.Resource {
 		ReadContext:   ResourceK8SPoolRead,
 		UpdateContext: ResourceK8SPoolUpdate,
 		DeleteContext: ResourceK8SPoolDelete,
-		CustomizeDiff: ResourceK8SPoolCustomDiff,
+		CustomizeDiff: customdiff.All(
+			ResourceK8SPoolCustomDiff,
+			tags.CustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -113,6 +118,7 @@ func poolSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the pool",
 		},
+		"tags_all": tags.AllSchema(),
 		"container_runtime": {
 			Type:             schema.TypeString,
 			Optional:         true,
@@ -366,7 +372,7 @@ func ResourceK8SPoolCreate(ctx context.Context, d *schema.ResourceData, m any) d
 		Autoscaling:      d.Get("autoscaling").(bool),
 		Autohealing:      d.Get("autohealing").(bool),
 		Size:             uint32(d.Get("size").(int)),
-		Tags:             types.ExpandStrings(d.Get("tags")),
+		Tags:             tags.Expand(d, m),
 		KubeletArgs:      expandKubeletArgs(d.Get("kubelet_args")),
 		PublicIPDisabled: d.Get("public_ip_disabled").(bool),
 	}
@@ -553,7 +559,7 @@ func setPoolState(ctx context.Context, d *schema.ResourceData, m any, pool *k8s.
 		_ = d.Set("root_volume_size_in_gb", int(*pool.RootVolumeSize)/1e9)
 	}
 
-	_ = d.Set("tags", pool.Tags)
+	tags.Set(d, m, pool.Tags)
 	_ = d.Set("container_runtime", pool.ContainerRuntime)
 	_ = d.Set("created_at", pool.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", pool.UpdatedAt.Format(time.RFC3339))
@@ -668,8 +674,8 @@ func ResourceK8SPoolUpdate(ctx context.Context, d *schema.ResourceData, m any) d
 		updateRequest.Size = new(uint32(d.Get("size").(int)))
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("kubelet_args") {
@@ -0,0 +1,299 @@
+package k8s
+
+import (
+	"cmp"
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	listscw "github.com/scaleway/terraform-provider-scaleway/v2/internal/list"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+var (
+	_ list.ListResource                 = (*PoolListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*PoolListResource)(nil)
+	_ list.ListResourceWithRawV6Schemas = (*PoolListResource)(nil)
+)
+
+type PoolListResource struct {
+	meta   *meta.Meta
+	k8sAPI *k8s.API
+}
+
+func (r *PoolListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	m := listscw.ConfigureMeta(request, response)
+	if m == nil {
+		return
+	}
+
+	r.meta = m
+	r.k8sAPI = k8s.NewAPI(meta.ExtractScwClient(m))
+}
+
+func NewPoolListResource() list.ListResource {
+	return &PoolListResource{}
+}
+
+func (r *PoolListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the cluster to list the pools of. The pools of every cluster of the projects and regions are listed if not set",
+				Optional:    true,
+				Validators: []validator.String{
+					verify.IsStringUUIDOrUUIDWithLocality(),
+				},
+			},
+			"name":     listscw.NameAttribute("Name of the pool to filter for"),
+			"tags":     listscw.TagsAttribute("Tags of the pool to filter for"),
+			"statuses": listscw.StatesAttribute[k8s.PoolStatus]("Statuses of the pool to filter for (e.g. ready, scaling)"),
+			"versions": schema.ListAttribute{
+				Description: "Kubernetes versions of the pool to filter for, either minor (e.g. 1.32) or full (e.g. 1.32.3) versions",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"organization_id": listscw.OrganizationIDAttribute("Organization ID to filter for"),
+			"project_ids":     listscw.ProjectIDsAttribute("Project IDs to filter for."),
+			"regions":         listscw.RegionsAttribute("Regions to filter for."),
+		},
+	}
+}
+
+func (r *PoolListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	poolResource := ResourcePool()
+
+	resp.ProtoV6Schema = translate.Schema(poolResource.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = translate.ResourceIdentitySchema(poolResource.ProtoIdentitySchema(ctx)())
+}
+
+type PoolListResourceModel struct {
+	Tags           types.List   `tfsdk:"tags"`
+	Statuses       types.List   `tfsdk:"statuses"`
+	Versions       types.List   `tfsdk:"versions"`
+	Regions        types.List   `tfsdk:"regions"`
+	ProjectIDs     types.List   `tfsdk:"project_ids"`
+	ClusterID      types.String `tfsdk:"cluster_id"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (m *PoolListResourceModel) GetTags() types.List     { return m.Tags }
+func (m *PoolListResourceModel) GetStates() types.List   { return m.Statuses }
+func (m *PoolListResourceModel) GetRegions() types.List  { return m.Regions }
+func (m *PoolListResourceModel) GetProjects() types.List { return m.ProjectIDs }
+
+func (r *PoolListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_k8s_pool"
+}
+
+// listClusters returns the clusters to list the pools of: the one of cluster_id, or every cluster of the projects and regions.
+func (r *PoolListResource) listClusters(ctx context.Context, data *PoolListResourceModel) ([]*k8s.Cluster, error) {
+	if !data.ClusterID.IsNull() {
+		region, clusterID, err := regional.ParseID(data.ClusterID.ValueString())
+		if err == nil {
+			return []*k8s.Cluster{{ID: clusterID, Region: region}}, nil
+		}
+
+		// A cluster ID without region is looked up in the default region of the provider
+		region, exists := r.meta.ScwClient().GetDefaultRegion()
+		if !exists {
+			return nil, regional.ErrRegionNotFound
+		}
+
+		return []*k8s.Cluster{{ID: data.ClusterID.ValueString(), Region: region}}, nil
+	}
+
+	regions, err := listscw.ExtractRegions(ctx, data, r.meta)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := listscw.ExtractProjects(ctx, data, r.meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return listscw.FetchConcurrently(ctx, listscw.RegionalProjectTargets(regions, projects),
+		func(ctx context.Context, target listscw.RegionalFetchTarget) ([]*k8s.Cluster, error) {
+			return fetchClusters(ctx, r.k8sAPI, target.Region, &target.ProjectID, nil, data.OrganizationID.ValueStringPointer(), clusterFilters{})
+		},
+		nil,
+	)
+}
+
+func (r *PoolListResource) FetchPools(ctx context.Context, cluster *k8s.Cluster, tags, statuses, versions []string, data PoolListResourceModel) ([]*k8s.Pool, error) {
+	response, err := r.k8sAPI.ListPools(&k8s.ListPoolsRequest{
+		Region:    cluster.Region,
+		ClusterID: cluster.ID,
+		Name:      data.Name.ValueStringPointer(),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]*k8s.Pool, 0, len(response.Pools))
+
+	for _, pool := range response.Pools {
+		if listscw.MatchTags(tags, pool.Tags) &&
+			listscw.MatchStates(statuses, pool.Status.String()) &&
+			matchVersions(versions, pool.Version) {
+			pools = append(pools, pool)
+		}
+	}
+
+	return pools, nil
+}
+
+func (r *PoolListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data PoolListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	tags, diags := listscw.ExtractTags(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	statuses, diags := listscw.ExtractStates(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	versions, diags := listscw.ExtractStrings(ctx, data.Versions)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	clusters, err := r.listClusters(ctx, &data)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing clusters", "An error was encountered when listing clusters: "+err.Error()),
+		})
+
+		return
+	}
+
+	allPools, err := listscw.FetchConcurrently(ctx, clusters,
+		func(ctx context.Context, cluster *k8s.Cluster) ([]*k8s.Pool, error) {
+			return r.FetchPools(ctx, cluster, tags, statuses, versions, data)
+		},
+		func(a, b *k8s.Pool) int {
+			return cmp.Or(
+				strings.Compare(string(a.Region), string(b.Region)),
+				strings.Compare(a.ClusterID, b.ClusterID),
+				strings.Compare(a.ID, b.ID),
+			)
+		},
+	)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Listing pools", "Failed to list pools: "+err.Error()),
+		})
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, pool := range allPools {
+			result := req.NewListResult(ctx)
+			result.DisplayName = pool.Name
+
+			poolResource := ResourcePool()
+			resourceData := poolResource.Data(&terraform.InstanceState{})
+
+			err := identity.SetRegionalIdentity(resourceData, pool.Region, pool.ID)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Retrieving identity data",
+					"An error was encountered when retrieving the identity data: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeIdentity, errIdentityState := resourceData.TfTypeIdentityState()
+			if errIdentityState != nil {
+				result.Diagnostics.AddError(
+					"Converting identity data",
+					"An error was encountered when converting the identity data: "+errIdentityState.Error(),
+				)
+			}
+
+			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
+			result.Diagnostics.Append(identitySetDiags...)
+
+			nodes, err := getNodes(ctx, r.k8sAPI, pool)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Listing pool nodes",
+					"An error was encountered when listing the nodes of the pool: "+err.Error(),
+				)
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			sdkDiags := setPoolState(ctx, resourceData, r.meta, pool, r.k8sAPI, nodes)
+			if sdkDiags.HasError() {
+				tflog.Error(ctx, "error from setting pool state")
+
+				for _, d := range sdkDiags {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				}
+
+				if !push(result) {
+					return
+				}
+
+				continue
+			}
+
+			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
+			if errTfTypeResourceState != nil {
+				result.Diagnostics.AddError(
+					"Converting resource state",
+					"An error was encountered when converting the resource state: "+errTfTypeResourceState.Error(),
+				)
+			}
+
+			resourceSetDiags := result.Resource.Set(ctx, *tfTypeResource)
+			result.Diagnostics.Append(resourceSetDiags...)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package k8s_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	vpcchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc/testfuncs"
+)
+
+func TestAccListK8SPools_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccListK8SPools_Basic because list resources are not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	latestK8SVersion := testAccK8SClusterGetLatestK8SVersion(tt)
+
+	poolConfig := fmt.Sprintf(`
+		resource "scaleway_vpc_private_network" "main" {
+		  name = "tf-acc-k8s-pool-list"
+		}
+
+		resource "scaleway_k8s_cluster" "main" {
+		  name                        = "tf-acc-k8s-pool-list"
+		  version                     = %q
+		  cni                         = "cilium"
+		  private_network_id          = scaleway_vpc_private_network.main.id
+		  delete_additional_resources = true
+		}
+
+		resource "scaleway_k8s_pool" "main" {
+		  cluster_id = scaleway_k8s_cluster.main.id
+		  name       = "tf-acc-k8s-pool-list"
+		  node_type  = "pro2_xxs"
+		  size       = 1
+		  tags       = ["tf-acc-k8s-pool-list"]
+		}
+	`, latestK8SVersion)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckK8SPoolDestroy(tt, "scaleway_k8s_pool.main"),
+			testAccCheckK8SClusterDestroy(tt),
+			vpcchecks.CheckPrivateNetworkDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: poolConfig,
+			},
+			{
+				Query: true,
+				Config: `
+					list "scaleway_k8s_pool" "by_cluster" {
+					  provider = scaleway
+
+					  config {
+					    cluster_id = scaleway_k8s_cluster.main.id
+					  }
+					}
+
+					list "scaleway_k8s_pool" "by_tag" {
+					  provider = scaleway
+
+					  config {
+					    project_ids = [scaleway_k8s_cluster.main.project_id]
+					    tags        = ["tf-acc-k8s-pool-list"]
+					    statuses    = ["ready"]
+					  }
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("list.scaleway_k8s_pool.by_cluster", 1),
+					querycheck.ExpectLength("list.scaleway_k8s_pool.by_tag", 1),
+				},
+			},
+		},
+	})
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_k8s_cluster", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_k8s_cluster", &resource.Sweeper{
 		Name: "scaleway_k8s_cluster",
 		F:    testSweepK8SCluster,
 	})
@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -33,8 +34,9 @@ func ResourceCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
-		SchemaFunc: clusterSchema,
-		Identity:   identity.DefaultRegional(),
+		SchemaFunc:    clusterSchema,
+		CustomizeDiff: tags.CustomizeDiff,
+		Identity:      identity.DefaultRegional(),
 	}
 }
 
@@ -53,6 +55,7 @@ func clusterSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags to apply",
 		},
+		"tags_all": tags.AllSchema(),
 		"version": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -200,8 +203,8 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any
 		},
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		req.Tags = types.ExpandStrings(v)
+	if clusterTags := tags.Expand(d, meta); len(clusterTags) > 0 {
+		req.Tags = clusterTags
 	}
 
 	if v, ok := d.GetOk("user_name"); ok {
@@ -273,7 +276,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 
-	diags := setClusterState(d, cluster)
+	diags := setClusterState(d, meta, cluster)
 	if err := identity.SetRegionalIdentity(d, cluster.Region, cluster.ID); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
@@ -303,14 +306,14 @@ func readClusterIntoState(ctx context.Context, d *schema.ResourceData, m any) di
 		return diag.FromErr(err)
 	}
 
-	return setClusterState(d, cluster)
+	return setClusterState(d, m, cluster)
 }
 
-func setClusterState(d *schema.ResourceData, cluster *kafkaapi.Cluster) diag.Diagnostics {
+func setClusterState(d *schema.ResourceData, m any, cluster *kafkaapi.Cluster) diag.Diagnostics {
 	_ = d.Set("region", string(cluster.Region))
 	_ = d.Set("project_id", cluster.ProjectID)
 	_ = d.Set("name", cluster.Name)
-	_ = d.Set("tags", types.FlattenSliceString(cluster.Tags))
+	tags.Set(d, m, cluster.Tags)
 	_ = d.Set("version", cluster.Version)
 	_ = d.Set("node_amount", int(cluster.NodeAmount))
 	_ = d.Set("node_type", cluster.NodeType)
@@ -361,8 +364,8 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = new(types.ExpandStrings(d.Get("tags")))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, meta)
 		hasChanged = true
 	}
 
@@ -10,7 +10,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_kafka_cluster", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_kafka_cluster", &resource.Sweeper{
 		Name: "scaleway_kafka_cluster",
 		F:    testSweepCluster,
 	})
@@ -11,8 +11,8 @@ import (
 
 func waitForKafkaCluster(ctx context.Context, api *kafka.API, region scw.Region, id string, timeout time.Duration) (*kafka.Cluster, error) {
 	retryInterval := defaultWaitRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	req := &kafka.WaitForClusterRequest{
@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -170,7 +171,7 @@ func FlattenKeyRotationPolicy(rp *key_manager.KeyRotationPolicy) []map[string]an
 	}
 }
 
-func setKeyState(d *schema.ResourceData, key *key_manager.Key) {
+func setKeyState(d *schema.ResourceData, m any, key *key_manager.Key) {
 	_ = d.Set("name", key.Name)
 	_ = d.Set("project_id", key.ProjectID)
 	_ = d.Set("region", key.Region.String())
@@ -182,7 +183,7 @@ func setKeyState(d *schema.ResourceData, key *key_manager.Key) {
 	_ = d.Set("algorithm", algorithm)
 
 	_ = d.Set("description", key.Description)
-	_ = d.Set("tags", key.Tags)
+	tags.Set(d, m, key.Tags)
 	_ = d.Set("state", key.State.String())
 	_ = d.Set("rotation_count", int(key.RotationCount))
 	_ = d.Set("created_at", types.FlattenTime(key.CreatedAt))
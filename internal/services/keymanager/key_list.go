@@ -200,7 +200,7 @@ func (r *KeyListResource) List(ctx context.Context, req list.ListRequest, stream
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			setKeyState(resourceData, key)
+			setKeyState(resourceData, r.meta, key)
 
 			tfTypeResource, errTfTypeResourceState := resourceData.TfTypeResourceState()
 			if errTfTypeResourceState != nil {
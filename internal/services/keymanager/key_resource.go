@@ -32,6 +32,7 @@ func ResourceKeyManagerKey() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			validateUsageAlgorithmCombination(),
+			tags.CustomizeDiff,
 		),
 		SchemaFunc: keySchema,
 	}
@@ -91,6 +92,7 @@ func keySchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Description: "List of the key's tags.",
 		},
+		"tags_all": tags.AllSchema(),
 		"rotation_policy": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -154,8 +156,8 @@ func resourceKeyManagerKeyCreate(ctx context.Context, d *schema.ResourceData, m
 		Unprotected: d.Get("unprotected").(bool),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		createReq.Tags = types.ExpandStrings(v)
+	if keyTags := tags.Expand(d, m); len(keyTags) > 0 {
+		createReq.Tags = keyTags
 	}
 
 	if v, ok := d.GetOk("rotation_policy"); ok {
@@ -219,7 +221,7 @@ func resourceKeyManagerKeyRead(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	setKeyState(d, key)
+	setKeyState(d, m, key)
 
 	err = identity.SetRegionalIdentity(d, key.Region, key.ID)
 	if err != nil {
@@ -248,8 +250,8 @@ func resourceKeyManagerKeyUpdate(ctx context.Context, d *schema.ResourceData, m
 		updateReq.Description = new(d.Get("description").(string))
 	}
 
-	if d.HasChange("tags") {
-		updateReq.Tags = new(types.ExpandStrings(d.Get("tags")))
+	if tags.HasChange(d) {
+		updateReq.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("rotation_policy") {
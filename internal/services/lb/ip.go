@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -37,7 +38,8 @@ func ResourceIP() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{Version: 0, Type: lbUpgradeV1SchemaType(), Upgrade: UpgradeStateV1Func},
 		},
-		SchemaFunc: ipSchema,
+		SchemaFunc:    ipSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -78,7 +80,8 @@ func ipSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with the flexible IP",
 		},
-		"region": regional.ComputedSchema(),
+		"tags_all": tags.AllSchema(),
+		"region":   regional.ComputedSchema(),
 	}
 }
 
@@ -98,7 +101,7 @@ func resourceLbIPCreate(ctx context.Context, d *schema.ResourceData, m any) diag
 		ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		Reverse:   types.ExpandStringPtr(d.Get("reverse")),
 		IsIPv6:    d.Get("is_ipv6").(bool),
-		Tags:      types.ExpandStrings(d.Get("tags")),
+		Tags:      tags.Expand(d, m),
 	}
 
 	res, err := lbAPI.CreateIP(createReq, scw.WithContext(ctx))
@@ -148,7 +151,7 @@ func resourceLbIPRead(ctx context.Context, d *schema.ResourceData, m any) diag.D
 		}
 	}
 
-	diags := setIPState(d, ip, zone)
+	diags := setIPState(d, m, ip, zone)
 
 	err = identity.SetZonalIdentity(d, ip.Zone, ip.ID)
 	if err != nil {
@@ -158,7 +161,7 @@ func resourceLbIPRead(ctx context.Context, d *schema.ResourceData, m any) diag.D
 	return diags
 }
 
-func setIPState(d *schema.ResourceData, ip *lbSDK.IP, zone scw.Zone) diag.Diagnostics {
+func setIPState(d *schema.ResourceData, m any, ip *lbSDK.IP, zone scw.Zone) diag.Diagnostics {
 	region, _ := zone.Region()
 	_ = d.Set("region", string(region))
 	_ = d.Set("zone", ip.Zone.String())
@@ -167,7 +170,7 @@ func setIPState(d *schema.ResourceData, ip *lbSDK.IP, zone scw.Zone) diag.Diagno
 	_ = d.Set("ip_address", ip.IPAddress)
 	_ = d.Set("reverse", ip.Reverse)
 	_ = d.Set("lb_id", types.FlattenStringPtr(ip.LBID))
-	_ = d.Set("tags", ip.Tags)
+	tags.Set(d, m, ip.Tags)
 
 	isIPv6 := false
 
@@ -243,8 +246,8 @@ func resourceLbIPUpdate(ctx context.Context, d *schema.ResourceData, m any) diag
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -54,6 +55,7 @@ func ResourceLb() *schema.Resource {
 			cdf.LocalityCheck("ip_id", "private_network.#.private_network_id"),
 			customizeDiffLBIPIDs,
 			customizeDiffAssignFlexibleIPv6,
+			tags.CustomizeDiff,
 		),
 		SchemaFunc: lbSchema,
 	}
@@ -86,6 +88,7 @@ func lbSchema() map[string]*schema.Schema {
 			},
 			Description: "Array of tags to associate with the load-balancer",
 		},
+		"tags_all": tags.AllSchema(),
 		"ip_id": {
 			Type:             schema.TypeString,
 			Optional:         true,
@@ -259,10 +262,8 @@ func resourceLbCreate(ctx context.Context, d *schema.ResourceData, m any) diag.D
 		AssignFlexibleIPv6:    types.ExpandBoolPtr(types.GetBool(d, "assign_flexible_ipv6")),
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		for _, tag := range tags.([]any) {
-			createReq.Tags = append(createReq.Tags, tag.(string))
-		}
+	if lbTags := tags.Expand(d, m); len(lbTags) > 0 {
+		createReq.Tags = lbTags
 	}
 
 	lb, err := lbAPI.CreateLB(createReq, scw.WithContext(ctx))
@@ -357,7 +358,7 @@ func setLBState(ctx context.Context, d *schema.ResourceData, m any, lbAPI *lbSDK
 	_ = d.Set("region", region.String())
 	_ = d.Set("organization_id", lb.OrganizationID)
 	_ = d.Set("project_id", lb.ProjectID)
-	_ = d.Set("tags", lb.Tags)
+	tags.Set(d, m, lb.Tags)
 	// For now API return lowercase lb type. This should be fixed in a near future on the API side
 	_ = d.Set("type", strings.ToUpper(lb.Type))
 	_ = d.Set("ssl_compatibility_level", lb.SslCompatibilityLevel.String())
@@ -405,7 +406,7 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.D
 		Zone:                  zone,
 		LBID:                  ID,
 		Name:                  d.Get("name").(string),
-		Tags:                  types.ExpandStrings(d.Get("tags")),
+		Tags:                  tags.Expand(d, m),
 		Description:           d.Get("description").(string),
 		SslCompatibilityLevel: lbSDK.SSLCompatibilityLevel(*types.ExpandStringPtr(d.Get("ssl_compatibility_level"))),
 	}
@@ -12,11 +12,11 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_lb_ip", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_lb_ip", &resource.Sweeper{
 		Name: "scaleway_lb_ip",
 		F:    testSweepIP,
 	})
-	resource.AddTestSweepers("scaleway_lb", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_lb", &resource.Sweeper{
 		Name: "scaleway_lb",
 		F:    testSweepLB,
 	})
@@ -11,8 +11,8 @@ import (
 
 func waitForLB(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) (*lb.LB, error) {
 	retryInterval := DefaultWaitLBRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	loadBalancer, err := lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
@@ -27,8 +27,8 @@ func waitForLB(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID stri
 
 func waitForInstances(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) (*lb.LB, error) {
 	retryInterval := DefaultWaitLBRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	loadBalancer, err := lbAPI.WaitForLbInstances(&lb.ZonedAPIWaitForLBInstancesRequest{
@@ -43,8 +43,8 @@ func waitForInstances(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lb
 
 func waitForPrivateNetworks(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) ([]*lb.PrivateNetwork, error) {
 	retryInterval := DefaultWaitLBRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	privateNetworks, err := lbAPI.WaitForLBPN(&lb.ZonedAPIWaitForLBPNRequest{
@@ -59,8 +59,8 @@ func waitForPrivateNetworks(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zo
 
 func waitForCertificate(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, id string, timeout time.Duration) (*lb.Certificate, error) {
 	retryInterval := DefaultWaitLBRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	certificate, err := lbAPI.WaitForLBCertificate(&lb.ZonedAPIWaitForLBCertificateRequest{
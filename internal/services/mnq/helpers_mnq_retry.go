@@ -25,8 +25,8 @@ func RetryMNQNamespaceReadValue[T any](ctx context.Context, fn func() (T, error)
 	)
 
 	wait := transport.RetryOn403WaitTime
-	if transport.DefaultWaitRetryInterval != nil {
-		wait = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		wait = *interval
 	}
 
 	deadline := time.Now().Add(transport.IAMPropagationTimeout)
@@ -14,23 +14,23 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_mnq_nats_account", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mnq_nats_account", &resource.Sweeper{
 		Name: "scaleway_mnq_nats_account",
 		F:    testSweepNatsAccount,
 	})
-	resource.AddTestSweepers("scaleway_mnq_sns", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mnq_sns", &resource.Sweeper{
 		Name: "scaleway_mnq_sns",
 		F:    testSweepSNS,
 	})
-	resource.AddTestSweepers("scaleway_mnq_sns_credentials", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mnq_sns_credentials", &resource.Sweeper{
 		Name: "scaleway_mnq_sns_credentials",
 		F:    testSweepSNSCredentials,
 	})
-	resource.AddTestSweepers("scaleway_mnq_sqs", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mnq_sqs", &resource.Sweeper{
 		Name: "scaleway_mnq_sqs",
 		F:    testSweepSQS,
 	})
-	resource.AddTestSweepers("scaleway_mnq_sqs_credentials", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mnq_sqs_credentials", &resource.Sweeper{
 		Name: "scaleway_mnq_sqs_credentials",
 		F:    testSweepSQSCredentials,
 	})
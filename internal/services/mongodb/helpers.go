@@ -48,8 +48,8 @@ func NewAPIWithRegionAndID(m any, id string) (*mongodb.API, scw.Region, string,
 
 func waitForInstance(ctx context.Context, api *mongodb.API, region scw.Region, id string, timeout time.Duration) (*mongodb.Instance, error) {
 	retryInterval := defaultWaitMongodbInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return api.WaitForInstance(&mongodb.WaitForInstanceRequest{
@@ -62,8 +62,8 @@ func waitForInstance(ctx context.Context, api *mongodb.API, region scw.Region, i
 
 func waitForSnapshot(ctx context.Context, api *mongodb.API, region scw.Region, instanceID string, snapshotID string, timeout time.Duration) (*mongodb.Snapshot, error) {
 	retryInterval := defaultWaitMongodbInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return api.WaitForSnapshot(&mongodb.WaitForSnapshotRequest{
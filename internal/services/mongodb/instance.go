@@ -24,6 +24,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -65,6 +66,7 @@ func ResourceInstance() *schema.Resource {
 
 				return nil
 			},
+			tags.CustomizeDiff,
 		),
 		Identity: identity.DefaultRegional(),
 	}
@@ -266,6 +268,7 @@ func instanceSchema() map[string]*schema.Schema {
 			},
 			Description: "List of tags [\"tag1\", \"tag2\", ...] attached to a MongoDB instance",
 		},
+		"tags_all": tags.AllSchema(),
 		"snapshot_schedule_frequency_hours": {
 			Type:        schema.TypeInt,
 			Optional:    true,
@@ -375,9 +378,8 @@ func ResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m any)
 
 		createReq.Volume = volumeRequestDetails
 
-		tags, tagsExist := d.GetOk("tags")
-		if tagsExist {
-			createReq.Tags = types.ExpandStrings(tags)
+		if instanceTags := tags.Expand(d, m); len(instanceTags) > 0 {
+			createReq.Tags = instanceTags
 		}
 
 		var eps []*mongodb.EndpointSpec
@@ -445,7 +447,7 @@ func setInstanceState(ctx context.Context, d *schema.ResourceData, m any, mongod
 	_ = d.Set("node_number", int(instance.NodeAmount))
 	_ = d.Set("node_type", instance.NodeType)
 	_ = d.Set("project_id", instance.ProjectID)
-	_ = d.Set("tags", instance.Tags)
+	tags.Set(d, m, instance.Tags)
 	_ = d.Set("created_at", instance.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("region", instance.Region.String())
 
@@ -604,7 +606,7 @@ func handleVolumeSizeUpgrade(ctx context.Context, mongodbAPI *mongodb.API, regio
 	return nil
 }
 
-func handleInstanceUpdate(ctx context.Context, mongodbAPI *mongodb.API, region scw.Region, id string, d *schema.ResourceData) diag.Diagnostics {
+func handleInstanceUpdate(ctx context.Context, mongodbAPI *mongodb.API, region scw.Region, id string, d *schema.ResourceData, m any) diag.Diagnostics {
 	shouldUpdateInstance := false
 	req := &mongodb.UpdateInstanceRequest{
 		Region:     region,
@@ -616,11 +618,9 @@ func handleInstanceUpdate(ctx context.Context, mongodbAPI *mongodb.API, region s
 		shouldUpdateInstance = true
 	}
 
-	if d.HasChange("tags") {
-		if tags := types.ExpandUpdatedStringsPtr(d.Get("tags")); tags != nil {
-			req.Tags = tags
-			shouldUpdateInstance = true
-		}
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
+		shouldUpdateInstance = true
 	}
 
 	if updateSnapshotScheduleFields(d, req) {
@@ -655,7 +655,7 @@ func ResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m any)
 	////////////////////
 	// Update instance
 	////////////////////
-	if diag := handleInstanceUpdate(ctx, mongodbAPI, region, ID, d); diag != nil {
+	if diag := handleInstanceUpdate(ctx, mongodbAPI, region, ID, d, m); diag != nil {
 		return diag
 	}
 
@@ -217,7 +217,7 @@ func (a *InstanceSnapshotAction) Invoke(ctx context.Context, req action.InvokeRe
 			return
 		}
 
-		_, err = waitForSnapshot(ctx, a.mongodbAPI, waitRegion, instanceID, snapshot.ID, defaultMongodbSnapshotTimeout)
+		_, err = waitForSnapshot(ctx, a.mongodbAPI, waitRegion, instanceID, snapshot.ID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaultMongodbSnapshotTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error waiting for MongoDB snapshot completion",
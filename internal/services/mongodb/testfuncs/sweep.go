@@ -9,11 +9,11 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_mongodb_instance", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mongodb_instance", &resource.Sweeper{
 		Name: "scaleway_mongodb_instance",
 		F:    testSweepMongodbInstance,
 	})
-	resource.AddTestSweepers("scaleway_mongodb_instance_snapshot", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mongodb_instance_snapshot", &resource.Sweeper{
 		Name: "scaleway_mongodb_instance_snapshot",
 		F:    testSweepMongodbInstanceSnapshot,
 	})
@@ -12,6 +12,7 @@ import (
 	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,6 +20,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 )
 
 func ResourceBucket() *schema.Resource {
@@ -33,9 +35,12 @@ func ResourceBucket() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Identity:      identity.DefaultRegional(),
-		SchemaFunc:    bucketSchema,
-		CustomizeDiff: validateBucket,
+		Identity:   identity.DefaultRegional(),
+		SchemaFunc: bucketSchema,
+		CustomizeDiff: customdiff.All(
+			validateBucket,
+			tags.CustomizeDiffMap,
+		),
 	}
 }
 
@@ -75,6 +80,7 @@ func bucketSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "The tags associated with this bucket",
 		},
+		"tags_all": tags.AllMapSchema(),
 		"endpoint": {
 			Type:        schema.TypeString,
 			Description: "Endpoint of the bucket",
@@ -90,39 +96,7 @@ func bucketSchema() map[string]*schema.Schema {
 			Description: "List of CORS rules",
 			Optional:    true,
 			Computed:    true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"allowed_headers": {
-						Type:        schema.TypeList,
-						Description: "Allowed headers in the CORS rule",
-						Optional:    true,
-						Elem:        &schema.Schema{Type: schema.TypeString},
-					},
-					"allowed_methods": {
-						Type:        schema.TypeList,
-						Description: "Allowed HTTP methods allowed in the CORS rule",
-						Required:    true,
-						Elem:        &schema.Schema{Type: schema.TypeString},
-					},
-					"allowed_origins": {
-						Type:        schema.TypeList,
-						Description: "Allowed origins allowed in the CORS rule",
-						Required:    true,
-						Elem:        &schema.Schema{Type: schema.TypeString},
-					},
-					"expose_headers": {
-						Type:        schema.TypeList,
-						Description: "Exposed headers in the CORS rule",
-						Optional:    true,
-						Elem:        &schema.Schema{Type: schema.TypeString},
-					},
-					"max_age_seconds": {
-						Type:        schema.TypeInt,
-						Description: "Max age of the CORS rule",
-						Optional:    true,
-					},
-				},
-			},
+			Elem:        corsRuleSchema(),
 		},
 		"force_destroy": {
 			Type:        schema.TypeBool,
@@ -133,155 +107,9 @@ func bucketSchema() map[string]*schema.Schema {
 		"lifecycle_rule": {
 			Type:        schema.TypeList,
 			Optional:    true,
+			Computed:    true,
 			Description: "Lifecycle configuration is a set of rules that define actions that Scaleway Object Storage applies to a group of objects",
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"id": {
-						Type:         schema.TypeString,
-						Optional:     true,
-						Computed:     true,
-						ValidateFunc: validation.StringLenBetween(0, 255),
-						Description:  "Unique identifier for the rule",
-					},
-					"prefix": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: "The prefix identifying one or more objects to which the rule applies",
-					},
-					"tags": {
-						Type: schema.TypeMap,
-						Elem: &schema.Schema{
-							Type: schema.TypeString,
-						},
-						Optional:    true,
-						Description: "The tags associated with the bucket lifecycle",
-					},
-					"object_size_greater_than": {
-						Type:        schema.TypeInt,
-						Optional:    true,
-						Description: "Minimum object size (in bytes) to which the rule applies",
-					},
-					"object_size_less_than": {
-						Type:        schema.TypeInt,
-						Optional:    true,
-						Description: "Maximum object size (in bytes) to which the rule applies",
-					},
-					"enabled": {
-						Type:        schema.TypeBool,
-						Required:    true,
-						Description: "Specifies if the configuration rule is Enabled or Disabled",
-					},
-					"abort_incomplete_multipart_upload_days": {
-						Type:        schema.TypeInt,
-						Optional:    true,
-						Description: "Specifies the number of days after initiating a multipart upload when the multipart upload must be completed",
-					},
-					"expiration": {
-						Type:        schema.TypeList,
-						Optional:    true,
-						MaxItems:    1,
-						Description: "Specifies a period in the object's expire",
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"date": {
-									Type:         schema.TypeString,
-									Optional:     true,
-									ValidateFunc: validBucketLifecycleTimestamp,
-									Description:  "Specifies the date the object is to be moved or deleted. The date value must be in RFC3339 full-date format e.g. `2023-08-22`",
-								},
-								"days": {
-									Type:         schema.TypeInt,
-									Optional:     true,
-									ValidateFunc: validation.IntAtLeast(1),
-									Description:  "Specifies the number of days after object creation when the specific rule action takes effect",
-								},
-								"expired_object_delete_marker": {
-									Type:        schema.TypeBool,
-									Optional:    true,
-									Description: "Specifies whether Scaleway Object will remove a delete marker with no noncurrent versions. If set to `true`, the delete marker will be expired; if set to `false` the policy takes no action",
-								},
-							},
-						},
-					},
-					"transition": {
-						Type:        schema.TypeSet,
-						Optional:    true,
-						Set:         transitionHash,
-						Description: "Define when objects transition to another storage class",
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"date": {
-									Type:         schema.TypeString,
-									Optional:     true,
-									ValidateFunc: validBucketLifecycleTimestamp,
-									Description:  "Specifies the date objects are transitioned to the specified storage class. The date value must be in RFC3339 full-date format e.g. `2023-08-22`",
-								},
-								"days": {
-									Type:         schema.TypeInt,
-									Optional:     true,
-									ValidateFunc: validation.IntAtLeast(0),
-									Description:  "Specifies the number of days after object creation when the specific rule action takes effect",
-								},
-								"storage_class": {
-									Type:         schema.TypeString,
-									Required:     true,
-									ValidateFunc: validation.StringInSlice(TransitionSCWStorageClassValues(), false),
-									Description:  "Specifies the Scaleway Object Storage class to which you want the object to transition",
-								},
-							},
-						},
-					},
-					"noncurrent_version_expiration": {
-						Type:        schema.TypeList,
-						MaxItems:    1,
-						Optional:    true,
-						Description: "Configuration block that specifies when noncurrent object versions expire",
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"newer_noncurrent_versions": {
-									Type:         schema.TypeInt,
-									Optional:     true,
-									ValidateFunc: validation.IntBetween(1, 100),
-									Description:  "Number of noncurrent versions Scaleway Object Storage will retain. Must be a non-zero positive integer",
-								},
-								"noncurrent_days": {
-									Type:         schema.TypeInt,
-									Optional:     true,
-									ValidateFunc: validation.IntAtLeast(1),
-									Description:  "Number of days an object is noncurrent before Scaleway Object Storage can perform the associated action. Must be a positive integer",
-								},
-							},
-						},
-					},
-					"noncurrent_version_transition": {
-						Type:        schema.TypeSet,
-						Optional:    true,
-						Description: "Set of configuration blocks that specify the transition rule for the lifecycle rule that describes when noncurrent objects transition to a specific storage class",
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"newer_noncurrent_versions": {
-									Type:         schema.TypeInt,
-									Optional:     true,
-									ValidateFunc: validation.IntBetween(1, 100),
-									Description:  "Number of noncurrent versions Scaleway Object Storage will retain. Must be a non-zero positive integer",
-								},
-								"noncurrent_days": {
-									Type:         schema.TypeInt,
-									Required:     true,
-									ValidateFunc: validation.IntAtLeast(1),
-									Description:  "Number of days an object is noncurrent before Scaleway Object Storage can perform the associated action",
-								},
-								"storage_class": {
-									Type:         schema.TypeString,
-									Required:     true,
-									ValidateFunc: validation.StringInSlice(TransitionSCWStorageClassValues(), false),
-									Description:  "Specifies the Scaleway Object Storage class to which you want the object to transition",
-								},
-							},
-						},
-					},
-				},
-			},
+			Elem:        lifecycleRuleSchema(),
 		},
 		"region":     regional.Schema(),
 		"project_id": account.ProjectIDSchema(),
@@ -305,6 +133,196 @@ func bucketSchema() map[string]*schema.Schema {
 	}
 }
 
+// corsRuleSchema is the schema of the CORS rules of a bucket, shared with scaleway_object_bucket_cors_configuration.
+func corsRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"allowed_headers": {
+				Type:        schema.TypeList,
+				Description: "Allowed headers in the CORS rule",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_methods": {
+				Type:        schema.TypeList,
+				Description: "Allowed HTTP methods allowed in the CORS rule",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_origins": {
+				Type:        schema.TypeList,
+				Description: "Allowed origins allowed in the CORS rule",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expose_headers": {
+				Type:        schema.TypeList,
+				Description: "Exposed headers in the CORS rule",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"max_age_seconds": {
+				Type:        schema.TypeInt,
+				Description: "Max age of the CORS rule",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// lifecycleRuleSchema is the schema of the lifecycle rules of a bucket, shared with
+// scaleway_object_bucket_lifecycle_configuration.
+func lifecycleRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  "Unique identifier for the rule",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The prefix identifying one or more objects to which the rule applies",
+			},
+			"tags": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "The tags associated with the bucket lifecycle",
+			},
+			"object_size_greater_than": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Minimum object size (in bytes) to which the rule applies",
+			},
+			"object_size_less_than": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum object size (in bytes) to which the rule applies",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies if the configuration rule is Enabled or Disabled",
+			},
+			"abort_incomplete_multipart_upload_days": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Specifies the number of days after initiating a multipart upload when the multipart upload must be completed",
+			},
+			"expiration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Specifies a period in the object's expire",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validBucketLifecycleTimestamp,
+							Description:  "Specifies the date the object is to be moved or deleted. The date value must be in RFC3339 full-date format e.g. `2023-08-22`",
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Specifies the number of days after object creation when the specific rule action takes effect",
+						},
+						"expired_object_delete_marker": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Specifies whether Scaleway Object will remove a delete marker with no noncurrent versions. If set to `true`, the delete marker will be expired; if set to `false` the policy takes no action",
+						},
+					},
+				},
+			},
+			"transition": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         transitionHash,
+				Description: "Define when objects transition to another storage class",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validBucketLifecycleTimestamp,
+							Description:  "Specifies the date objects are transitioned to the specified storage class. The date value must be in RFC3339 full-date format e.g. `2023-08-22`",
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Specifies the number of days after object creation when the specific rule action takes effect",
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(TransitionSCWStorageClassValues(), false),
+							Description:  "Specifies the Scaleway Object Storage class to which you want the object to transition",
+						},
+					},
+				},
+			},
+			"noncurrent_version_expiration": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Configuration block that specifies when noncurrent object versions expire",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"newer_noncurrent_versions": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+							Description:  "Number of noncurrent versions Scaleway Object Storage will retain. Must be a non-zero positive integer",
+						},
+						"noncurrent_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of days an object is noncurrent before Scaleway Object Storage can perform the associated action. Must be a positive integer",
+						},
+					},
+				},
+			},
+			"noncurrent_version_transition": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of configuration blocks that specify the transition rule for the lifecycle rule that describes when noncurrent objects transition to a specific storage class",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"newer_noncurrent_versions": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+							Description:  "Number of noncurrent versions Scaleway Object Storage will retain. Must be a non-zero positive integer",
+						},
+						"noncurrent_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of days an object is noncurrent before Scaleway Object Storage can perform the associated action",
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(TransitionSCWStorageClassValues(), false),
+							Description:  "Specifies the Scaleway Object Storage class to which you want the object to transition",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 /*
 *** CREATE
  */
@@ -344,7 +362,7 @@ func resourceObjectBucketCreate(ctx context.Context, d *schema.ResourceData, m a
 		return diag.FromErr(err)
 	}
 
-	tagsSet := ExpandObjectBucketTags(d.Get("tags"))
+	tagsSet := ExpandObjectBucketTags(tags.ExpandMap(d, m))
 
 	if len(tagsSet) > 0 {
 		_, err = s3Client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
@@ -393,8 +411,8 @@ func resourceObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, m a
 		}
 	}
 
-	if d.HasChange("tags") {
-		tagsSet := ExpandObjectBucketTags(d.Get("tags"))
+	if tags.HasChange(d) {
+		tagsSet := ExpandObjectBucketTags(tags.ExpandMap(d, m))
 
 		if len(tagsSet) > 0 {
 			_, err = s3Client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
@@ -420,7 +438,8 @@ func resourceObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, m a
 		}
 	}
 
-	if d.HasChange("lifecycle_rule") {
+	// The lifecycle rules may be managed by scaleway_object_bucket_lifecycle_configuration when no block is set
+	if d.HasChange("lifecycle_rule") && isLifecycleRuleConfigured(d) {
 		if err := resourceBucketLifecycleUpdate(ctx, s3Client, d); err != nil {
 			return diag.FromErr(err)
 		}
@@ -429,7 +448,21 @@ func resourceObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, m a
 	return resourceObjectBucketRead(ctx, d, m)
 }
 
-//gocyclo:ignore
+// isLifecycleRuleConfigured returns true if a lifecycle_rule block is set in the configuration of the bucket.
+func isLifecycleRuleConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.Type().HasAttribute("lifecycle_rule") {
+		return false
+	}
+
+	rules := rawConfig.GetAttr("lifecycle_rule")
+	if rules.IsNull() {
+		return false
+	}
+
+	return !rules.IsKnown() || rules.LengthInt() > 0
+}
+
 func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *schema.ResourceData) error {
 	bucket := d.Get("name").(string)
 
@@ -448,9 +481,30 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 		return nil
 	}
 
+	rules, err := expandBucketLifecycleRules(lifecycleRules)
+	if err != nil {
+		return err
+	}
+
+	i := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3Types.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	if _, err := conn.PutBucketLifecycleConfiguration(ctx, i); err != nil {
+		return fmt.Errorf("error applying lifecycle configuration to bucket %s: %w", bucket, err)
+	}
+
+	return nil
+}
+
+//gocyclo:ignore
+func expandBucketLifecycleRules(lifecycleRules []any) ([]s3Types.LifecycleRule, error) {
 	rules := make([]s3Types.LifecycleRule, 0, len(lifecycleRules))
 
-	for i, lifecycleRule := range lifecycleRules {
+	for _, lifecycleRule := range lifecycleRules {
 		r := lifecycleRule.(map[string]any)
 
 		rule := s3Types.LifecycleRule{}
@@ -463,7 +517,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 		}
 
 		// Filter
-		rule.Filter = extractFilter(r, d)
+		rule.Filter = extractFilter(r)
 
 		// Enabled
 		if val, ok := r["enabled"].(bool); ok && val {
@@ -481,7 +535,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 		}
 
 		// Expiration
-		expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.expiration", i)).([]any)
+		expiration := r["expiration"].([]any)
 		if len(expiration) > 0 && expiration[0] != nil {
 			e := expiration[0].(map[string]any)
 			i := &s3Types.LifecycleExpiration{}
@@ -494,7 +548,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 			if val, ok := e["date"].(string); ok && val != "" {
 				date, err := time.Parse("2006-01-02", val)
 				if err != nil {
-					return fmt.Errorf("error while parsing expiration date '%s': %w", val, err)
+					return nil, fmt.Errorf("error while parsing expiration date '%s': %w", val, err)
 				}
 
 				i.Date = aws.Time(date)
@@ -508,7 +562,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 		}
 
 		// Transitions
-		transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.transition", i)).(*schema.Set).List()
+		transitions := r["transition"].(*schema.Set).List()
 		if len(transitions) > 0 {
 			rule.Transitions = []s3Types.Transition{}
 
@@ -528,7 +582,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 				if val, ok := transition["date"].(string); ok && val != "" {
 					date, err := time.Parse(time.RFC3339, val)
 					if err != nil {
-						return fmt.Errorf("error while parsing transition date '%s': %w", date, err)
+						return nil, fmt.Errorf("error while parsing transition date '%s': %w", date, err)
 					}
 
 					i.Date = aws.Time(date)
@@ -539,7 +593,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 		}
 
 		// NoncurrentVersionExpiration
-		noncurrentVersionExpiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_expiration", i)).([]any)
+		noncurrentVersionExpiration := r["noncurrent_version_expiration"].([]any)
 		if len(noncurrentVersionExpiration) > 0 && noncurrentVersionExpiration[0] != nil {
 			expiration := noncurrentVersionExpiration[0].(map[string]any)
 			i := &s3Types.NoncurrentVersionExpiration{}
@@ -558,7 +612,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 		}
 
 		// NoncurrentVersionTransitions
-		noncurrentVersionTransitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_transition", i)).(*schema.Set).List()
+		noncurrentVersionTransitions := r["noncurrent_version_transition"].(*schema.Set).List()
 		if len(noncurrentVersionTransitions) > 0 {
 			rule.NoncurrentVersionTransitions = []s3Types.NoncurrentVersionTransition{}
 
@@ -587,21 +641,10 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 		rules = append(rules, rule)
 	}
 
-	i := &s3.PutBucketLifecycleConfigurationInput{
-		Bucket: aws.String(bucket),
-		LifecycleConfiguration: &s3Types.BucketLifecycleConfiguration{
-			Rules: rules,
-		},
-	}
-
-	if _, err := conn.PutBucketLifecycleConfiguration(ctx, i); err != nil {
-		return fmt.Errorf("error applying lifecycle configuration to bucket %s: %w", bucket, err)
-	}
-
-	return nil
+	return rules, nil
 }
 
-func extractFilter(r map[string]any, resourceData *schema.ResourceData) *s3Types.LifecycleRuleFilter {
+func extractFilter(r map[string]any) *s3Types.LifecycleRuleFilter {
 	prefix := r["prefix"].(string)
 	tags := ExpandObjectBucketTags(r["tags"])
 	objectSizeGreaterThan := r["object_size_greater_than"].(int)
@@ -746,7 +789,7 @@ func resourceObjectBucketRead(ctx context.Context, d *schema.ResourceData, m any
 		return diag.FromErr(err)
 	}
 
-	diags := setBucketState(ctx, d, bucketName, region, s3Client)
+	diags := setBucketState(ctx, d, m, bucketName, region, s3Client)
 
 	err = identity.SetRegionalIdentity(d, region, bucketName)
 	if err != nil {
@@ -756,7 +799,7 @@ func resourceObjectBucketRead(ctx context.Context, d *schema.ResourceData, m any
 	return diags
 }
 
-func setBucketState(ctx context.Context, d *schema.ResourceData, bucketName string, region scw.Region, s3Client *s3.Client) diag.Diagnostics {
+func setBucketState(ctx context.Context, d *schema.ResourceData, m any, bucketName string, region scw.Region, s3Client *s3.Client) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	_ = d.Set("name", bucketName)
@@ -821,7 +864,7 @@ func setBucketState(ctx context.Context, d *schema.ResourceData, bucketName stri
 		tagsSet = tagsResponse.TagSet
 	}
 
-	_ = d.Set("tags", flattenObjectBucketTags(tagsSet))
+	tags.SetMap(d, m, flattenObjectBucketTags(tagsSet))
 
 	_ = d.Set("endpoint", objectBucketEndpointURL(bucketName, region))
 	_ = d.Set("api_endpoint", objectBucketAPIEndpointURL(region))
@@ -863,7 +906,7 @@ func setBucketState(ctx context.Context, d *schema.ResourceData, bucketName stri
 		}
 	}
 
-	lifecycleRules := resourceBucketLifecycleRulesRead(lifecycle, d)
+	lifecycleRules := resourceBucketLifecycleRulesRead(lifecycle, bucketName)
 
 	if err := d.Set("lifecycle_rule", lifecycleRules); err != nil {
 		return append(diags, diag.Diagnostic{
@@ -876,7 +919,7 @@ func setBucketState(ctx context.Context, d *schema.ResourceData, bucketName stri
 }
 
 func resourceBucketLifecycleRulesRead(
-	lifecycle *s3.GetBucketLifecycleConfigurationOutput, d *schema.ResourceData,
+	lifecycle *s3.GetBucketLifecycleConfigurationOutput, bucketName string,
 ) []map[string]any {
 	lifecycleRules := make([]map[string]any, 0)
 
@@ -884,7 +927,7 @@ func resourceBucketLifecycleRulesRead(
 		lifecycleRules = make([]map[string]any, 0, len(lifecycle.Rules))
 
 		for _, lifecycleRule := range lifecycle.Rules {
-			log.Printf("[DEBUG] SCW bucket: %s, read lifecycle rule: %v", d.Id(), lifecycleRule)
+			log.Printf("[DEBUG] SCW bucket: %s, read lifecycle rule: %v", bucketName, lifecycleRule)
 
 			rule := make(map[string]any)
 
@@ -1131,24 +1174,28 @@ func validateBucket(ctx context.Context, diff *schema.ResourceDiff, meta any) er
 		}
 	}
 
-	// Lifecycle rules
-	ruleCount := diff.Get("lifecycle_rule.#").(int)
+	return validateLifecycleRules(diff, "lifecycle_rule")
+}
+
+// validateLifecycleRules validates the lifecycle rules of the given attribute.
+func validateLifecycleRules(diff *schema.ResourceDiff, key string) error {
+	ruleCount := diff.Get(key + ".#").(int)
 
 	for i := range ruleCount {
 		// Expiration
-		if _, ok := diff.GetOk(fmt.Sprintf("lifecycle_rule.%d.expiration", i)); ok {
-			if err := validateLifecycleExpiration(diff, i); err != nil {
+		if _, ok := diff.GetOk(fmt.Sprintf("%s.%d.expiration", key, i)); ok {
+			if err := validateLifecycleExpiration(diff, key, i); err != nil {
 				return err
 			}
 		}
 
 		// Transition
-		if v, ok := diff.GetOk(fmt.Sprintf("lifecycle_rule.%d.transition", i)); ok {
+		if v, ok := diff.GetOk(fmt.Sprintf("%s.%d.transition", key, i)); ok {
 			// Special treatment for "TypeSet" (can't be simply indexed)
 			transitionSet := v.(*schema.Set)
 			for _, transitionRaw := range transitionSet.List() {
 				transition := transitionRaw.(map[string]any)
-				if err := validateLifecycleTransition(transition); err != nil {
+				if err := validateLifecycleTransition(key, transition); err != nil {
 					return err
 				}
 			}
@@ -1158,8 +1205,8 @@ func validateBucket(ctx context.Context, diff *schema.ResourceDiff, meta any) er
 	return nil
 }
 
-func validateLifecycleExpiration(diff *schema.ResourceDiff, i int) error {
-	prefix := fmt.Sprintf("lifecycle_rule.%d.expiration.0.", i)
+func validateLifecycleExpiration(diff *schema.ResourceDiff, key string, i int) error {
+	prefix := fmt.Sprintf("%s.%d.expiration.0.", key, i)
 
 	_, daysOk := diff.GetOk(prefix + "days")
 	_, dateOk := diff.GetOk(prefix + "date")
@@ -1180,17 +1227,17 @@ func validateLifecycleExpiration(diff *schema.ResourceDiff, i int) error {
 	}
 
 	if count == 0 {
-		return fmt.Errorf("lifecycle_rule.%d.expiration: one (only one) of 'days', 'date', 'expired_object_delete_marker' should be defined", i)
+		return fmt.Errorf("%s.%d.expiration: one (only one) of 'days', 'date', 'expired_object_delete_marker' should be defined", key, i)
 	}
 
 	if count > 1 {
-		return fmt.Errorf("lifecycle_rule.%d.expiration: 'days', 'date', 'expired_object_delete_marker' are mutually exclusive", i)
+		return fmt.Errorf("%s.%d.expiration: 'days', 'date', 'expired_object_delete_marker' are mutually exclusive", key, i)
 	}
 
 	return nil
 }
 
-func validateLifecycleTransition(transition map[string]any) error {
+func validateLifecycleTransition(key string, transition map[string]any) error {
 	// At this point, the "days" and "date" fields are initialized.
 	// Either with the filled values, or with default zero values, which makes
 	// the "ok" value obsolete.
@@ -1217,7 +1264,7 @@ func validateLifecycleTransition(transition map[string]any) error {
 	}
 
 	if count > 1 {
-		return errors.New("lifecycle_rule.transition: 'days', 'date' are mutually exclusive")
+		return fmt.Errorf("%s.transition: 'days', 'date' are mutually exclusive", key)
 	}
 
 	return nil
@@ -0,0 +1,176 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+)
+
+func ResourceBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketCorsConfigurationCreate,
+		ReadContext:   resourceBucketCorsConfigurationRead,
+		UpdateContext: resourceBucketCorsConfigurationUpdate,
+		DeleteContext: resourceBucketCorsConfigurationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaFunc: bucketCorsConfigurationSchema,
+		Identity:   identity.DefaultRegional(),
+	}
+}
+
+func bucketCorsConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringLenBetween(1, 63),
+			Description:      "The bucket's name or regional ID.",
+			DiffSuppressFunc: dsf.Locality,
+		},
+		"cors_rule": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "List of CORS rules",
+			Elem:        corsRuleSchema(),
+		},
+		"region":     regional.Schema(),
+		"project_id": account.ProjectIDSchema(),
+	}
+}
+
+func resourceBucketCorsConfigurationCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, region, err := s3ClientWithRegion(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionalID := regional.ExpandID(d.Get("bucket"))
+	bucket := regionalID.ID
+	bucketRegion := regionalID.Region
+
+	if bucketRegion != "" && bucketRegion != region {
+		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		region = bucketRegion
+	}
+
+	_, err = conn.PutBucketCors(ctx, &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3Types.CORSConfiguration{
+			CORSRules: expandBucketCORS(ctx, d.Get("cors_rule").([]any), bucket),
+		},
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) CORS configuration: %w", bucket, err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBucketCorsConfigurationRead(ctx, d, m)
+}
+
+func resourceBucketCorsConfigurationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn, region, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cors, err := conn.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucket),
+	})
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket, ErrCodeNoSuchCORSConfiguration) {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket CORS Configuration (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading object bucket CORS configuration (%s): %w", d.Id(), err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("bucket", regional.NewIDString(region, bucket))
+	_ = d.Set("region", region)
+
+	if err := d.Set("cors_rule", flattenBucketCORS(cors)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags, ok := setProjectIDFromACL(ctx, conn, d, bucket, diags)
+	if !ok {
+		return diags
+	}
+
+	return diags
+}
+
+func resourceBucketCorsConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.PutBucketCors(ctx, &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3Types.CORSConfiguration{
+			CORSRules: expandBucketCORS(ctx, d.Get("cors_rule").([]any), bucket),
+		},
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating object bucket CORS configuration (%s): %w", d.Id(), err))
+	}
+
+	return resourceBucketCorsConfigurationRead(ctx, d, m)
+}
+
+func resourceBucketCorsConfigurationDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.DeleteBucketCors(ctx, &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucket),
+	})
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket, ErrCodeNoSuchCORSConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting object bucket CORS configuration (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
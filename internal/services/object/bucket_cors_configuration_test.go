@@ -0,0 +1,86 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+)
+
+func TestAccObjectBucketCorsConfiguration_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	bucketName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-cors-config")
+	resourceName := "scaleway_object_bucket_cors_configuration.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             objectchecks.IsBucketDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name   = %[1]q
+						region = %[2]q
+					}
+
+					resource "scaleway_object_bucket_cors_configuration" "main" {
+						bucket = scaleway_object_bucket.main.id
+
+						cors_rule {
+							allowed_headers = ["*"]
+							allowed_methods = ["PUT", "POST"]
+							allowed_origins = ["https://www.example.com"]
+							expose_headers  = ["ETag"]
+							max_age_seconds = 3000
+						}
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "scaleway_object_bucket.main", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "scaleway_object_bucket.main", "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_origins.0", "https://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name   = %[1]q
+						region = %[2]q
+					}
+
+					resource "scaleway_object_bucket_cors_configuration" "main" {
+						bucket = scaleway_object_bucket.main.id
+
+						cors_rule {
+							allowed_methods = ["GET"]
+							allowed_origins = ["*"]
+						}
+
+						cors_rule {
+							allowed_methods = ["PUT"]
+							allowed_origins = ["https://www.example.com"]
+						}
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.0", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.1.allowed_methods.0", "PUT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
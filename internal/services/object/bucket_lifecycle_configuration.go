@@ -0,0 +1,183 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+)
+
+func ResourceBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketLifecycleConfigurationCreate,
+		ReadContext:   resourceBucketLifecycleConfigurationRead,
+		UpdateContext: resourceBucketLifecycleConfigurationUpdate,
+		DeleteContext: resourceBucketLifecycleConfigurationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+			return validateLifecycleRules(diff, "rule")
+		},
+		SchemaFunc: bucketLifecycleConfigurationSchema,
+		Identity:   identity.DefaultRegional(),
+	}
+}
+
+func bucketLifecycleConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringLenBetween(1, 63),
+			Description:      "The bucket's name or regional ID.",
+			DiffSuppressFunc: dsf.Locality,
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "Lifecycle rules applied to the objects of the bucket",
+			Elem:        lifecycleRuleSchema(),
+		},
+		"region":     regional.Schema(),
+		"project_id": account.ProjectIDSchema(),
+	}
+}
+
+func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, region, err := s3ClientWithRegion(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionalID := regional.ExpandID(d.Get("bucket"))
+	bucket := regionalID.ID
+	bucketRegion := regionalID.Region
+
+	if bucketRegion != "" && bucketRegion != region {
+		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		region = bucketRegion
+	}
+
+	if err := putBucketLifecycleConfiguration(ctx, conn, bucket, d.Get("rule").([]any)); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) lifecycle configuration: %w", bucket, err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBucketLifecycleConfigurationRead(ctx, d, m)
+}
+
+func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn, region, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lifecycle, err := conn.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading object bucket lifecycle configuration (%s): %w", d.Id(), err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("bucket", regional.NewIDString(region, bucket))
+	_ = d.Set("region", region)
+
+	if err := d.Set("rule", resourceBucketLifecycleRulesRead(lifecycle, bucket)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags, ok := setProjectIDFromACL(ctx, conn, d, bucket, diags)
+	if !ok {
+		return diags
+	}
+
+	return diags
+}
+
+func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := putBucketLifecycleConfiguration(ctx, conn, bucket, d.Get("rule").([]any)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating object bucket lifecycle configuration (%s): %w", d.Id(), err))
+	}
+
+	return resourceBucketLifecycleConfigurationRead(ctx, d, m)
+}
+
+func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(bucket),
+	})
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting object bucket lifecycle configuration (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func putBucketLifecycleConfiguration(ctx context.Context, conn *s3.Client, bucket string, rawRules []any) error {
+	rules, err := expandBucketLifecycleRules(rawRules)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3Types.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	})
+
+	return err
+}
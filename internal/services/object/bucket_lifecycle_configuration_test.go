@@ -0,0 +1,110 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+)
+
+func TestAccObjectBucketLifecycleConfiguration_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	bucketName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-lifecycle-config")
+	resourceName := "scaleway_object_bucket_lifecycle_configuration.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             objectchecks.IsBucketDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name   = %[1]q
+						region = %[2]q
+					}
+
+					resource "scaleway_object_bucket_lifecycle_configuration" "main" {
+						bucket = scaleway_object_bucket.main.id
+
+						rule {
+							id      = "expire-logs"
+							prefix  = "logs/"
+							enabled = true
+
+							expiration {
+								days = 30
+							}
+						}
+
+						rule {
+							id      = "archive"
+							enabled = true
+
+							tags = {
+								archive = "true"
+							}
+
+							transition {
+								days          = 10
+								storage_class = "GLACIER"
+							}
+						}
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectBucketLifecycleConfigurationExists(tt, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "scaleway_object_bucket.main", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "scaleway_object_bucket.main", "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "expire-logs"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.0.days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.id", "archive"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.tags.archive", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.1.transition.*", map[string]string{
+						"days":          "10",
+						"storage_class": "GLACIER",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name   = %[1]q
+						region = %[2]q
+					}
+
+					resource "scaleway_object_bucket_lifecycle_configuration" "main" {
+						bucket = scaleway_object_bucket.main.id
+
+						rule {
+							id      = "expire-logs"
+							prefix  = "logs/"
+							enabled = false
+
+							expiration {
+								days = 60
+							}
+						}
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectBucketLifecycleConfigurationExists(tt, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.0.days", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
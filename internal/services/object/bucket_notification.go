@@ -0,0 +1,308 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+func ResourceBucketNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketNotificationCreate,
+		ReadContext:   resourceBucketNotificationRead,
+		UpdateContext: resourceBucketNotificationUpdate,
+		DeleteContext: resourceBucketNotificationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaFunc: bucketNotificationSchema,
+		Identity:   identity.DefaultRegional(),
+	}
+}
+
+func bucketNotificationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringLenBetween(1, 63),
+			Description:      "The bucket's name or regional ID.",
+			DiffSuppressFunc: dsf.Locality,
+		},
+		"topic": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "Notifications sent to a SNS topic",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringLenBetween(0, 255),
+						Description:  "Unique identifier for the notification",
+					},
+					"topic_arn": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "ARN of the SNS topic the notifications are sent to",
+					},
+					"events": {
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Description: "Events which trigger a notification, like `s3:ObjectCreated:*`",
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateDiagFunc: verify.ValidateEnum[s3Types.Event](),
+						},
+					},
+					"filter_prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Only send notifications for the objects whose key starts with this prefix",
+					},
+					"filter_suffix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Only send notifications for the objects whose key ends with this suffix",
+					},
+				},
+			},
+		},
+		"region":     regional.Schema(),
+		"project_id": account.ProjectIDSchema(),
+	}
+}
+
+func resourceBucketNotificationCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, region, err := s3ClientWithRegion(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionalID := regional.ExpandID(d.Get("bucket"))
+	bucket := regionalID.ID
+	bucketRegion := regionalID.Region
+
+	if bucketRegion != "" && bucketRegion != region {
+		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		region = bucketRegion
+	}
+
+	_, err = conn.PutBucketNotificationConfiguration(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+		NotificationConfiguration: &s3Types.NotificationConfiguration{
+			TopicConfigurations: expandBucketNotificationTopics(d.Get("topic").([]any)),
+		},
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) notification: %w", bucket, err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBucketNotificationRead(ctx, d, m)
+}
+
+func resourceBucketNotificationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn, region, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notification, err := conn.GetBucketNotificationConfiguration(ctx, &s3.GetBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket) {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Notification (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading object bucket notification (%s): %w", d.Id(), err))
+	}
+
+	// Removing the notifications of a bucket leaves an empty configuration
+	if !d.IsNewResource() && len(notification.TopicConfigurations) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Notification (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+
+		return diags
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("bucket", regional.NewIDString(region, bucket))
+	_ = d.Set("region", region)
+
+	if err := d.Set("topic", flattenBucketNotificationTopics(notification.TopicConfigurations)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags, ok := setProjectIDFromACL(ctx, conn, d, bucket, diags)
+	if !ok {
+		return diags
+	}
+
+	return diags
+}
+
+func resourceBucketNotificationUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.PutBucketNotificationConfiguration(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+		NotificationConfiguration: &s3Types.NotificationConfiguration{
+			TopicConfigurations: expandBucketNotificationTopics(d.Get("topic").([]any)),
+		},
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating object bucket notification (%s): %w", d.Id(), err))
+	}
+
+	return resourceBucketNotificationRead(ctx, d, m)
+}
+
+func resourceBucketNotificationDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// There is no API to delete the notification configuration, it is replaced with an empty one
+	_, err = conn.PutBucketNotificationConfiguration(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: &s3Types.NotificationConfiguration{},
+	})
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting object bucket notification (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandBucketNotificationTopics(rawTopics []any) []s3Types.TopicConfiguration {
+	topics := make([]s3Types.TopicConfiguration, 0, len(rawTopics))
+
+	for _, rawTopic := range rawTopics {
+		t := rawTopic.(map[string]any)
+
+		topic := s3Types.TopicConfiguration{
+			TopicArn: aws.String(t["topic_arn"].(string)),
+		}
+
+		if val, ok := t["id"].(string); ok && val != "" {
+			topic.Id = aws.String(val)
+		} else {
+			topic.Id = aws.String(id.PrefixedUniqueId("tf-scw-bucket-notification-"))
+		}
+
+		for _, event := range types.ExpandStrings(t["events"].(*schema.Set).List()) {
+			topic.Events = append(topic.Events, s3Types.Event(event))
+		}
+
+		var filterRules []s3Types.FilterRule
+
+		if prefix := t["filter_prefix"].(string); prefix != "" {
+			filterRules = append(filterRules, s3Types.FilterRule{
+				Name:  s3Types.FilterRuleNamePrefix,
+				Value: aws.String(prefix),
+			})
+		}
+
+		if suffix := t["filter_suffix"].(string); suffix != "" {
+			filterRules = append(filterRules, s3Types.FilterRule{
+				Name:  s3Types.FilterRuleNameSuffix,
+				Value: aws.String(suffix),
+			})
+		}
+
+		if len(filterRules) > 0 {
+			topic.Filter = &s3Types.NotificationConfigurationFilter{
+				Key: &s3Types.S3KeyFilter{
+					FilterRules: filterRules,
+				},
+			}
+		}
+
+		topics = append(topics, topic)
+	}
+
+	return topics
+}
+
+func flattenBucketNotificationTopics(topics []s3Types.TopicConfiguration) []any {
+	rawTopics := make([]any, 0, len(topics))
+
+	for _, topic := range topics {
+		events := make([]any, 0, len(topic.Events))
+		for _, event := range topic.Events {
+			events = append(events, string(event))
+		}
+
+		t := map[string]any{
+			"id":        aws.ToString(topic.Id),
+			"topic_arn": aws.ToString(topic.TopicArn),
+			"events":    schema.NewSet(schema.HashString, events),
+		}
+
+		if topic.Filter != nil && topic.Filter.Key != nil {
+			for _, rule := range topic.Filter.Key.FilterRules {
+				// The name of the filter rules may be returned capitalized
+				switch s3Types.FilterRuleName(strings.ToLower(string(rule.Name))) {
+				case s3Types.FilterRuleNamePrefix:
+					t["filter_prefix"] = aws.ToString(rule.Value)
+				case s3Types.FilterRuleNameSuffix:
+					t["filter_suffix"] = aws.ToString(rule.Value)
+				}
+			}
+		}
+
+		rawTopics = append(rawTopics, t)
+	}
+
+	return rawTopics
+}
@@ -0,0 +1,112 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+)
+
+func TestAccObjectBucketNotification_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	bucketName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-notification")
+	resourceName := "scaleway_object_bucket_notification.main"
+
+	base := fmt.Sprintf(`
+		resource "scaleway_account_project" "main" {
+			name = "tf_tests_object_bucket_notification"
+		}
+
+		resource "scaleway_mnq_sns" "main" {
+			project_id = scaleway_account_project.main.id
+		}
+
+		resource "scaleway_mnq_sns_credentials" "main" {
+			project_id = scaleway_mnq_sns.main.project_id
+			permissions {
+				can_manage = true
+			}
+		}
+
+		resource "scaleway_mnq_sns_topic" "main" {
+			project_id = scaleway_mnq_sns.main.project_id
+			name       = "test-object-bucket-notification"
+			access_key = scaleway_mnq_sns_credentials.main.access_key
+			secret_key = scaleway_mnq_sns_credentials.main.secret_key
+		}
+
+		resource "scaleway_object_bucket" "main" {
+			name       = %[1]q
+			region     = "fr-par"
+			project_id = scaleway_account_project.main.id
+		}
+	`, bucketName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             objectchecks.IsBucketDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: base + `
+					resource "scaleway_object_bucket_notification" "main" {
+						bucket     = scaleway_object_bucket.main.id
+						project_id = scaleway_account_project.main.id
+
+						topic {
+							id            = "uploads"
+							topic_arn     = scaleway_mnq_sns_topic.main.arn
+							events        = ["s3:ObjectCreated:*"]
+							filter_prefix = "uploads/"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "scaleway_object_bucket.main", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "scaleway_account_project.main", "id"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "topic.0.id", "uploads"),
+					resource.TestCheckResourceAttrPair(resourceName, "topic.0.topic_arn", "scaleway_mnq_sns_topic.main", "arn"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "topic.0.events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckResourceAttr(resourceName, "topic.0.filter_prefix", "uploads/"),
+				),
+			},
+			{
+				Config: base + `
+					resource "scaleway_object_bucket_notification" "main" {
+						bucket     = scaleway_object_bucket.main.id
+						project_id = scaleway_account_project.main.id
+
+						topic {
+							id            = "uploads"
+							topic_arn     = scaleway_mnq_sns_topic.main.arn
+							events        = ["s3:ObjectCreated:*", "s3:ObjectRemoved:*"]
+							filter_suffix = ".jpg"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "topic.0.events.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "topic.0.events.*", "s3:ObjectRemoved:*"),
+					resource.TestCheckResourceAttr(resourceName, "topic.0.filter_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "topic.0.filter_suffix", ".jpg"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+
+					return rs.Primary.ID + "@" + rs.Primary.Attributes["project_id"], nil
+				},
+			},
+		},
+	})
+}
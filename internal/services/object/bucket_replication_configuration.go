@@ -0,0 +1,319 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+)
+
+// bucketARNPrefix is the prefix of the ARN of a bucket, used to reference the destination bucket of a replication rule.
+const bucketARNPrefix = "arn:scw:s3:::"
+
+func ResourceBucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketReplicationConfigurationCreate,
+		ReadContext:   resourceBucketReplicationConfigurationRead,
+		UpdateContext: resourceBucketReplicationConfigurationUpdate,
+		DeleteContext: resourceBucketReplicationConfigurationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaFunc: bucketReplicationConfigurationSchema,
+		Identity:   identity.DefaultRegional(),
+	}
+}
+
+func bucketReplicationConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringLenBetween(1, 63),
+			Description:      "The source bucket's name or regional ID.",
+			DiffSuppressFunc: dsf.Locality,
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "Replication rules of the bucket",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringLenBetween(0, 255),
+						Description:  "Unique identifier for the rule",
+					},
+					"priority": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "Priority of the rule when several rules replicate the same object, the highest priority wins",
+					},
+					"enabled": {
+						Type:        schema.TypeBool,
+						Required:    true,
+						Description: "Specifies if the rule is enabled",
+					},
+					"prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The prefix identifying the objects to replicate. All the objects are replicated if empty",
+					},
+					"delete_marker_replication": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether delete markers are replicated",
+					},
+					"destination": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						MaxItems:    1,
+						Description: "Bucket where the objects are replicated",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"bucket": {
+									Type:             schema.TypeString,
+									Required:         true,
+									Description:      "The destination bucket's name or regional ID",
+									DiffSuppressFunc: dsf.Locality,
+								},
+								"storage_class": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									Computed:     true,
+									ValidateFunc: validation.StringInSlice(TransitionSCWStorageClassValues(), false),
+									Description:  "Storage class of the replicated objects. Defaults to the storage class of the source objects",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"region":     regional.Schema(),
+		"project_id": account.ProjectIDSchema(),
+	}
+}
+
+func resourceBucketReplicationConfigurationCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, region, err := s3ClientWithRegion(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionalID := regional.ExpandID(d.Get("bucket"))
+	bucket := regionalID.ID
+	bucketRegion := regionalID.Region
+
+	if bucketRegion != "" && bucketRegion != region {
+		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		region = bucketRegion
+	}
+
+	if err := putBucketReplicationConfiguration(ctx, conn, bucket, d.Get("rule").([]any)); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) replication configuration: %w", bucket, err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBucketReplicationConfigurationRead(ctx, d, m)
+}
+
+func resourceBucketReplicationConfigurationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn, region, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	replication, err := conn.GetBucketReplication(ctx, &s3.GetBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	})
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket, ErrCodeReplicationConfigurationNotFound) {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Replication Configuration (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading object bucket replication configuration (%s): %w", d.Id(), err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("bucket", regional.NewIDString(region, bucket))
+	_ = d.Set("region", region)
+
+	if replication.ReplicationConfiguration != nil {
+		if err := d.Set("rule", flattenBucketReplicationRules(replication.ReplicationConfiguration.Rules)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	diags, ok := setProjectIDFromACL(ctx, conn, d, bucket, diags)
+	if !ok {
+		return diags
+	}
+
+	return diags
+}
+
+func resourceBucketReplicationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := putBucketReplicationConfiguration(ctx, conn, bucket, d.Get("rule").([]any)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating object bucket replication configuration (%s): %w", d.Id(), err))
+	}
+
+	return resourceBucketReplicationConfigurationRead(ctx, d, m)
+}
+
+func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.DeleteBucketReplication(ctx, &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	})
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket, ErrCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting object bucket replication configuration (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func putBucketReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket string, rawRules []any) error {
+	_, err := conn.PutBucketReplication(ctx, &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: &s3Types.ReplicationConfiguration{
+			// Scaleway Object Storage does not use roles for the replication, but the field is required by the SDK.
+			Role:  aws.String(""),
+			Rules: expandBucketReplicationRules(rawRules),
+		},
+	})
+
+	return err
+}
+
+func expandBucketReplicationRules(rawRules []any) []s3Types.ReplicationRule {
+	rules := make([]s3Types.ReplicationRule, 0, len(rawRules))
+
+	for _, rawRule := range rawRules {
+		r := rawRule.(map[string]any)
+
+		rule := s3Types.ReplicationRule{
+			Priority: aws.Int32(int32(r["priority"].(int))), //nolint:gosec
+			Status:   s3Types.ReplicationRuleStatusDisabled,
+			Filter: &s3Types.ReplicationRuleFilter{
+				Prefix: aws.String(r["prefix"].(string)),
+			},
+			DeleteMarkerReplication: &s3Types.DeleteMarkerReplication{
+				Status: s3Types.DeleteMarkerReplicationStatusDisabled,
+			},
+		}
+
+		if val, ok := r["id"].(string); ok && val != "" {
+			rule.ID = aws.String(val)
+		} else {
+			rule.ID = aws.String(id.PrefixedUniqueId("tf-scw-bucket-replication-"))
+		}
+
+		if r["enabled"].(bool) {
+			rule.Status = s3Types.ReplicationRuleStatusEnabled
+		}
+
+		if r["delete_marker_replication"].(bool) {
+			rule.DeleteMarkerReplication.Status = s3Types.DeleteMarkerReplicationStatusEnabled
+		}
+
+		if destinations := r["destination"].([]any); len(destinations) > 0 && destinations[0] != nil {
+			destination := destinations[0].(map[string]any)
+			rule.Destination = &s3Types.Destination{
+				Bucket: aws.String(bucketARNPrefix + regional.ExpandID(destination["bucket"]).ID),
+			}
+
+			if storageClass := destination["storage_class"].(string); storageClass != "" {
+				rule.Destination.StorageClass = s3Types.StorageClass(storageClass)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenBucketReplicationRules(rules []s3Types.ReplicationRule) []any {
+	rawRules := make([]any, 0, len(rules))
+
+	for _, rule := range rules {
+		r := map[string]any{
+			"id":                        aws.ToString(rule.ID),
+			"priority":                  int(aws.ToInt32(rule.Priority)),
+			"enabled":                   rule.Status == s3Types.ReplicationRuleStatusEnabled,
+			"delete_marker_replication": rule.DeleteMarkerReplication != nil && rule.DeleteMarkerReplication.Status == s3Types.DeleteMarkerReplicationStatusEnabled,
+		}
+
+		switch {
+		case rule.Filter != nil && rule.Filter.Prefix != nil:
+			r["prefix"] = aws.ToString(rule.Filter.Prefix)
+		case rule.Prefix != nil:
+			r["prefix"] = aws.ToString(rule.Prefix)
+		}
+
+		if rule.Destination != nil {
+			r["destination"] = []any{map[string]any{
+				"bucket":        strings.TrimPrefix(aws.ToString(rule.Destination.Bucket), bucketARNPrefix),
+				"storage_class": string(rule.Destination.StorageClass),
+			}}
+		}
+
+		rawRules = append(rawRules, r)
+	}
+
+	return rawRules
+}
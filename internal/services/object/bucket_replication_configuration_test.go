@@ -0,0 +1,105 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+)
+
+func TestAccObjectBucketReplicationConfiguration_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	sourceName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-replication-source")
+	destinationName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-replication-destination")
+	resourceName := "scaleway_object_bucket_replication_configuration.main"
+
+	buckets := fmt.Sprintf(`
+		resource "scaleway_object_bucket" "source" {
+			name   = %[1]q
+			region = %[2]q
+
+			versioning {
+				enabled = true
+			}
+		}
+
+		resource "scaleway_object_bucket" "destination" {
+			name   = %[3]q
+			region = %[4]q
+
+			versioning {
+				enabled = true
+			}
+		}
+	`, sourceName, objectTestsMainRegion, destinationName, objectTestsSecondaryRegion)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             objectchecks.IsBucketDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: buckets + `
+					resource "scaleway_object_bucket_replication_configuration" "main" {
+						bucket = scaleway_object_bucket.source.id
+
+						rule {
+							id      = "backups"
+							prefix  = "backups/"
+							enabled = true
+
+							destination {
+								bucket = scaleway_object_bucket.destination.id
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "scaleway_object_bucket.source", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "scaleway_object_bucket.source", "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "backups"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.prefix", "backups/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.delete_marker_replication", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination.0.bucket", destinationName),
+				),
+			},
+			{
+				Config: buckets + `
+					resource "scaleway_object_bucket_replication_configuration" "main" {
+						bucket = scaleway_object_bucket.source.id
+
+						rule {
+							id                        = "backups"
+							priority                  = 1
+							enabled                   = true
+							delete_marker_replication = true
+
+							destination {
+								bucket        = scaleway_object_bucket.destination.id
+								storage_class = "ONEZONE_IA"
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.0.prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.delete_marker_replication", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination.0.storage_class", "ONEZONE_IA"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rule.0.destination.0.bucket"},
+			},
+		},
+	})
+}
@@ -0,0 +1,198 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+)
+
+func ResourceBucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBucketVersioningCreate,
+		ReadContext:   resourceBucketVersioningRead,
+		UpdateContext: resourceBucketVersioningUpdate,
+		DeleteContext: resourceBucketVersioningDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaFunc: bucketVersioningSchema,
+		Identity:   identity.DefaultRegional(),
+	}
+}
+
+func bucketVersioningSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringLenBetween(1, 63),
+			Description:      "The bucket's name or regional ID.",
+			DiffSuppressFunc: dsf.Locality,
+		},
+		"versioning_configuration": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			MaxItems:    1,
+			Description: "Versioning state of the bucket",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Required:    true,
+						Description: "Enable versioning, or suspend it if false. Once you version-enable a bucket, it can never return to an unversioned state",
+					},
+				},
+			},
+		},
+		"region":     regional.Schema(),
+		"project_id": account.ProjectIDSchema(),
+	}
+}
+
+func resourceBucketVersioningCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, region, err := s3ClientWithRegion(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionalID := regional.ExpandID(d.Get("bucket"))
+	bucket := regionalID.ID
+	bucketRegion := regionalID.Region
+
+	if bucketRegion != "" && bucketRegion != region {
+		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		region = bucketRegion
+	}
+
+	_, err = conn.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: expandObjectBucketVersioning(d.Get("versioning_configuration").([]any)),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) versioning: %w", bucket, err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBucketVersioningRead(ctx, d, m)
+}
+
+func resourceBucketVersioningRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn, region, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versioning, err := conn.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	})
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket) {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Versioning (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading object bucket versioning (%s): %w", d.Id(), err))
+	}
+
+	err = identity.SetRegionalIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("bucket", regional.NewIDString(region, bucket))
+	_ = d.Set("region", region)
+	_ = d.Set("versioning_configuration", FlattenObjectBucketVersioning(versioning))
+
+	diags, ok := setProjectIDFromACL(ctx, conn, d, bucket, diags)
+	if !ok {
+		return diags
+	}
+
+	return diags
+}
+
+func resourceBucketVersioningUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: expandObjectBucketVersioning(d.Get("versioning_configuration").([]any)),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating object bucket versioning (%s): %w", d.Id(), err))
+	}
+
+	return resourceBucketVersioningRead(ctx, d, m)
+}
+
+// resourceBucketVersioningDelete suspends the versioning of the bucket, as a bucket can never return to an unversioned
+// state. Object Lock requires versioning, so it is left enabled on buckets with Object Lock.
+func resourceBucketVersioningDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lock, err := conn.GetObjectLockConfiguration(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket):
+		return nil
+	case err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeObjectLockConfigurationNotFoundError):
+		return diag.FromErr(fmt.Errorf("error reading object bucket lock configuration (%s): %w", d.Id(), err))
+	case err == nil && lock.ObjectLockConfiguration != nil:
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket (%s) has Object Lock enabled, its versioning cannot be suspended", d.Id()))
+
+		return nil
+	}
+
+	_, err = conn.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucket),
+		VersioningConfiguration: &s3Types.VersioningConfiguration{
+			Status: s3Types.BucketVersioningStatusSuspended,
+		},
+	})
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error suspending object bucket versioning (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
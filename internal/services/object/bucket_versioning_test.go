@@ -0,0 +1,62 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+)
+
+func TestAccObjectBucketVersioning_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	bucketName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-bucket-versioning")
+	resourceName := "scaleway_object_bucket_versioning.main"
+
+	config := func(enabled bool) string {
+		return fmt.Sprintf(`
+			resource "scaleway_object_bucket" "main" {
+				name   = %[1]q
+				region = %[2]q
+			}
+
+			resource "scaleway_object_bucket_versioning" "main" {
+				bucket = scaleway_object_bucket.main.id
+
+				versioning_configuration {
+					enabled = %[3]t
+				}
+			}
+		`, bucketName, objectTestsMainRegion, enabled)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             objectchecks.IsBucketDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "scaleway_object_bucket.main", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "scaleway_object_bucket.main", "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
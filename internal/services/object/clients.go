@@ -2,6 +2,7 @@ package object
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -22,3 +23,13 @@ func SharedS3ClientForRegion(region scw.Region) (*s3.Client, error) {
 
 	return NewS3ClientFromMeta(ctx, m, region.String())
 }
+
+// NewS3PresignClientFromMeta returns a client presigning the requests of the S3 client of the given region and project
+func NewS3PresignClientFromMeta(ctx context.Context, m *meta.Meta, region, projectID string, expires time.Duration) (*s3.PresignClient, error) {
+	client, err := NewS3ClientFromMetaWithProjectID(ctx, m, region, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s3.NewPresignClient(client, s3.WithPresignExpires(expires)), nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 )
 
 func DataSourceObject() *schema.Resource {
@@ -61,5 +62,8 @@ func DataSourceObjectRead(ctx context.Context, d *schema.ResourceData, m any) di
 
 	d.SetId(regional.NewIDString(region, objectID(bucket, key)))
 
-	return resourceObjectRead(ctx, d, m)
+	diags := resourceObjectRead(ctx, d, m)
+	tags.SetDataSourceMap(d, m)
+
+	return diags
 }
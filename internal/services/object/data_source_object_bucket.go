@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 )
 
 func DataSourceBucket() *schema.Resource {
@@ -68,5 +69,8 @@ func DataSourceObjectStorageRead(ctx context.Context, d *schema.ResourceData, m
 	bucketRegionalID := regional.NewIDString(region, bucket)
 	d.SetId(bucketRegionalID)
 
-	return setBucketState(ctx, d, bucket, region, s3Client)
+	diags := setBucketState(ctx, d, m, bucket, region, s3Client)
+	tags.SetDataSourceMap(d, m)
+
+	return diags
 }
@@ -26,6 +26,8 @@ const (
 	ErrCodeBucketNotEmpty = "BucketNotEmpty"
 	// ErrCodeNoSuchBucket bucket not found
 	ErrCodeNoSuchBucket = "NoSuchBucket"
+	// ErrCodeNoSuchUpload multipart upload not found
+	ErrCodeNoSuchUpload = "NoSuchUpload"
 	// ErrCodeNoSuchBucketPolicy policy not found
 	ErrCodeNoSuchBucketPolicy = "NoSuchBucketPolicy"
 	// ErrCodeNoSuchWebsiteConfiguration website configuration not found
@@ -62,6 +64,7 @@ const (
 	ErrCodeValidationException                       = "ValidationException"
 	ErrCodeServerSideEncryptionConfigurationNotFound = "ServerSideEncryptionConfigurationNotFoundError"
 	ErrCodeOperationAborted                          = "OperationAborted"
+	ErrCodeReplicationConfigurationNotFound          = "ReplicationConfigurationNotFoundError"
 )
 
 // TimedOut returns true if the error represents a "wait timed out" condition.
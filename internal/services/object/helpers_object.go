@@ -40,6 +40,7 @@ const (
 	defaultObjectBucketTimeout = 10 * time.Minute
 
 	maxObjectVersionDeletionWorkers = 8
+	maxObjectUploadWorkers          = 8
 
 	ErrCodeForbidden = "Forbidden"
 )
@@ -5,8 +5,9 @@ import (
 	"context"
 	"crypto/md5" //nolint:gosec
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"os"
+	"io/fs"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,11 +15,13 @@ import (
 	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -28,6 +31,10 @@ func ResourceObject() *schema.Resource {
 		ReadContext:   resourceObjectRead,
 		UpdateContext: resourceObjectUpdate,
 		DeleteContext: resourceObjectDelete,
+		CustomizeDiff: customdiff.All(
+			resourceObjectCustomizeDiff,
+			tags.CustomizeDiffMap,
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
 			Create:  schema.DefaultTimeout(defaultObjectBucketTimeout),
@@ -76,8 +83,10 @@ func objectSchema() map[string]*schema.Schema {
 		"hash": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "File hash to trigger upload",
+			Computed:    true,
+			Description: "File hash to trigger upload, defaults to the MD5 hash of the file",
 		},
+		"multipart": multipartSchema(),
 		"storage_class": {
 			Type:         schema.TypeString,
 			Optional:     true,
@@ -107,6 +116,7 @@ func objectSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllMapSchema(),
 		"visibility": {
 			Optional:    true,
 			Type:        schema.TypeString,
@@ -182,13 +192,18 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m any) di
 		req.SSECustomerKey = encryption
 	}
 
-	if filePath, hasFile := d.GetOk("file"); hasFile {
-		file, err := os.Open(filePath.(string))
+	filePath, hasFile := d.GetOk("file")
+
+	if hasFile {
+		err = uploadObjectFile(ctx, s3Client, req, filePath.(string), expandMultipartConfig(d.Get("multipart")))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		req.Body = file
+		err = setObjectFileHash(d, filePath.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	} else if content, hasContent := d.GetOk("content"); hasContent {
 		contentString := []byte(content.(string))
 		req.Body = bytes.NewReader(contentString)
@@ -206,17 +221,19 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m any) di
 		req.Body = bytes.NewReader([]byte{})
 	}
 
-	_, err = s3Client.PutObject(ctx, req)
-	if err != nil {
-		return diag.FromErr(err)
+	if !hasFile {
+		_, err = s3Client.PutObject(ctx, req)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	if rawTags, hasTags := d.GetOk("tags"); hasTags {
+	if objectTags := tags.ExpandMap(d, m); len(objectTags) > 0 {
 		_, err := s3Client.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
 			Bucket: types.ExpandStringPtr(bucket),
 			Key:    types.ExpandStringPtr(key),
 			Tagging: &s3Types.Tagging{
-				TagSet: ExpandObjectBucketTags(rawTags),
+				TagSet: ExpandObjectBucketTags(objectTags),
 			},
 		})
 		if err != nil {
@@ -282,17 +299,27 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m any) di
 		}
 
 		if filePath, hasFile := d.GetOk("file"); hasFile {
-			file, err := os.Open(filePath.(string))
-			if err != nil {
-				return diag.FromErr(err)
+			multipart := expandMultipartConfig(d.Get("multipart"))
+			// The settings of a previous upload which failed are kept in the state, its multipart upload is only
+			// resumed if they did not change since.
+			multipart.resume = !d.HasChanges("visibility", "content_type", "metadata", "storage_class", "sse_customer_key")
+
+			err = uploadObjectFile(ctx, s3Client, req, filePath.(string), multipart)
+			if err == nil {
+				err = setObjectFileHash(d, filePath.(string))
+			} else {
+				// Keep the previous file and hash in the state so that the next apply uploads the file again, resuming
+				// its multipart upload
+				for _, attribute := range []string{"file", "hash"} {
+					previous, _ := d.GetChange(attribute)
+					_ = d.Set(attribute, previous)
+				}
 			}
-
-			req.Body = file
 		} else {
 			req.Body = bytes.NewReader([]byte{})
-		}
 
-		_, err = s3Client.PutObject(ctx, req)
+			_, err = s3Client.PutObject(ctx, req)
+		}
 	} else {
 		req := &s3.CopyObjectInput{
 			Bucket:       types.ExpandStringPtr(bucketUpdated),
@@ -335,12 +362,12 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m any) di
 		}
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		_, err := s3Client.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
 			Bucket: types.ExpandStringPtr(bucketUpdated),
 			Key:    types.ExpandStringPtr(key),
 			Tagging: &s3Types.Tagging{
-				TagSet: ExpandObjectBucketTags(d.Get("tags")),
+				TagSet: ExpandObjectBucketTags(tags.ExpandMap(d, m)),
 			},
 		})
 		if err != nil {
@@ -391,7 +418,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, m any) diag
 	_ = d.Set("metadata", types.FlattenMap(obj.Metadata))
 	_ = d.Set("content_type", &obj.ContentType)
 
-	tags, err := s3Client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{
+	objectTags, err := s3Client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{
 		Bucket: types.ExpandStringPtr(bucket),
 		Key:    types.ExpandStringPtr(key),
 	})
@@ -399,7 +426,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, m any) diag
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set("tags", flattenObjectBucketTags(tags.TagSet))
+	tags.SetMap(d, m, flattenObjectBucketTags(objectTags.TagSet))
 
 	acl, err := s3Client.GetObjectAcl(ctx, &s3.GetObjectAclInput{
 		Bucket: types.ExpandStringPtr(bucket),
@@ -440,6 +467,56 @@ func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, m any) di
 	return nil
 }
 
+// resourceObjectCustomizeDiff computes the hash of the file when it is not set, so that a change of its content triggers
+// an upload. The file is streamed, so large files are not loaded in memory.
+// Objects uploaded before the hash was computed have no hash in their state: it is not computed for them, as the
+// resulting diff would upload the file again. It is set on their next upload.
+func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	hashConfig, diags := d.GetRawConfigAt(cty.GetAttrPath("hash"))
+	if diags.HasError() || !hashConfig.IsNull() || !d.NewValueKnown("file") {
+		return nil
+	}
+
+	if oldHash, _ := d.GetChange("hash"); d.Id() != "" && oldHash.(string) == "" {
+		return nil
+	}
+
+	filePath := d.Get("file").(string)
+	if filePath == "" {
+		return nil
+	}
+
+	hash, err := fileMD5(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		// The file may be created during the apply
+		return d.SetNewComputed("hash")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if hash != d.Get("hash").(string) {
+		return d.SetNew("hash", hash)
+	}
+
+	return nil
+}
+
+// setObjectFileHash sets the hash of an uploaded file when it was not known during the plan.
+func setObjectFileHash(d *schema.ResourceData, filePath string) error {
+	if d.Get("hash").(string) != "" {
+		return nil
+	}
+
+	hash, err := fileMD5(filePath)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("hash", hash)
+}
+
 func objectID(bucket, key string) string {
 	return fmt.Sprintf("%s/%s", bucket, key)
 }
@@ -0,0 +1,621 @@
+package object
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/workerpool"
+)
+
+func ResourceObjectDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectDirectoryCreate,
+		ReadContext:   resourceObjectDirectoryRead,
+		UpdateContext: resourceObjectDirectoryUpdate,
+		DeleteContext: resourceObjectDirectoryDelete,
+		CustomizeDiff: resourceObjectDirectoryCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObjectDirectoryImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+			Create:  schema.DefaultTimeout(defaultObjectBucketTimeout),
+			Read:    schema.DefaultTimeout(defaultObjectBucketTimeout),
+			Update:  schema.DefaultTimeout(defaultObjectBucketTimeout),
+			Delete:  schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		SchemaFunc: objectDirectorySchema,
+		Identity:   objectDirectoryIdentity(),
+	}
+}
+
+func objectDirectoryIdentity() *schema.ResourceIdentity {
+	return identity.WrapSchemaMap(map[string]*schema.Schema{
+		"region": identity.DefaultRegionAttribute(),
+		"bucket": {
+			Type:              schema.TypeString,
+			Description:       "The name of the bucket",
+			RequiredForImport: true,
+		},
+		"key_prefix": {
+			Type:              schema.TypeString,
+			Description:       "The prefix of the keys of the objects",
+			OptionalForImport: true,
+		},
+	})
+}
+
+func objectDirectorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bucket": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			Description:      "The bucket's name or regional ID.",
+			DiffSuppressFunc: dsf.Locality,
+		},
+		"source_dir": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Path of the local directory to upload",
+		},
+		"key_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Prefix added to the path of the files to build the keys of the objects, like `site/`",
+		},
+		"include": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Glob patterns of the files to upload, relative to source_dir. Defaults to all the files",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"exclude": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Glob patterns of the files not to upload, relative to source_dir",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"content_types": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Map of file extensions, like `.html`, to the content type of the objects. Other content types are detected from the extension, then from the content of the files",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"storage_class": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(TransitionSCWStorageClassValues(), false),
+			Description:  "Specifies the Scaleway Object Storage class of the objects",
+		},
+		"visibility": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Visibility of the objects, public-read or private",
+			ValidateFunc: validation.StringInSlice([]string{
+				string(s3Types.ObjectCannedACLPrivate),
+				string(s3Types.ObjectCannedACLPublicRead),
+			}, false),
+		},
+		"manifest": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "Map of the keys of the uploaded objects to the MD5 hash of their content",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"region":     regional.Schema(),
+		"project_id": account.ProjectIDSchema(),
+	}
+}
+
+// objectDirectoryFile is a local file uploaded as an object.
+type objectDirectoryFile struct {
+	path        string
+	hash        string
+	contentType string
+}
+
+func resourceObjectDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for _, key := range []string{"source_dir", "key_prefix", "include", "exclude"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("manifest")
+		}
+	}
+
+	files, err := listObjectDirectoryFiles(
+		d.Get("source_dir").(string),
+		d.Get("key_prefix").(string),
+		types.ExpandStrings(d.Get("include")),
+		types.ExpandStrings(d.Get("exclude")),
+	)
+	if err != nil {
+		return err
+	}
+
+	manifest := make(map[string]any, len(files))
+	for key, file := range files {
+		manifest[key] = file.hash
+	}
+
+	if maps.Equal(manifest, d.Get("manifest").(map[string]any)) {
+		return nil
+	}
+
+	return d.SetNew("manifest", manifest)
+}
+
+func resourceObjectDirectoryCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	s3Client, region, bucket, err := s3ClientForObjectDirectory(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	err = setObjectDirectoryIdentity(d, region, bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := syncObjectDirectory(ctx, d, s3Client, bucket, map[string]any{}, true)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceObjectDirectoryRead(ctx, d, m)...)
+}
+
+func resourceObjectDirectoryRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	s3Client, region, bucket, err := s3ClientForObjectDirectory(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	manifest := d.Get("manifest").(map[string]any)
+	current := make(map[string]any, len(manifest))
+
+	// Objects which were deleted or modified outside of Terraform are removed from the manifest or get their new hash,
+	// so they are uploaded again on the next apply.
+	pages := s3.NewListObjectsV2Paginator(s3Client, &s3.ListObjectsV2Input{
+		Bucket: types.ExpandStringPtr(bucket),
+		Prefix: types.ExpandStringPtr(d.Get("key_prefix")),
+	})
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			if IsS3Err(err, ErrCodeNoSuchBucket, "") && !d.IsNewResource() {
+				d.SetId("")
+
+				return nil
+			}
+
+			return diag.FromErr(err)
+		}
+
+		for _, obj := range page.Contents {
+			key := aws.ToString(obj.Key)
+			if _, tracked := manifest[key]; tracked {
+				current[key] = strings.Trim(aws.ToString(obj.ETag), `"`)
+			}
+		}
+	}
+
+	_ = d.Set("manifest", current)
+	_ = d.Set("region", region)
+	_ = d.Set("bucket", regional.NewIDString(region, bucket))
+
+	return diag.FromErr(setObjectDirectoryIdentity(d, region, bucket))
+}
+
+func resourceObjectDirectoryUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	s3Client, _, bucket, err := s3ClientForObjectDirectory(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	previous, _ := d.GetChange("manifest")
+	uploadAll := d.HasChanges("content_types", "storage_class", "visibility")
+
+	diags := syncObjectDirectory(ctx, d, s3Client, bucket, previous.(map[string]any), uploadAll)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceObjectDirectoryRead(ctx, d, m)...)
+}
+
+func resourceObjectDirectoryDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	s3Client, _, bucket, err := s3ClientForObjectDirectory(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	pool := workerpool.NewWorkerPool(findUploadWorkerCapacity())
+
+	for key := range d.Get("manifest").(map[string]any) {
+		pool.AddTask(func() error {
+			return deleteObjectDirectoryObject(ctx, s3Client, bucket, key)
+		})
+	}
+
+	if errs := pool.CloseAndWait(); len(errs) > 0 {
+		return diag.FromErr(errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// resourceObjectDirectoryImport sets the bucket and key prefix from the ID, or from the identity. The manifest of an
+// imported directory is empty, so all the files are uploaded again on the next apply.
+func resourceObjectDirectoryImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		importedIdentity, err := d.Identity()
+		if err != nil {
+			return nil, fmt.Errorf("error getting identity: %w", err)
+		}
+
+		d.SetId(regional.NewIDString(
+			scw.Region(importedIdentity.Get("region").(string)),
+			objectID(importedIdentity.Get("bucket").(string), importedIdentity.Get("key_prefix").(string)),
+		))
+	}
+
+	region, keyPrefix, bucket, err := regional.ParseNestedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	_ = d.Set("bucket", regional.NewIDString(region, bucket))
+	_ = d.Set("key_prefix", keyPrefix)
+	_ = d.Set("region", region)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setObjectDirectoryIdentity sets the identity of a directory and its ID, {region}/{bucket}/{key_prefix}.
+func setObjectDirectoryIdentity(d *schema.ResourceData, region scw.Region, bucket string) error {
+	return identity.SetMultiPartIdentity(d, map[string]string{
+		"region":     region.String(),
+		"bucket":     bucket,
+		"key_prefix": d.Get("key_prefix").(string),
+	}, "region", "bucket", "key_prefix")
+}
+
+// syncObjectDirectory uploads the files which are not in the previous manifest or whose content changed, and deletes the
+// objects of the previous manifest which do not match a file anymore. The manifest is set to the objects which are in
+// sync, even if some requests failed.
+func syncObjectDirectory(ctx context.Context, d *schema.ResourceData, s3Client *s3.Client, bucket string, previous map[string]any, uploadAll bool) diag.Diagnostics {
+	files, err := listObjectDirectoryFiles(
+		d.Get("source_dir").(string),
+		d.Get("key_prefix").(string),
+		types.ExpandStrings(d.Get("include")),
+		types.ExpandStrings(d.Get("exclude")),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contentTypes := types.ExpandMapStringString(d.Get("content_types"))
+	storageClass := s3Types.StorageClass(d.Get("storage_class").(string))
+	visibility := s3Types.ObjectCannedACL(d.Get("visibility").(string))
+
+	manifest := maps.Clone(previous)
+	manifestMu := sync.Mutex{}
+	pool := workerpool.NewWorkerPool(findUploadWorkerCapacity())
+
+	for key, file := range files {
+		if !uploadAll && previous[key] == file.hash {
+			continue
+		}
+
+		pool.AddTask(func() error {
+			err := uploadObjectDirectoryFile(ctx, s3Client, bucket, key, file, contentTypes, storageClass, visibility)
+
+			manifestMu.Lock()
+			defer manifestMu.Unlock()
+
+			if err != nil {
+				delete(manifest, key)
+
+				return err
+			}
+
+			manifest[key] = file.hash
+
+			return nil
+		})
+	}
+
+	for key := range previous {
+		if _, exists := files[key]; exists {
+			continue
+		}
+
+		pool.AddTask(func() error {
+			err := deleteObjectDirectoryObject(ctx, s3Client, bucket, key)
+			if err != nil {
+				return err
+			}
+
+			manifestMu.Lock()
+			defer manifestMu.Unlock()
+
+			delete(manifest, key)
+
+			return nil
+		})
+	}
+
+	errs := pool.CloseAndWait()
+
+	_ = d.Set("manifest", manifest)
+
+	if len(errs) > 0 {
+		return diag.FromErr(errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func uploadObjectDirectoryFile(ctx context.Context, s3Client *s3.Client, bucket string, key string, file objectDirectoryFile, contentTypes map[string]string, storageClass s3Types.StorageClass, visibility s3Types.ObjectCannedACL) error {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	contentType, err := detectObjectContentType(f, contentTypes)
+	if err != nil {
+		return fmt.Errorf("failed to detect the content type of %s: %w", file.path, err)
+	}
+
+	digest, err := hex.DecodeString(file.hash)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:       types.ExpandStringPtr(bucket),
+		Key:          types.ExpandStringPtr(key),
+		Body:         f,
+		ContentType:  types.ExpandStringPtr(contentType),
+		ContentMD5:   aws.String(base64.StdEncoding.EncodeToString(digest)),
+		StorageClass: storageClass,
+		ACL:          visibility,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload %s to %s: %w", file.path, key, err)
+	}
+
+	return nil
+}
+
+func deleteObjectDirectoryObject(ctx context.Context, s3Client *s3.Client, bucket string, key string) error {
+	_, err := s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: types.ExpandStringPtr(bucket),
+		Key:    types.ExpandStringPtr(key),
+	})
+	if err != nil && !IsS3Err(err, ErrCodeNoSuchBucket, "") {
+		return fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// detectObjectContentType returns the content type set for the extension of the file, or the one detected from its
+// extension, or from its content. The file is rewound after the detection.
+func detectObjectContentType(f *os.File, contentTypes map[string]string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(f.Name()))
+
+	if contentType, ok := contentTypes[ext]; ok {
+		return contentType, nil
+	}
+
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType, nil
+	}
+
+	buffer := make([]byte, 512)
+
+	n, err := io.ReadFull(f, buffer)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buffer[:n]), nil
+}
+
+// listObjectDirectoryFiles returns the regular files of a directory matching the include and exclude globs, by key.
+func listObjectDirectoryFiles(sourceDir string, keyPrefix string, include []string, exclude []string) (map[string]objectDirectoryFile, error) {
+	includeRegexps, err := compileGlobs(include)
+	if err != nil {
+		return nil, err
+	}
+
+	excludeRegexps, err := compileGlobs(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]objectDirectoryFile{}
+
+	err = filepath.WalkDir(sourceDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if len(includeRegexps) > 0 && !matchesAnyGlob(includeRegexps, rel) || matchesAnyGlob(excludeRegexps, rel) {
+			return nil
+		}
+
+		hash, err := fileMD5(path)
+		if err != nil {
+			return err
+		}
+
+		files[keyPrefix+rel] = objectDirectoryFile{
+			path: path,
+			hash: hash,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the files of %s: %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func fileMD5(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New() //nolint:gosec
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// compileGlobs converts glob patterns to regular expressions. `*` and `?` do not match `/`, `**` matches any number of
+// directories.
+func compileGlobs(globs []string) ([]*regexp.Regexp, error) {
+	regexps := make([]*regexp.Regexp, 0, len(globs))
+
+	for _, glob := range globs {
+		var sb strings.Builder
+
+		sb.WriteString("^")
+
+		for i := 0; i < len(glob); i++ {
+			switch c := glob[i]; {
+			case strings.HasPrefix(glob[i:], "**/"):
+				sb.WriteString("(.*/)?")
+
+				i += 2
+			case strings.HasPrefix(glob[i:], "**"):
+				sb.WriteString(".*")
+
+				i++
+			case c == '*':
+				sb.WriteString("[^/]*")
+			case c == '?':
+				sb.WriteString("[^/]")
+			default:
+				sb.WriteString(regexp.QuoteMeta(string(c)))
+			}
+		}
+
+		sb.WriteString("$")
+
+		r, err := regexp.Compile(sb.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", glob, err)
+		}
+
+		regexps = append(regexps, r)
+	}
+
+	return regexps, nil
+}
+
+func matchesAnyGlob(regexps []*regexp.Regexp, path string) bool {
+	for _, r := range regexps {
+		if r.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findUploadWorkerCapacity() int {
+	return min(runtime.NumCPU(), maxObjectUploadWorkers)
+}
+
+// s3ClientForObjectDirectory returns a client for the region of the bucket.
+func s3ClientForObjectDirectory(ctx context.Context, d *schema.ResourceData, m any) (*s3.Client, scw.Region, string, error) {
+	s3Client, region, err := s3ClientWithRegion(ctx, d, m)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	regionalID := regional.ExpandID(d.Get("bucket"))
+	if regionalID.Region != "" && regionalID.Region != region {
+		s3Client, err = s3ClientForceRegion(ctx, d, m, regionalID.Region.String())
+		if err != nil {
+			return nil, "", "", err
+		}
+
+		region = regionalID.Region
+	}
+
+	return s3Client, region, regionalID.ID, nil
+}
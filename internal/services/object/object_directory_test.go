@@ -0,0 +1,77 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+)
+
+func TestAccObjectDirectory_Basic(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-scaleway-object-directory")
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             objectchecks.IsBucketDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name   = "%s"
+						region = "%s"
+					}
+
+					resource "scaleway_object_directory" "site" {
+						bucket     = scaleway_object_bucket.main.id
+						source_dir = "testfixture/directory"
+						key_prefix = "site/"
+						exclude    = ["drafts/**"]
+
+						content_types = {
+							".custom" = "application/json"
+						}
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object_directory.site", "manifest.%", "3"),
+					resource.TestCheckResourceAttrSet("scaleway_object_directory.site", "manifest.site/index.html"),
+					resource.TestCheckResourceAttrSet("scaleway_object_directory.site", "manifest.site/css/style.css"),
+					resource.TestCheckNoResourceAttr("scaleway_object_directory.site", "manifest.site/drafts/notes.txt"),
+					objectchecks.IsObjectDirectoryUploaded(tt, "scaleway_object_directory.site", map[string]string{
+						"site/index.html":    "text/html; charset=utf-8",
+						"site/css/style.css": "text/css; charset=utf-8",
+						"site/data.custom":   "application/json",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name   = "%s"
+						region = "%s"
+					}
+
+					resource "scaleway_object_directory" "site" {
+						bucket     = scaleway_object_bucket.main.id
+						source_dir = "testfixture/directory"
+						key_prefix = "site/"
+						include    = ["**/*.html", "**/*.txt"]
+						visibility = "public-read"
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object_directory.site", "manifest.%", "2"),
+					resource.TestCheckResourceAttrSet("scaleway_object_directory.site", "manifest.site/index.html"),
+					resource.TestCheckResourceAttrSet("scaleway_object_directory.site", "manifest.site/drafts/notes.txt"),
+					resource.TestCheckNoResourceAttr("scaleway_object_directory.site", "manifest.site/css/style.css"),
+					objectchecks.IsObjectDirectoryUploaded(tt, "scaleway_object_directory.site", nil),
+				),
+			},
+		},
+	})
+}
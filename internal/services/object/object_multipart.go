@@ -0,0 +1,414 @@
+package object
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/workerpool"
+)
+
+const (
+	mebibyte = 1 << 20
+
+	defaultMultipartThreshold   = 100
+	defaultMultipartPartSize    = 64
+	defaultMultipartConcurrency = 4
+	defaultMultipartMaxRetries  = 3
+
+	// maxMultipartParts is the maximum number of parts of a multipart upload accepted by Scaleway Object Storage
+	maxMultipartParts = 1000
+	// minMultipartPartSize is the minimum size in MiB of the parts of a multipart upload, except the last one
+	minMultipartPartSize = 5
+	// maxMultipartPartSize is the maximum size in MiB of the parts of a multipart upload
+	maxMultipartPartSize = 5 * 1024
+)
+
+func multipartSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Settings of the multipart upload of large files",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaultMultipartThreshold,
+					ValidateFunc: validation.IntAtLeast(minMultipartPartSize),
+					Description:  "Size in MiB from which files are uploaded in multiple parts",
+				},
+				"part_size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaultMultipartPartSize,
+					ValidateFunc: validation.IntBetween(minMultipartPartSize, maxMultipartPartSize),
+					Description:  "Size in MiB of the parts. It is increased if the file would have more than 1000 parts",
+				},
+				"concurrency": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaultMultipartConcurrency,
+					ValidateFunc: validation.IntBetween(1, 32),
+					Description:  "Number of parts uploaded in parallel",
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaultMultipartMaxRetries,
+					ValidateFunc: validation.IntBetween(0, 10),
+					Description:  "Number of times the upload of a part is retried before failing the whole upload",
+				},
+			},
+		},
+	}
+}
+
+type multipartConfig struct {
+	threshold   int64
+	partSize    int64
+	concurrency int
+	maxRetries  int
+	// retryDelay is the delay before the first retry of a part, it doubles with each retry
+	retryDelay time.Duration
+	// resume is set when the pending multipart uploads of the object were created with the settings of the request,
+	// e.g. by a previous upload which failed. They are aborted otherwise.
+	resume bool
+}
+
+// multipartAPI is the part of the S3 client used to upload objects in multiple parts.
+type multipartAPI interface {
+	s3.ListMultipartUploadsAPIClient
+	s3.ListPartsAPIClient
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+}
+
+func expandMultipartConfig(raw any) multipartConfig {
+	config := multipartConfig{
+		threshold:   defaultMultipartThreshold * mebibyte,
+		partSize:    defaultMultipartPartSize * mebibyte,
+		concurrency: defaultMultipartConcurrency,
+		maxRetries:  defaultMultipartMaxRetries,
+		retryDelay:  time.Second,
+	}
+
+	list, ok := raw.([]any)
+	if !ok || len(list) == 0 || list[0] == nil {
+		return config
+	}
+
+	m := list[0].(map[string]any)
+	config.threshold = int64(m["threshold"].(int)) * mebibyte
+	config.partSize = int64(m["part_size"].(int)) * mebibyte
+	config.concurrency = m["concurrency"].(int)
+	config.maxRetries = m["max_retries"].(int)
+
+	return config
+}
+
+// uploadObjectFile streams a file to an object, with a single request or in multiple parts if it is larger than the
+// multipart threshold. The body of the request is ignored.
+func uploadObjectFile(ctx context.Context, s3Client multipartAPI, req *s3.PutObjectInput, path string, config multipartConfig) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if info.Size() < config.threshold {
+		input := *req
+		input.Body = file
+
+		_, err = s3Client.PutObject(ctx, &input)
+
+		return err
+	}
+
+	return uploadObjectMultipart(ctx, s3Client, req, file, info.Size(), config)
+}
+
+// uploadObjectMultipart uploads a file in parts read directly from the disk. A part which fails to upload is retried
+// alone. If it still fails after the maximum number of retries, the multipart upload is kept in the bucket with its
+// uploaded parts, and the next upload of the object with the same settings resumes it: only the missing or modified
+// parts are uploaded.
+func uploadObjectMultipart(ctx context.Context, s3Client multipartAPI, req *s3.PutObjectInput, file io.ReaderAt, size int64, config multipartConfig) error {
+	partSize := multipartPartSize(size, config.partSize)
+	partCount := int((size + partSize - 1) / partSize)
+
+	var (
+		uploadID      *string
+		uploadedParts map[int32]s3Types.Part
+		err           error
+	)
+
+	if config.resume {
+		uploadID, uploadedParts, err = findObjectMultipart(ctx, s3Client, req)
+	} else {
+		err = abortStaleObjectMultiparts(ctx, s3Client, req)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if uploadID == nil {
+		upload, err := s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+			Bucket:               req.Bucket,
+			Key:                  req.Key,
+			ACL:                  req.ACL,
+			ContentType:          req.ContentType,
+			Metadata:             req.Metadata,
+			StorageClass:         req.StorageClass,
+			SSECustomerAlgorithm: req.SSECustomerAlgorithm,
+			SSECustomerKey:       req.SSECustomerKey,
+			SSECustomerKeyMD5:    req.SSECustomerKeyMD5,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create the multipart upload of %s: %w", aws.ToString(req.Key), err)
+		}
+
+		uploadID = upload.UploadId
+	}
+
+	parts := make([]s3Types.CompletedPart, partCount)
+	pool := workerpool.NewWorkerPool(config.concurrency)
+
+	for i := range partCount {
+		pool.AddTask(func() error {
+			offset := int64(i) * partSize
+			section := io.NewSectionReader(file, offset, min(partSize, size-offset))
+			partNumber := int32(i + 1) //nolint:gosec // bounded by maxMultipartParts
+
+			etag, err := uploadObjectPart(ctx, s3Client, req, uploadID, partNumber, section, uploadedParts[partNumber], config)
+			if err != nil {
+				return err
+			}
+
+			parts[i] = s3Types.CompletedPart{
+				ETag:       etag,
+				PartNumber: aws.Int32(partNumber),
+			}
+
+			return nil
+		})
+	}
+
+	if errs := pool.CloseAndWait(); len(errs) > 0 {
+		return fmt.Errorf("multipart upload %s of %s failed, its uploaded parts are kept and it is resumed on the next upload of the object: %w",
+			aws.ToString(uploadID), aws.ToString(req.Key), errors.Join(errs...))
+	}
+
+	_, err = s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   req.Bucket,
+		Key:      req.Key,
+		UploadId: uploadID,
+		MultipartUpload: &s3Types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		SSECustomerAlgorithm: req.SSECustomerAlgorithm,
+		SSECustomerKey:       req.SSECustomerKey,
+		SSECustomerKeyMD5:    req.SSECustomerKeyMD5,
+	})
+	if err != nil {
+		return abortObjectMultipart(ctx, s3Client, req, uploadID, fmt.Errorf("failed to complete the multipart upload: %w", err))
+	}
+
+	return nil
+}
+
+// multipartPartSize returns the size of the parts of a file, grown so that the file fits in the maximum number of
+// parts and rounded to the next MiB.
+func multipartPartSize(size int64, partSize int64) int64 {
+	return max(partSize, ((size+maxMultipartParts-1)/maxMultipartParts+mebibyte-1)/mebibyte*mebibyte)
+}
+
+// listObjectMultiparts returns the multipart uploads of an object which were neither completed nor aborted.
+func listObjectMultiparts(ctx context.Context, s3Client multipartAPI, req *s3.PutObjectInput) ([]s3Types.MultipartUpload, error) {
+	var objectUploads []s3Types.MultipartUpload
+
+	uploads := s3.NewListMultipartUploadsPaginator(s3Client, &s3.ListMultipartUploadsInput{
+		Bucket: req.Bucket,
+		Prefix: req.Key,
+	})
+
+	for uploads.HasMorePages() {
+		page, err := uploads.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the multipart uploads of %s: %w", aws.ToString(req.Key), err)
+		}
+
+		for _, upload := range page.Uploads {
+			if aws.ToString(upload.Key) == aws.ToString(req.Key) {
+				objectUploads = append(objectUploads, upload)
+			}
+		}
+	}
+
+	return objectUploads, nil
+}
+
+// abortStaleObjectMultiparts aborts the multipart uploads of an object which were created with other settings than
+// the request. The ACL, content type and metadata of an upload cannot be read back, so that such an upload would
+// complete into an object with outdated settings if it were resumed.
+func abortStaleObjectMultiparts(ctx context.Context, s3Client multipartAPI, req *s3.PutObjectInput) error {
+	uploads, err := listObjectMultiparts(ctx, s3Client, req)
+	if err != nil {
+		return err
+	}
+
+	for _, upload := range uploads {
+		_, err := s3Client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   req.Bucket,
+			Key:      req.Key,
+			UploadId: upload.UploadId,
+		})
+		if err != nil && !IsS3Err(err, ErrCodeNoSuchUpload, "") {
+			return fmt.Errorf("failed to abort the stale multipart upload %s of %s: %w", aws.ToString(upload.UploadId), aws.ToString(req.Key), err)
+		}
+	}
+
+	return nil
+}
+
+// findObjectMultipart returns the latest multipart upload of an object which was neither completed nor aborted, with
+// its uploaded parts by number. The upload ID is nil if there is none with the storage class of the request.
+// It must only be used when the uploads of the object were created with the ACL, content type and metadata of the
+// request, as they are not returned by the API.
+func findObjectMultipart(ctx context.Context, s3Client multipartAPI, req *s3.PutObjectInput) (*string, map[int32]s3Types.Part, error) {
+	var latest *s3Types.MultipartUpload
+
+	uploads, err := listObjectMultiparts(ctx, s3Client, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, upload := range uploads {
+		if req.StorageClass != "" && upload.StorageClass != req.StorageClass {
+			continue
+		}
+
+		if latest == nil || aws.ToTime(upload.Initiated).After(aws.ToTime(latest.Initiated)) {
+			latest = &upload
+		}
+	}
+
+	if latest == nil {
+		return nil, nil, nil
+	}
+
+	uploadedParts := map[int32]s3Types.Part{}
+
+	parts := s3.NewListPartsPaginator(s3Client, &s3.ListPartsInput{
+		Bucket:               req.Bucket,
+		Key:                  req.Key,
+		UploadId:             latest.UploadId,
+		SSECustomerAlgorithm: req.SSECustomerAlgorithm,
+		SSECustomerKey:       req.SSECustomerKey,
+		SSECustomerKeyMD5:    req.SSECustomerKeyMD5,
+	})
+
+	for parts.HasMorePages() {
+		page, err := parts.NextPage(ctx)
+		if err != nil {
+			if IsS3Err(err, ErrCodeNoSuchUpload, "") {
+				// The upload was completed or aborted since it was listed
+				return nil, nil, nil
+			}
+
+			return nil, nil, fmt.Errorf("failed to list the parts of the multipart upload %s: %w", aws.ToString(latest.UploadId), err)
+		}
+
+		for _, part := range page.Parts {
+			uploadedParts[aws.ToInt32(part.PartNumber)] = part
+		}
+	}
+
+	return latest.UploadId, uploadedParts, nil
+}
+
+// uploadObjectPart uploads a part of a multipart upload, retrying with an exponential backoff on failure.
+// The MD5 of the part is sent so that corrupted parts are rejected. The part is not uploaded again if the uploaded one
+// has the same size and MD5.
+func uploadObjectPart(ctx context.Context, s3Client multipartAPI, req *s3.PutObjectInput, uploadID *string, partNumber int32, section *io.SectionReader, uploaded s3Types.Part, config multipartConfig) (*string, error) {
+	h := md5.New() //nolint:gosec
+	if _, err := io.Copy(h, section); err != nil {
+		return nil, err
+	}
+
+	digest := h.Sum(nil)
+
+	if aws.ToInt64(uploaded.Size) == section.Size() && strings.Trim(aws.ToString(uploaded.ETag), `"`) == hex.EncodeToString(digest) {
+		return uploaded.ETag, nil
+	}
+
+	contentMD5 := base64.StdEncoding.EncodeToString(digest)
+
+	for attempt := 0; ; attempt++ {
+		if _, err := section.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+
+		part, err := s3Client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:               req.Bucket,
+			Key:                  req.Key,
+			UploadId:             uploadID,
+			PartNumber:           aws.Int32(partNumber),
+			Body:                 section,
+			ContentLength:        aws.Int64(section.Size()),
+			ContentMD5:           aws.String(contentMD5),
+			SSECustomerAlgorithm: req.SSECustomerAlgorithm,
+			SSECustomerKey:       req.SSECustomerKey,
+			SSECustomerKeyMD5:    req.SSECustomerKeyMD5,
+		})
+		if err == nil {
+			return part.ETag, nil
+		}
+
+		if attempt >= config.maxRetries {
+			return nil, fmt.Errorf("failed to upload part %d after %d attempts: %w", partNumber, attempt+1, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to upload part %d: %w", partNumber, ctx.Err())
+		case <-time.After(config.retryDelay << attempt):
+		}
+	}
+}
+
+// abortObjectMultipart aborts a multipart upload so that its parts are not kept in the bucket, and returns the error
+// which caused it.
+func abortObjectMultipart(ctx context.Context, s3Client multipartAPI, req *s3.PutObjectInput, uploadID *string, cause error) error {
+	_, err := s3Client.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+		Bucket:   req.Bucket,
+		Key:      req.Key,
+		UploadId: uploadID,
+	})
+	if err != nil {
+		return fmt.Errorf("multipart upload of %s failed: %w (abort failed: %w)", aws.ToString(req.Key), cause, err)
+	}
+
+	return fmt.Errorf("multipart upload of %s failed: %w", aws.ToString(req.Key), cause)
+}
@@ -0,0 +1,436 @@
+package object
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubMultipartAPI is an in-memory bucket keeping the multipart uploads of its objects.
+type stubMultipartAPI struct {
+	mu sync.Mutex
+
+	uploads []s3Types.MultipartUpload
+	parts   map[string]map[int32]s3Types.Part
+	// failures is the number of times the upload of each part number fails before succeeding
+	failures map[int32]int
+
+	putObjects     int
+	created        []string
+	aborted        []string
+	completed      []string
+	completedParts []s3Types.CompletedPart
+	// uploadedParts are the part numbers of all the UploadPart calls, including the failed ones
+	uploadedParts []int32
+}
+
+func newStubMultipartAPI() *stubMultipartAPI {
+	return &stubMultipartAPI{
+		parts:    map[string]map[int32]s3Types.Part{},
+		failures: map[int32]int{},
+	}
+}
+
+// addUpload adds a pending multipart upload with the given parts.
+func (s *stubMultipartAPI) addUpload(uploadID string, storageClass s3Types.StorageClass, initiated time.Time, parts ...s3Types.Part) {
+	s.uploads = append(s.uploads, s3Types.MultipartUpload{
+		Key:          aws.String("large.bin"),
+		UploadId:     aws.String(uploadID),
+		StorageClass: storageClass,
+		Initiated:    aws.Time(initiated),
+	})
+
+	s.parts[uploadID] = map[int32]s3Types.Part{}
+	for _, part := range parts {
+		s.parts[uploadID][aws.ToInt32(part.PartNumber)] = part
+	}
+}
+
+func (s *stubMultipartAPI) removeUpload(uploadID string) {
+	s.uploads = slices.DeleteFunc(s.uploads, func(upload s3Types.MultipartUpload) bool {
+		return aws.ToString(upload.UploadId) == uploadID
+	})
+	delete(s.parts, uploadID)
+}
+
+func (s *stubMultipartAPI) ListMultipartUploads(_ context.Context, _ *s3.ListMultipartUploadsInput, _ ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return &s3.ListMultipartUploadsOutput{
+		Uploads:     slices.Clone(s.uploads),
+		IsTruncated: aws.Bool(false),
+	}, nil
+}
+
+func (s *stubMultipartAPI) ListParts(_ context.Context, params *s3.ListPartsInput, _ ...func(*s3.Options)) (*s3.ListPartsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	output := &s3.ListPartsOutput{IsTruncated: aws.Bool(false)}
+	for _, part := range s.parts[aws.ToString(params.UploadId)] {
+		output.Parts = append(output.Parts, part)
+	}
+
+	return output, nil
+}
+
+func (s *stubMultipartAPI) PutObject(_ context.Context, _ *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.putObjects++
+
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (s *stubMultipartAPI) CreateMultipartUpload(_ context.Context, params *s3.CreateMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	uploadID := "created-" + strconv.Itoa(len(s.created))
+	s.created = append(s.created, uploadID)
+	s.addUpload(uploadID, params.StorageClass, time.Now())
+
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String(uploadID)}, nil
+}
+
+func (s *stubMultipartAPI) UploadPart(_ context.Context, params *s3.UploadPartInput, _ ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	content, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	partNumber := aws.ToInt32(params.PartNumber)
+	s.uploadedParts = append(s.uploadedParts, partNumber)
+
+	if s.failures[partNumber] > 0 {
+		s.failures[partNumber]--
+
+		return nil, errors.New("connection reset by peer")
+	}
+
+	part := s3Types.Part{
+		PartNumber: params.PartNumber,
+		ETag:       aws.String(partETag(content)),
+		Size:       aws.Int64(int64(len(content))),
+	}
+	s.parts[aws.ToString(params.UploadId)][partNumber] = part
+
+	return &s3.UploadPartOutput{ETag: part.ETag}, nil
+}
+
+func (s *stubMultipartAPI) CompleteMultipartUpload(_ context.Context, params *s3.CompleteMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.completed = append(s.completed, aws.ToString(params.UploadId))
+	s.completedParts = params.MultipartUpload.Parts
+	s.removeUpload(aws.ToString(params.UploadId))
+
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (s *stubMultipartAPI) AbortMultipartUpload(_ context.Context, params *s3.AbortMultipartUploadInput, _ ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.aborted = append(s.aborted, aws.ToString(params.UploadId))
+	s.removeUpload(aws.ToString(params.UploadId))
+
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+// partETag returns the ETag of a part, which is the quoted MD5 of its content.
+func partETag(content []byte) string {
+	digest := md5.Sum(content) //nolint:gosec
+
+	return `"` + hex.EncodeToString(digest[:]) + `"`
+}
+
+// multipartTestFile returns 2.5 MiB of content, uploaded in 3 parts of 1 MiB.
+func multipartTestFile() []byte {
+	return bytes.Repeat([]byte("0123456789abcdef"), 5*mebibyte/2/16)
+}
+
+// multipartTestParts returns the completed parts of the file uploaded in parts of 1 MiB.
+func multipartTestParts(content []byte) []s3Types.CompletedPart {
+	parts := []s3Types.CompletedPart(nil)
+
+	for offset := 0; offset < len(content); offset += mebibyte {
+		parts = append(parts, s3Types.CompletedPart{
+			ETag:       aws.String(partETag(content[offset:min(offset+mebibyte, len(content))])),
+			PartNumber: aws.Int32(int32(offset/mebibyte + 1)), //nolint:gosec
+		})
+	}
+
+	return parts
+}
+
+func multipartTestRequest() *s3.PutObjectInput {
+	return &s3.PutObjectInput{
+		Bucket:       aws.String("bucket"),
+		Key:          aws.String("large.bin"),
+		StorageClass: s3Types.StorageClassStandard,
+	}
+}
+
+func multipartTestConfig() multipartConfig {
+	return multipartConfig{
+		threshold:   mebibyte,
+		partSize:    mebibyte,
+		concurrency: 2,
+		maxRetries:  defaultMultipartMaxRetries,
+		retryDelay:  time.Millisecond,
+	}
+}
+
+func TestMultipartPartSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int64
+		partSize int64
+		want     int64
+	}{
+		{
+			name:     "small file",
+			size:     11 * mebibyte,
+			partSize: 5 * mebibyte,
+			want:     5 * mebibyte,
+		},
+		{
+			name:     "maximum number of parts",
+			size:     5000 * mebibyte,
+			partSize: 5 * mebibyte,
+			want:     5 * mebibyte,
+		},
+		{
+			name:     "more than the maximum number of parts",
+			size:     5000*mebibyte + 1,
+			partSize: 5 * mebibyte,
+			want:     6 * mebibyte,
+		},
+		{
+			name:     "large file",
+			size:     10 * 1024 * mebibyte,
+			partSize: 5 * mebibyte,
+			want:     11 * mebibyte,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			partSize := multipartPartSize(tt.size, tt.partSize)
+			assert.Equal(t, tt.want, partSize)
+			assert.LessOrEqual(t, (tt.size+partSize-1)/partSize, int64(maxMultipartParts))
+		})
+	}
+}
+
+func TestUploadObjectMultipart(t *testing.T) {
+	content := multipartTestFile()
+	parts := multipartTestParts(content)
+	secondPart := content[mebibyte : 2*mebibyte]
+	initiated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	firstPart := s3Types.Part{
+		PartNumber: aws.Int32(1),
+		ETag:       parts[0].ETag,
+		Size:       aws.Int64(mebibyte),
+	}
+	corruptedSecondPart := s3Types.Part{
+		PartNumber: aws.Int32(2),
+		ETag:       aws.String(partETag(secondPart[1:])),
+		Size:       aws.Int64(mebibyte - 1),
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(s *stubMultipartAPI)
+		resume bool
+		// maxRetries is the maximum number of retries of a part, the default one is used if not set
+		maxRetries    int
+		wantErr       string
+		wantCreated   []string
+		wantAborted   []string
+		wantCompleted []string
+		wantUploaded  []int32
+		wantPending   []string
+	}{
+		{
+			name:          "new upload",
+			wantCreated:   []string{"created-0"},
+			wantCompleted: []string{"created-0"},
+			wantUploaded:  []int32{1, 2, 3},
+		},
+		{
+			name: "resumed upload",
+			setup: func(s *stubMultipartAPI) {
+				s.addUpload("older", s3Types.StorageClassStandard, initiated.Add(-time.Hour))
+				s.addUpload("latest", s3Types.StorageClassStandard, initiated, firstPart, corruptedSecondPart)
+			},
+			resume:        true,
+			wantCompleted: []string{"latest"},
+			wantUploaded:  []int32{2, 3},
+			wantPending:   []string{"older"},
+		},
+		{
+			name: "upload with another storage class is not resumed",
+			setup: func(s *stubMultipartAPI) {
+				s.addUpload("glacier", s3Types.StorageClassGlacier, initiated, firstPart)
+			},
+			resume:        true,
+			wantCreated:   []string{"created-0"},
+			wantCompleted: []string{"created-0"},
+			wantUploaded:  []int32{1, 2, 3},
+			wantPending:   []string{"glacier"},
+		},
+		{
+			name: "stale uploads are aborted",
+			setup: func(s *stubMultipartAPI) {
+				s.addUpload("stale", s3Types.StorageClassStandard, initiated, firstPart)
+				s.addUpload("glacier", s3Types.StorageClassGlacier, initiated)
+			},
+			wantCreated:   []string{"created-0"},
+			wantAborted:   []string{"stale", "glacier"},
+			wantCompleted: []string{"created-0"},
+			wantUploaded:  []int32{1, 2, 3},
+		},
+		{
+			name: "failed part is retried",
+			setup: func(s *stubMultipartAPI) {
+				s.failures[2] = 2
+			},
+			wantCreated:   []string{"created-0"},
+			wantCompleted: []string{"created-0"},
+			wantUploaded:  []int32{1, 2, 2, 2, 3},
+		},
+		{
+			name: "failed upload is kept",
+			setup: func(s *stubMultipartAPI) {
+				s.failures[2] = 2
+			},
+			maxRetries:   1,
+			wantErr:      "failed to upload part 2 after 2 attempts: connection reset by peer",
+			wantCreated:  []string{"created-0"},
+			wantUploaded: []int32{1, 2, 2, 3},
+			wantPending:  []string{"created-0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s3Client := newStubMultipartAPI()
+			if tt.setup != nil {
+				tt.setup(s3Client)
+			}
+
+			config := multipartTestConfig()
+			config.resume = tt.resume
+
+			if tt.maxRetries != 0 {
+				config.maxRetries = tt.maxRetries
+			}
+
+			err := uploadObjectMultipart(t.Context(), s3Client, multipartTestRequest(), bytes.NewReader(content), int64(len(content)), config)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, parts, s3Client.completedParts)
+			}
+
+			slices.Sort(s3Client.uploadedParts)
+
+			pending := []string(nil)
+			for _, upload := range s3Client.uploads {
+				pending = append(pending, aws.ToString(upload.UploadId))
+			}
+
+			assert.Equal(t, tt.wantCreated, s3Client.created)
+			assert.Equal(t, tt.wantAborted, s3Client.aborted)
+			assert.Equal(t, tt.wantCompleted, s3Client.completed)
+			assert.Equal(t, tt.wantUploaded, s3Client.uploadedParts)
+			assert.Equal(t, tt.wantPending, pending)
+		})
+	}
+}
+
+func TestUploadObjectPart_Canceled(t *testing.T) {
+	s3Client := newStubMultipartAPI()
+	s3Client.addUpload("upload", s3Types.StorageClassStandard, time.Now())
+	s3Client.failures[1] = 1
+
+	config := multipartTestConfig()
+	config.retryDelay = time.Hour
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	content := []byte("content")
+
+	_, err := uploadObjectPart(ctx, s3Client, multipartTestRequest(), aws.String("upload"), 1, io.NewSectionReader(bytes.NewReader(content), 0, int64(len(content))), s3Types.Part{}, config)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int32{1}, s3Client.uploadedParts)
+}
+
+func TestUploadObjectFile(t *testing.T) {
+	content := multipartTestFile()
+	path := filepath.Join(t.TempDir(), "large.bin")
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+
+	tests := []struct {
+		name          string
+		threshold     int64
+		wantPutObject int
+		wantCompleted []string
+	}{
+		{
+			name:          "below the threshold",
+			threshold:     int64(len(content)) + 1,
+			wantPutObject: 1,
+		},
+		{
+			name:          "above the threshold",
+			threshold:     int64(len(content)),
+			wantCompleted: []string{"created-0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s3Client := newStubMultipartAPI()
+			config := multipartTestConfig()
+			config.threshold = tt.threshold
+
+			require.NoError(t, uploadObjectFile(t.Context(), s3Client, multipartTestRequest(), path, config))
+			assert.Equal(t, tt.wantPutObject, s3Client.putObjects)
+			assert.Equal(t, tt.wantCompleted, s3Client.completed)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package object_test
 
 import (
+	"bytes"
+	"crypto/md5" //nolint:gosec
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
@@ -10,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+	"github.com/stretchr/testify/require"
 )
 
 // // Service information constants
@@ -826,3 +831,57 @@ func TestAccObject_Encryption(t *testing.T) {
 		},
 	})
 }
+
+func TestAccObject_Multipart(t *testing.T) {
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	// 11 MiB, uploaded in 3 parts of 5 MiB. The file is generated at a stable path so that the configuration matches
+	// the recorded cassette.
+	content := bytes.Repeat([]byte("0123456789abcdef"), 11*1024*64)
+	filePath := "testfixture/multipart.bin"
+	require.NoError(t, os.WriteFile(filePath, content, 0o600))
+	t.Cleanup(func() {
+		_ = os.Remove(filePath)
+	})
+
+	hash := md5.Sum(content) //nolint:gosec
+
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-scaleway-object-multipart")
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			objectchecks.IsObjectDestroyed(tt),
+			objectchecks.IsBucketDestroyed(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "base-01" {
+						name   = "%s"
+						region = "%s"
+					}
+
+					resource scaleway_object "file" {
+						bucket           = scaleway_object_bucket.base-01.id
+						key              = "large.bin"
+						file             = "%s"
+						storage_class    = "ONEZONE_IA"
+						sse_customer_key = "%s"
+
+						multipart {
+							threshold   = 5
+							part_size   = 5
+							concurrency = 2
+						}
+					}
+				`, bucketName, objectTestsMainRegion, filePath, encryptionStr),
+				Check: resource.ComposeTestCheckFunc(
+					objectchecks.CheckBucketExists(tt, "scaleway_object_bucket.base-01", true),
+					resource.TestCheckResourceAttr("scaleway_object.file", "hash", hex.EncodeToString(hash[:])),
+					resource.TestCheckResourceAttr("scaleway_object.file", "storage_class", "ONEZONE_IA"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,293 @@
+package object
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+const (
+	presignedURLDefaultExpiresIn = 3600
+	// presignedURLMaxExpiresIn is the maximum validity of a presigned URL allowed by the signature v4, 7 days
+	presignedURLMaxExpiresIn = 7 * 24 * 3600
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*PresignedURLEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*PresignedURLEphemeralResource)(nil)
+)
+
+type PresignedURLEphemeralResource struct {
+	meta *meta.Meta
+}
+
+func NewPresignedURLEphemeralResource() ephemeral.EphemeralResource {
+	return &PresignedURLEphemeralResource{}
+}
+
+func (r *PresignedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	m, ok := req.ProviderData.(*meta.Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *meta.Meta, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.meta = m
+}
+
+func (r *PresignedURLEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_presigned_url"
+}
+
+type PresignedURLEphemeralResourceModel struct {
+	Bucket         types.String `tfsdk:"bucket"`
+	Key            types.String `tfsdk:"key"`
+	Method         types.String `tfsdk:"method"`
+	ExpiresIn      types.Int64  `tfsdk:"expires_in"`
+	SSECustomerKey types.String `tfsdk:"sse_customer_key"`
+	Region         types.String `tfsdk:"region"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	// Output
+	URL       types.String `tfsdk:"url"`
+	Headers   types.Map    `tfsdk:"headers"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+//go:embed descriptions/presigned_url_ephemeral_resource.md
+var presignedURLEphemeralResourceDescription string
+
+func (r *PresignedURLEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         presignedURLEphemeralResourceDescription,
+		MarkdownDescription: presignedURLEphemeralResourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket, or its regional ID.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the object.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Description: "The HTTP method allowed by the URL, `GET` to download the object or `PUT` to upload it. Defaults to `GET`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodGet, http.MethodPut),
+				},
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of seconds the URL is valid for, up to 7 days. Defaults to 3600.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, presignedURLMaxExpiresIn),
+				},
+			},
+			"sse_customer_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Customer's encryption key of the object (SSE-C). The headers to send along with the URL are returned in `headers`.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(32, 32),
+				},
+			},
+			"region": regional.SchemaAttribute("The region of the bucket. If not set, the region is derived from the bucket when possible or from the provider configuration."),
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The project ID of the bucket. Defaults to the project ID of the provider configuration.",
+				Validators: []validator.String{
+					verify.IsStringUUID(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL of the object.",
+			},
+			"headers": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "The headers that must be sent along with the URL, apart from `host`.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time of the URL's expiration (RFC 3339 format).",
+			},
+		},
+	}
+}
+
+func (r *PresignedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data PresignedURLEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.meta == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured S3 client",
+			"The ephemeral resource was not properly configured. The Scaleway client is missing. "+
+				"This is usually a bug in the provider. Please report it to the maintainers.",
+		)
+
+		return
+	}
+
+	regionalID := regional.ExpandID(data.Bucket.ValueString())
+	bucket := regionalID.ID
+
+	var region scw.Region
+
+	switch {
+	case !data.Region.IsNull() && data.Region.ValueString() != "":
+		region = scw.Region(data.Region.ValueString())
+	case regionalID.Region != "":
+		region = regionalID.Region
+	default:
+		defaultRegion, exists := r.meta.ScwClient().GetDefaultRegion()
+		if !exists {
+			resp.Diagnostics.AddError(
+				"Missing region",
+				"The region attribute is required to presign an URL. Please provide it explicitly or configure a default region in the provider.",
+			)
+
+			return
+		}
+
+		region = defaultRegion
+	}
+
+	method := http.MethodGet
+	if !data.Method.IsNull() && data.Method.ValueString() != "" {
+		method = data.Method.ValueString()
+	}
+
+	expiresIn := int64(presignedURLDefaultExpiresIn)
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = data.ExpiresIn.ValueInt64()
+	}
+
+	expires := time.Duration(expiresIn) * time.Second
+
+	presignClient, err := NewS3PresignClientFromMeta(ctx, r.meta, region.String(), data.ProjectID.ValueString(), expires)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating S3 client",
+			fmt.Sprintf("Failed to create the S3 client: %s", err),
+		)
+
+		return
+	}
+
+	var (
+		sseCustomerAlgorithm *string
+		sseCustomerKey       *string
+		sseCustomerKeyMD5    *string
+	)
+
+	if !data.SSECustomerKey.IsNull() && data.SSECustomerKey.ValueString() != "" {
+		digestMD5, encryption, err := EncryptCustomerKey(data.SSECustomerKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error encoding the SSE-C key",
+				fmt.Sprintf("%s", err),
+			)
+
+			return
+		}
+
+		sseCustomerAlgorithm = aws.String("AES256")
+		sseCustomerKey = encryption
+		sseCustomerKeyMD5 = &digestMD5
+	}
+
+	var presigned *v4.PresignedHTTPRequest
+
+	expiresAt := time.Now().Add(expires)
+
+	switch method {
+	case http.MethodPut:
+		presigned, err = presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+			Bucket:               aws.String(bucket),
+			Key:                  aws.String(data.Key.ValueString()),
+			SSECustomerAlgorithm: sseCustomerAlgorithm,
+			SSECustomerKey:       sseCustomerKey,
+			SSECustomerKeyMD5:    sseCustomerKeyMD5,
+		})
+	default:
+		presigned, err = presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+			Bucket:               aws.String(bucket),
+			Key:                  aws.String(data.Key.ValueString()),
+			SSECustomerAlgorithm: sseCustomerAlgorithm,
+			SSECustomerKey:       sseCustomerKey,
+			SSECustomerKeyMD5:    sseCustomerKeyMD5,
+		})
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error presigning object URL",
+			fmt.Sprintf("Failed to presign the %s URL of object %s/%s: %s", method, bucket, data.Key.ValueString(), err),
+		)
+
+		return
+	}
+
+	headers := make(map[string]string, len(presigned.SignedHeader))
+
+	for name, values := range presigned.SignedHeader {
+		// The host header is set by the HTTP clients from the URL
+		if strings.EqualFold(name, "host") {
+			continue
+		}
+
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+
+	headersValue, diags := types.MapValueFrom(ctx, types.StringType, headers)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.URL = types.StringValue(presigned.URL)
+	data.Headers = headersValue
+	data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+
+	resp.Result.Set(ctx, &data)
+}
@@ -0,0 +1,91 @@
+package object_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	objectchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+	secrettestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/secret/testfuncs"
+)
+
+func TestAccObjectPresignedURLEphemeralResource_Basic(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccObjectPresignedURLEphemeralResource_Basic because testing Ephemeral Resources is not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	bucketName := sdkacctest.RandomWithPrefix("tf-tests-scaleway-object-presigned-url")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			objectchecks.IsBucketDestroyed(tt),
+			secrettestfuncs.CheckSecretDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name   = %[1]q
+						region = %[2]q
+					}
+
+					resource "scaleway_object" "file" {
+						bucket  = scaleway_object_bucket.main.id
+						key     = "artifacts/build.txt"
+						content = "build output"
+					}
+
+					ephemeral "scaleway_object_presigned_url" "download" {
+						bucket     = scaleway_object_bucket.main.id
+						key        = scaleway_object.file.key
+						expires_in = 600
+					}
+
+					resource "scaleway_secret" "main" {
+						name = "tf-tests-object-presigned-url"
+					}
+
+					resource "scaleway_secret_version" "url" {
+						secret_id = scaleway_secret.main.id
+						data_wo   = ephemeral.scaleway_object_presigned_url.download.url
+					}
+				`, bucketName, objectTestsMainRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_secret_version.url", "revision", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectPresignedURLEphemeralResource_InvalidMethod(t *testing.T) {
+	if acctest.IsRunningOpenTofu() {
+		t.Skip("Skipping TestAccObjectPresignedURLEphemeralResource_InvalidMethod because testing Ephemeral Resources is not yet supported on OpenTofu")
+	}
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					ephemeral "scaleway_object_presigned_url" "main" {
+						bucket = "some-bucket"
+						key    = "some-key"
+						method = "DELETE"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -283,3 +284,44 @@ func IsObjectExists(tt *acctest.TestTools, n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// IsObjectDirectoryUploaded checks that the objects in the manifest of a scaleway_object_directory exist, with the given
+// content types by key.
+func IsObjectDirectoryUploaded(tt *acctest.TestTools, n string, contentTypes map[string]string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		regionalID := regional.ExpandID(rs.Primary.Attributes["bucket"])
+
+		s3Client, err := object.NewS3ClientFromMeta(ctx, tt.Meta, regionalID.Region.String())
+		if err != nil {
+			return err
+		}
+
+		for attribute := range rs.Primary.Attributes {
+			key, isManifestKey := strings.CutPrefix(attribute, "manifest.")
+			if !isManifestKey || key == "%" {
+				continue
+			}
+
+			obj, err := s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+				Bucket: new(regionalID.ID),
+				Key:    new(key),
+			})
+			if err != nil {
+				return fmt.Errorf("object %s not found: %w", key, err)
+			}
+
+			if expected, ok := contentTypes[key]; ok && aws.ToString(obj.ContentType) != expected {
+				return fmt.Errorf("object %s has content type %q, expected %q", key, aws.ToString(obj.ContentType), expected)
+			}
+		}
+
+		return nil
+	}
+}
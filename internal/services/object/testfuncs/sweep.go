@@ -12,7 +12,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_object_bucket", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_object_bucket", &resource.Sweeper{
 		Name: "scaleway_object_bucket",
 		F:    testSweepStorageObjectBucket,
 	})
@@ -39,7 +39,7 @@ func testSweepStorageObjectBucket(_ string) error {
 		for _, bucket := range listBucketResponse.Buckets {
 			logging.L.Debugf("Deleting %q bucket", *bucket.Name)
 
-			if acctest.IsTestResource(*bucket.Name) {
+			if acctest.IsTestResource(*bucket.Name) && acctest.ShouldSweep("object bucket", region.String(), *bucket.Name, bucket.CreationDate, nil) {
 				_, err := s3client.DeleteBucket(ctx, &s3.DeleteBucketInput{
 					Bucket: bucket.Name,
 				})
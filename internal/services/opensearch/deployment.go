@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -32,8 +33,9 @@ func ResourceDeployment() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
-		SchemaFunc: deploymentSchema,
-		Identity:   identity.DefaultRegional(),
+		SchemaFunc:    deploymentSchema,
+		CustomizeDiff: tags.CustomizeDiff,
+		Identity:      identity.DefaultRegional(),
 	}
 }
 
@@ -53,6 +55,7 @@ func deploymentSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags to apply",
 		},
+		"tags_all": tags.AllSchema(),
 		"version": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -222,8 +225,8 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		NodeType:  d.Get("node_type").(string),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		req.Tags = types.ExpandStrings(v)
+	if deploymentTags := tags.Expand(d, meta); len(deploymentTags) > 0 {
+		req.Tags = deploymentTags
 	}
 
 	if v, ok := d.GetOk("user_name"); ok {
@@ -303,7 +306,7 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(err)
 	}
 
-	diags := setDeploymentState(d, deployment)
+	diags := setDeploymentState(d, meta, deployment)
 
 	err = identity.SetRegionalIdentity(d, deployment.Region, deployment.ID)
 	if err != nil {
@@ -313,11 +316,11 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta an
 	return diags
 }
 
-func setDeploymentState(d *schema.ResourceData, deployment *searchdbapi.Deployment) diag.Diagnostics {
+func setDeploymentState(d *schema.ResourceData, m any, deployment *searchdbapi.Deployment) diag.Diagnostics {
 	_ = d.Set("region", string(deployment.Region))
 	_ = d.Set("project_id", deployment.ProjectID)
 	_ = d.Set("name", deployment.Name)
-	_ = d.Set("tags", types.FlattenSliceString(deployment.Tags))
+	tags.Set(d, m, deployment.Tags)
 	_ = d.Set("version", deployment.Version)
 	setDeploymentNodeCountState(d, deployment)
 	_ = d.Set("node_type", deployment.NodeType)
@@ -401,7 +404,7 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("name", "tags") {
+	if d.HasChanges("name", "tags", "tags_all") {
 		req := &searchdbapi.UpdateDeploymentRequest{
 			Region:       region,
 			DeploymentID: id,
@@ -413,8 +416,8 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			changed = true
 		}
 
-		if d.HasChange("tags") {
-			req.Tags = new(types.ExpandStrings(d.Get("tags")))
+		if tags.HasChange(d) {
+			req.Tags = tags.ExpandUpdated(d, meta)
 			changed = true
 		}
 
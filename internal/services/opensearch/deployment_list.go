@@ -206,7 +206,7 @@ func (r *DeploymentListResource) List(ctx context.Context, req list.ListRequest,
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			diagsState := setDeploymentState(resourceData, deployment)
+			diagsState := setDeploymentState(resourceData, r.meta, deployment)
 			if diagsState.HasError() {
 				tflog.Error(ctx, "error from setting setDeploymentState")
 
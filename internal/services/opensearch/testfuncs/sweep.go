@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_opensearch_deployment", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_opensearch_deployment", &resource.Sweeper{
 		Name: "scaleway_opensearch_deployment",
 		F:    testSweepOpenSearchDeployment,
 	})
@@ -17,8 +17,8 @@ func waitForDeployment(
 	timeout time.Duration,
 ) (*searchdbapi.Deployment, error) {
 	retryInterval := defaultWaitRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	var deployment *searchdbapi.Deployment
@@ -19,7 +19,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("scaleway_rdb_database_backup", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_rdb_database_backup", &resource.Sweeper{
 		Name: "scaleway_rdb_database_backup",
 		F:    testSweepDatabaseBackup,
 	})
@@ -169,7 +169,7 @@ func (a *DatabaseBackupExportAction) Invoke(ctx context.Context, req action.Invo
 	}
 
 	if data.Wait.ValueBool() {
-		_, err = waitForRDBDatabaseBackup(ctx, a.rdbAPI, region, backupID, defaultInstanceTimeout)
+		_, err = waitForRDBDatabaseBackup(ctx, a.rdbAPI, region, backupID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaultInstanceTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error waiting for RDB database backup export completion",
@@ -197,7 +197,7 @@ func (a *DatabaseBackupRestoreAction) Invoke(ctx context.Context, req action.Inv
 	}
 
 	if data.Wait.ValueBool() {
-		_, err = waitForRDBInstance(ctx, a.rdbAPI, region, instanceID, defaultInstanceTimeout)
+		_, err = waitForRDBInstance(ctx, a.rdbAPI, region, instanceID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaultInstanceTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error waiting for RDB database backup restore completion",
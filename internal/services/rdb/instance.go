@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ipamAPI "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
@@ -24,6 +25,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -48,9 +50,12 @@ func ResourceInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		SchemaVersion:    0,
-		SchemaFunc:       instanceSchema,
-		CustomizeDiff:    cdf.LocalityCheck("private_network.#.pn_id"),
+		SchemaVersion: 0,
+		SchemaFunc:    instanceSchema,
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("private_network.#.pn_id"),
+			tags.CustomizeDiff,
+		),
 		Identity:         identity.DefaultRegional(),
 		ResourceBehavior: schema.ResourceBehavior{MutableIdentity: true},
 	}
@@ -173,6 +178,7 @@ func instanceSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags [\"tag1\", \"tag2\", ...] attached to a database instance",
 		},
+		"tags_all": tags.AllSchema(),
 		"volume_type": {
 			Type:             schema.TypeString,
 			Default:          rdb.VolumeTypeLssd,
@@ -489,13 +495,12 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m an
 			return diag.FromErr(err)
 		}
 
-		rawTag, tagExist := d.GetOk("tags")
-		if tagExist {
+		if instanceTags := tags.Expand(d, m); len(instanceTags) > 0 {
 			updateReq := &rdb.UpdateInstanceRequest{
 				Region:     region,
 				InstanceID: res.ID,
 			}
-			updateReq.Tags = new(types.ExpandStrings(rawTag))
+			updateReq.Tags = &instanceTags
 
 			_, err = rdbAPI.UpdateInstance(updateReq, scw.WithContext(ctx))
 			if err != nil {
@@ -561,9 +566,8 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m an
 			createReq.InitSettings = expandInstanceSettings(initSettings)
 		}
 
-		rawTag, tagExist := d.GetOk("tags")
-		if tagExist {
-			createReq.Tags = types.ExpandStrings(rawTag)
+		if instanceTags := tags.Expand(d, m); len(instanceTags) > 0 {
+			createReq.Tags = instanceTags
 		}
 
 		// Init Endpoints
@@ -776,7 +780,7 @@ func setInstanceState(ctx context.Context, d *schema.ResourceData, m any, rdbAPI
 	_ = d.Set("backup_schedule_frequency", int(res.BackupSchedule.Frequency))
 	_ = d.Set("backup_schedule_retention", int(res.BackupSchedule.Retention))
 	_ = d.Set("backup_same_region", res.BackupSameRegion)
-	_ = d.Set("tags", types.FlattenSliceString(res.Tags))
+	tags.Set(d, m, res.Tags)
 
 	var loadBalancerEndpoint *rdb.Endpoint
 
@@ -1257,8 +1261,8 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m an
 		req.BackupSameRegion = types.ExpandBoolPtr(d.Get("backup_same_region"))
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("logs_policy") {
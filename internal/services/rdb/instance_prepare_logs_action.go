@@ -208,7 +208,7 @@ func (a *InstanceLogPrepareAction) Invoke(ctx context.Context, req action.Invoke
 	}
 
 	if data.Wait.ValueBool() {
-		_, err = waitForRDBInstance(ctx, a.rdbAPI, region, instanceID, defaultInstanceTimeout)
+		_, err = waitForRDBInstance(ctx, a.rdbAPI, region, instanceID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaultInstanceTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error waiting for RDB log preparation completion",
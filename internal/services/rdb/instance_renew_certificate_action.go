@@ -169,7 +169,7 @@ func (a *InstanceCertificateRenewAction) Invoke(ctx context.Context, req action.
 	}
 
 	if data.Wait.ValueBool() {
-		_, err = waitForRDBInstance(ctx, a.rdbAPI, region, instanceID, defaultInstanceTimeout)
+		_, err = waitForRDBInstance(ctx, a.rdbAPI, region, instanceID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaultInstanceTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error waiting for RDB certificate renewal completion",
@@ -171,7 +171,7 @@ func (a *ReadReplicaPromoteAction) Invoke(ctx context.Context, req action.Invoke
 	if data.Wait.ValueBool() {
 		newInstanceID := promotedInstance.ID
 
-		_, err = waitForRDBInstance(ctx, a.rdbAPI, region, newInstanceID, defaultInstanceTimeout)
+		_, err = waitForRDBInstance(ctx, a.rdbAPI, region, newInstanceID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaultInstanceTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error waiting for RDB read replica promotion completion",
@@ -169,7 +169,7 @@ func (a *ReadReplicaResetAction) Invoke(ctx context.Context, req action.InvokeRe
 	}
 
 	if data.Wait.ValueBool() {
-		_, err = waitForRDBReadReplica(ctx, a.rdbAPI, region, readReplicaID, defaultInstanceTimeout)
+		_, err = waitForRDBReadReplica(ctx, a.rdbAPI, region, readReplicaID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaultInstanceTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error waiting for RDB read replica reset completion",
@@ -219,7 +219,7 @@ func (a *InstanceSnapshotAction) Invoke(ctx context.Context, req action.InvokeRe
 			return
 		}
 
-		_, err = waitForRDBSnapshot(ctx, a.rdbAPI, waitRegion, snapshot.ID, defaultInstanceTimeout)
+		_, err = waitForRDBSnapshot(ctx, a.rdbAPI, waitRegion, snapshot.ID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaultInstanceTimeout))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error waiting for RDB snapshot completion",
@@ -9,11 +9,11 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_rdb_instance", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_rdb_instance", &resource.Sweeper{
 		Name: "scaleway_rdb_instance",
 		F:    testSweepInstance,
 	})
-	resource.AddTestSweepers("scaleway_rdb_snapshot", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_rdb_snapshot", &resource.Sweeper{
 		Name: "scaleway_rdb_snapshot",
 		F:    testSweepInstanceSnapshots,
 	})
@@ -23,6 +23,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -52,6 +53,7 @@ func ResourceCluster() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			cdf.LocalityCheck("private_network.#.id"),
 			customizeDiffMigrateClusterSize(),
+			tags.CustomizeDiff,
 		),
 	}
 }
@@ -109,6 +111,7 @@ func clusterSchema() map[string]*schema.Schema {
 			},
 			Description: "List of tags [\"tag1\", \"tag2\", ...] attached to a redis cluster",
 		},
+		"tags_all": tags.AllSchema(),
 		"cluster_size": {
 			Type:        schema.TypeInt,
 			Optional:    true,
@@ -329,9 +332,8 @@ func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m any) d
 		Password:  password,
 	}
 
-	tags, tagsExist := d.GetOk("tags")
-	if tagsExist {
-		createReq.Tags = types.ExpandStrings(tags)
+	if clusterTags := tags.Expand(d, m); len(clusterTags) > 0 {
+		createReq.Tags = clusterTags
 	}
 
 	clusterSize, clusterSizeExist := d.GetOk("cluster_size")
@@ -436,9 +438,7 @@ func setClusterState(ctx context.Context, d *schema.ResourceData, redisAPI *redi
 	_ = d.Set("acl", flattenACLs(cluster.ACLRules))
 	_ = d.Set("settings", flattenSettings(cluster.ClusterSettings))
 
-	if len(cluster.Tags) > 0 {
-		_ = d.Set("tags", cluster.Tags)
-	}
+	tags.Set(d, m, cluster.Tags)
 
 	// set endpoints
 	allPrivateIPs := []map[string]any(nil)
@@ -599,8 +599,8 @@ func ResourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m any) d
 		}
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("acl") {
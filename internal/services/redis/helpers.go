@@ -49,8 +49,8 @@ func NewAPIWithZoneAndID(m any, id string) (*redis.API, scw.Zone, string, error)
 
 func waitForCluster(ctx context.Context, api *redis.API, zone scw.Zone, id string, timeout time.Duration) (*redis.Cluster, error) {
 	retryInterval := defaultWaitRedisClusterRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return api.WaitForCluster(&redis.WaitForClusterRequest{
@@ -10,7 +10,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_redis_cluster", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_redis_cluster", &resource.Sweeper{
 		Name: "scaleway_redis_cluster",
 		F:    testSweepRedisCluster,
 	})
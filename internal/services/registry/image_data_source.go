@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -129,7 +130,7 @@ func DataSourceRegistryImageRead(ctx context.Context, d *schema.ResourceData, m
 	_ = d.Set("namespace_id", image.NamespaceID)
 	_ = d.Set("visibility", image.Visibility.String())
 	_ = d.Set("size", int(image.Size))
-	_ = d.Set("tags", image.Tags)
+	_ = d.Set("tags", tags.Flatten(m, image.Tags))
 	_ = d.Set("updated_at", types.FlattenTime(image.UpdatedAt))
 
 	return nil
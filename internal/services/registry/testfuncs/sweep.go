@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_registry_namespace", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_registry_namespace", &resource.Sweeper{
 		Name: "scaleway_registry_namespace",
 		F:    testSweepNamespace,
 	})
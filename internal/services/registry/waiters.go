@@ -12,8 +12,8 @@ import (
 
 func WaitForNamespace(ctx context.Context, api *registry.API, region scw.Region, id string, timeout time.Duration) (*registry.Namespace, error) {
 	retryInterval := defaultNamespaceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	ns, err := api.WaitForNamespace(&registry.WaitForNamespaceRequest{
@@ -28,8 +28,8 @@ func WaitForNamespace(ctx context.Context, api *registry.API, region scw.Region,
 
 func waitForNamespaceDelete(ctx context.Context, api *registry.API, region scw.Region, id string, timeout time.Duration) (*registry.Namespace, error) {
 	retryInterval := defaultNamespaceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	terminalStatus := map[registry.NamespaceStatus]struct{}{
@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -27,6 +28,7 @@ func ResourceConnection() *schema.Resource {
 		Identity:      identity.DefaultRegional(),
 		SchemaVersion: 0,
 		SchemaFunc:    connectionSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -46,6 +48,7 @@ func connectionSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"is_ipv6": {
 			Type:        schema.TypeBool,
 			Computed:    true,
@@ -264,7 +267,7 @@ func ResourceConnectionCreate(ctx context.Context, d *schema.ResourceData, m any
 		Region:                 region,
 		ProjectID:              d.Get("project_id").(string),
 		Name:                   types.ExpandOrGenerateString(d.Get("name").(string), "connection"),
-		Tags:                   types.ExpandStrings(d.Get("tags")),
+		Tags:                   tags.Expand(d, m),
 		IsIPv6:                 d.Get("is_ipv6").(bool),
 		EnableRoutePropagation: d.Get("enable_route_propagation").(bool),
 		InitiationPolicy:       s2s_vpn.CreateConnectionRequestInitiationPolicy(d.Get("initiation_policy").(string)),
@@ -315,7 +318,7 @@ func ResourceConnectionRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	diags := setConnectionState(d, connection)
+	diags := setConnectionState(d, m, connection)
 
 	err = identity.SetRegionalIdentity(d, connection.Region, connection.ID)
 	if err != nil {
@@ -325,12 +328,12 @@ func ResourceConnectionRead(ctx context.Context, d *schema.ResourceData, m any)
 	return diags
 }
 
-func setConnectionState(d *schema.ResourceData, connection *s2s_vpn.Connection) diag.Diagnostics {
+func setConnectionState(d *schema.ResourceData, m any, connection *s2s_vpn.Connection) diag.Diagnostics {
 	_ = d.Set("name", connection.Name)
 	_ = d.Set("region", connection.Region)
 	_ = d.Set("project_id", connection.ProjectID)
 	_ = d.Set("organization_id", connection.OrganizationID)
-	_ = d.Set("tags", connection.Tags)
+	tags.Set(d, m, connection.Tags)
 	_ = d.Set("created_at", types.FlattenTime(connection.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(connection.UpdatedAt))
 	_ = d.Set("status", connection.Status.String())
@@ -382,8 +385,8 @@ func ResourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, m any
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -159,7 +159,7 @@ func (a *ConnectionEnableRoutePropagationAction) Invoke(ctx context.Context, req
 		return
 	}
 
-	_, err = waitForVPNGateway(ctx, a.s2svpnAPI, region, connection.VpnGatewayID, defaulVPNGatewayTimeout)
+	_, err = waitForVPNGateway(ctx, a.s2svpnAPI, region, connection.VpnGatewayID, meta.TimeoutOr(a.meta.DefaultTimeouts().Update, defaulVPNGatewayTimeout))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error waiting for VPN gateway",
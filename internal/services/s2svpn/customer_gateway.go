@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -26,6 +27,7 @@ func ResourceCustomerGateway() *schema.Resource {
 		Identity:      identity.DefaultRegional(),
 		SchemaVersion: 0,
 		SchemaFunc:    customerGatewaySchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -45,6 +47,7 @@ func customerGatewaySchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"ipv4_public": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -92,7 +95,7 @@ func ResourceCustomerGatewayCreate(ctx context.Context, d *schema.ResourceData,
 		Region:    region,
 		ProjectID: d.Get("project_id").(string),
 		Name:      types.ExpandOrGenerateString(d.Get("name").(string), "connection"),
-		Tags:      types.ExpandStrings(d.Get("tags")),
+		Tags:      tags.Expand(d, m),
 		Asn:       uint32(d.Get("asn").(int)),
 	}
 
@@ -147,7 +150,7 @@ func ResourceCustomerGatewayRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	diags := setCustomerGatewayState(d, gateway)
+	diags := setCustomerGatewayState(d, m, gateway)
 
 	err = identity.SetRegionalIdentity(d, gateway.Region, gateway.ID)
 	if err != nil {
@@ -157,11 +160,11 @@ func ResourceCustomerGatewayRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
-func setCustomerGatewayState(d *schema.ResourceData, gateway *s2s_vpn.CustomerGateway) diag.Diagnostics {
+func setCustomerGatewayState(d *schema.ResourceData, m any, gateway *s2s_vpn.CustomerGateway) diag.Diagnostics {
 	_ = d.Set("name", gateway.Name)
 	_ = d.Set("project_id", gateway.ProjectID)
 	_ = d.Set("organization_id", gateway.OrganizationID)
-	_ = d.Set("tags", gateway.Tags)
+	tags.Set(d, m, gateway.Tags)
 	_ = d.Set("created_at", types.FlattenTime(gateway.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(gateway.UpdatedAt))
 	_ = d.Set("ipv4_public", types.FlattenIPPtr(gateway.PublicIPv4))
@@ -190,8 +193,8 @@ func ResourceCustomerGatewayUpdate(ctx context.Context, d *schema.ResourceData,
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -9,24 +9,24 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_s2s_vpn_connection", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_s2s_vpn_connection", &resource.Sweeper{
 		Name: "scaleway_s2s_vpn_connection",
 		F:    testSweepConnection,
 	})
 
-	resource.AddTestSweepers("scaleway_s2s_vpn_gateway", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_s2s_vpn_gateway", &resource.Sweeper{
 		Name:         "scaleway_s2s_vpn_gateway",
 		F:            testSweepVPNGateway,
 		Dependencies: []string{"scaleway_s2s_vpn_connection"},
 	})
 
-	resource.AddTestSweepers("scaleway_s2s_vpn_customer_gateway", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_s2s_vpn_customer_gateway", &resource.Sweeper{
 		Name:         "scaleway_s2s_vpn_customer_gateway",
 		F:            testSweepCustomerGateway,
 		Dependencies: []string{"scaleway_s2s_vpn_connection"},
 	})
 
-	resource.AddTestSweepers("scaleway_s2s_vpn_routing_policy", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_s2s_vpn_routing_policy", &resource.Sweeper{
 		Name:         "scaleway_s2s_vpn_routing_policy",
 		F:            testSweepRoutingPolicy,
 		Dependencies: []string{"scaleway_s2s_vpn_connection"},
@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -36,6 +37,7 @@ func ResourceVPNGateway() *schema.Resource {
 		Identity:      identity.DefaultRegional(),
 		SchemaVersion: 0,
 		SchemaFunc:    vpnGatewaySchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -56,6 +58,7 @@ func vpnGatewaySchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"gateway_type": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -145,7 +148,7 @@ func ResourceVPNGatewayCreate(ctx context.Context, d *schema.ResourceData, m any
 		Region:            region,
 		ProjectID:         d.Get("project_id").(string),
 		Name:              types.ExpandOrGenerateString(d.Get("name").(string), "connection"),
-		Tags:              types.ExpandStrings(d.Get("tags")),
+		Tags:              tags.Expand(d, m),
 		GatewayType:       d.Get("gateway_type").(string),
 		PrivateNetworkID:  regional.ExpandID(d.Get("private_network_id").(string)).ID,
 		IpamPrivateIPv4ID: types.ExpandStringPtr(regional.ExpandID(d.Get("ipam_private_ipv4_id").(string)).ID),
@@ -174,12 +177,12 @@ func ResourceVPNGatewayCreate(ctx context.Context, d *schema.ResourceData, m any
 	return ResourceVPNGatewayRead(ctx, d, m)
 }
 
-func setVPNGatewayState(d *schema.ResourceData, gateway *s2svpn.VpnGateway) diag.Diagnostics {
+func setVPNGatewayState(d *schema.ResourceData, m any, gateway *s2svpn.VpnGateway) diag.Diagnostics {
 	_ = d.Set("name", gateway.Name)
 	_ = d.Set("region", gateway.Region)
 	_ = d.Set("project_id", gateway.ProjectID)
 	_ = d.Set("organization_id", gateway.OrganizationID)
-	_ = d.Set("tags", gateway.Tags)
+	tags.Set(d, m, gateway.Tags)
 	_ = d.Set("created_at", types.FlattenTime(gateway.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(gateway.UpdatedAt))
 	_ = d.Set("asn", int(gateway.Asn))
@@ -211,7 +214,7 @@ func ResourceVPNGatewayRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	diags := setVPNGatewayState(d, gateway)
+	diags := setVPNGatewayState(d, m, gateway)
 
 	err = identity.SetRegionalIdentity(d, gateway.Region, gateway.ID)
 	if err != nil {
@@ -239,8 +242,8 @@ func ResourceVPNGatewayUpdate(ctx context.Context, d *schema.ResourceData, m any
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		req.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
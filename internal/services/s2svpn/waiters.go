@@ -16,8 +16,8 @@ const (
 
 func waitForVPNGateway(ctx context.Context, api *s2s_vpn.API, region scw.Region, vpngwID string, timeout time.Duration) (*s2s_vpn.VpnGateway, error) {
 	retryInterval := defaultVPNGatewayRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	server, err := api.WaitForVpnGateway(&s2s_vpn.WaitForVpnGatewayRequest{
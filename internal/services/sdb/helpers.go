@@ -43,8 +43,8 @@ func NewAPIWithRegionAndID(m any, regionalID string) (*sdbSDK.API, scw.Region, s
 
 func waitForDatabase(ctx context.Context, sdbAPI *sdbSDK.API, region scw.Region, id string, timeout time.Duration) (*sdbSDK.Database, error) {
 	retryInterval := function.DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	database, err := sdbAPI.WaitForDatabase(&sdbSDK.WaitForDatabaseRequest{
@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_sdb_sql_database", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_sdb_sql_database", &resource.Sweeper{
 		Name: "scaleway_sdb_sql_database",
 		F:    testSweepServerlessSQLDBDatabase,
 	})
@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -189,7 +190,7 @@ func flattenEphemeralPolicy(policy *secret.EphemeralPolicy) []map[string]any {
 	return []map[string]any{policyElem}
 }
 
-func setSecretState(d *schema.ResourceData, secret *secret.Secret, versions *secret.ListSecretVersionsResponse) {
+func setSecretState(d *schema.ResourceData, m any, secret *secret.Secret, versions *secret.ListSecretVersionsResponse) {
 	_ = d.Set("name", secret.Name)
 	_ = d.Set("description", types.FlattenStringPtr(secret.Description))
 	_ = d.Set("created_at", types.FlattenTime(secret.CreatedAt))
@@ -201,7 +202,7 @@ func setSecretState(d *schema.ResourceData, secret *secret.Secret, versions *sec
 	_ = d.Set("protected", secret.Protected)
 	_ = d.Set("ephemeral_policy", flattenEphemeralPolicy(secret.EphemeralPolicy))
 	_ = d.Set("type", secret.Type)
-	_ = d.Set("tags", types.FlattenSliceString(secret.Tags))
+	tags.Set(d, m, secret.Tags)
 	_ = d.Set("srn", secret.Srn)
 
 	// versions is not populated for list secret
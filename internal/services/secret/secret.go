@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -34,6 +35,7 @@ func ResourceSecret() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    secretSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -57,6 +59,7 @@ func secretSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "List of tags [\"tag1\", \"tag2\", ...] associated to secret",
 		},
+		"tags_all": tags.AllSchema(),
 		"status": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -200,9 +203,8 @@ func ResourceSecretCreate(ctx context.Context, d *schema.ResourceData, m any) di
 		Type:      secret.SecretType(d.Get("type").(string)),
 	}
 
-	rawTag, tagExist := d.GetOk("tags")
-	if tagExist {
-		secretCreateRequest.Tags = types.ExpandStrings(rawTag)
+	if secretTags := tags.Expand(d, m); len(secretTags) > 0 {
+		secretCreateRequest.Tags = secretTags
 	}
 
 	rawDescription, descriptionExist := d.GetOk("description")
@@ -267,10 +269,6 @@ func ResourceSecretRead(ctx context.Context, d *schema.ResourceData, m any) diag
 		return diag.FromErr(err)
 	}
 
-	if len(secretResponse.Tags) > 0 {
-		_ = d.Set("tags", types.FlattenSliceString(secretResponse.Tags))
-	}
-
 	// Retry on 404 to handle eventual consistency issues
 	versionsResponse, err := transport.RetryOn404(ctx, func(ctx context.Context) (*secret.ListSecretVersionsResponse, error) {
 		return api.ListSecretVersions(&secret.ListSecretVersionsRequest{
@@ -288,7 +286,7 @@ func ResourceSecretRead(ctx context.Context, d *schema.ResourceData, m any) diag
 		return diag.FromErr(err)
 	}
 
-	setSecretState(d, secretResponse, versionsResponse)
+	setSecretState(d, m, secretResponse, versionsResponse)
 
 	err = identity.SetRegionalIdentity(d, region, id)
 	if err != nil {
@@ -321,8 +319,8 @@ func ResourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m any) di
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
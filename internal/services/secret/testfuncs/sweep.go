@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_secret", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_secret", &resource.Sweeper{
 		Name: "scaleway_secret",
 		F:    testSweepSecret,
 	})
@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_tem_domain", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_tem_domain", &resource.Sweeper{
 		Name: "scaleway_tem_domain",
 		F:    testSweepDomain,
 	})
@@ -12,8 +12,8 @@ import (
 
 func WaitForDomain(ctx context.Context, api *tem.API, region scw.Region, id string, timeout time.Duration) (*tem.Domain, error) {
 	retryInterval := defaultDomainRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	domain, err := api.WaitForDomain(&tem.WaitForDomainRequest{
@@ -28,8 +28,8 @@ func WaitForDomain(ctx context.Context, api *tem.API, region scw.Region, id stri
 
 func WaitForDomainAutoconfig(ctx context.Context, api *tem.API, region scw.Region, id string, want bool, timeout time.Duration) error {
 	retryInterval := defaultDomainRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	if retryInterval <= 0 {
@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -30,6 +31,7 @@ func ResourceConnector() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    connectorSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 		Identity:      identity.DefaultRegional(),
 	}
 }
@@ -50,6 +52,7 @@ func connectorSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"vpc_id": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -103,7 +106,7 @@ func ResourceConnectorCreate(ctx context.Context, d *schema.ResourceData, m any)
 		Name:        types.ExpandOrGenerateString(d.Get("name"), "connector"),
 		VpcID:       regional.ExpandID(d.Get("vpc_id").(string)).ID,
 		TargetVpcID: regional.ExpandID(d.Get("target_vpc_id").(string)).ID,
-		Tags:        types.ExpandStrings(d.Get("tags")),
+		Tags:        tags.Expand(d, m),
 		Region:      region,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -138,7 +141,7 @@ func ResourceConnectorRead(ctx context.Context, d *schema.ResourceData, m any) d
 		return diag.FromErr(err)
 	}
 
-	diags := setConnectorState(d, res)
+	diags := setConnectorState(d, m, res)
 
 	err = identity.SetRegionalIdentity(d, res.Region, ID)
 	if err != nil {
@@ -148,7 +151,7 @@ func ResourceConnectorRead(ctx context.Context, d *schema.ResourceData, m any) d
 	return diags
 }
 
-func setConnectorState(d *schema.ResourceData, connector *vpc.VPCConnector) diag.Diagnostics {
+func setConnectorState(d *schema.ResourceData, m any, connector *vpc.VPCConnector) diag.Diagnostics {
 	_ = d.Set("name", connector.Name)
 	_ = d.Set("vpc_id", regional.NewIDString(connector.Region, connector.VpcID))
 	_ = d.Set("target_vpc_id", regional.NewIDString(connector.Region, connector.TargetVpcID))
@@ -158,7 +161,7 @@ func setConnectorState(d *schema.ResourceData, connector *vpc.VPCConnector) diag
 	_ = d.Set("updated_at", types.FlattenTime(connector.UpdatedAt))
 	_ = d.Set("status", connector.Status.String())
 	_ = d.Set("region", connector.Region)
-	_ = d.Set("tags", connector.Tags)
+	tags.Set(d, m, connector.Tags)
 	_ = d.Set("srn", connector.Srn)
 
 	return nil
@@ -182,8 +185,8 @@ func ResourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, m any)
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
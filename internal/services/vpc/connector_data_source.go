@@ -13,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -72,7 +73,10 @@ func dataSourceConnectorReadByID(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	return setConnectorState(d, connector)
+	diags := setConnectorState(d, m, connector)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
 
 func dataSourceConnectorReadByFilters(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -112,5 +116,8 @@ func dataSourceConnectorReadByFilters(ctx context.Context, d *schema.ResourceDat
 	connector := res.VpcConnectors[0]
 	d.SetId(regional.NewIDString(connector.Region, connector.ID))
 
-	return setConnectorState(d, connector)
+	diags := setConnectorState(d, m, connector)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
@@ -200,7 +200,7 @@ func (r *ConnectorListResource) List(ctx context.Context, req list.ListRequest,
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			sdkDiags := setConnectorState(resourceData, connector)
+			sdkDiags := setConnectorState(resourceData, r.meta, connector)
 			if sdkDiags.HasError() {
 				tflog.Error(ctx, "error from setting VPC connector state")
 
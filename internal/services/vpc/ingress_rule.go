@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -32,6 +33,7 @@ func ResourceIngressRule() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    ingressRuleSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 		Identity:      identity.DefaultRegional(),
 	}
 }
@@ -75,7 +77,8 @@ func ingressRuleSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
-		"region": regional.Schema(),
+		"tags_all": tags.AllSchema(),
+		"region":   regional.Schema(),
 		// Computed elements
 		"is_ipv6": {
 			Type:        schema.TypeBool,
@@ -118,7 +121,7 @@ func ResourceIngressRuleCreate(ctx context.Context, d *schema.ResourceData, m an
 		NexthopPrivateNetworkID: locality.ExpandID(d.Get("nexthop_private_network_id").(string)),
 		NexthopResourceIP:       net.ParseIP(d.Get("nexthop_resource_ip").(string)),
 		Description:             types.ExpandStringPtr(d.Get("description")),
-		Tags:                    types.ExpandStrings(d.Get("tags")),
+		Tags:                    tags.Expand(d, m),
 	}
 
 	res, err := vpcAPI.CreateIngressRule(req, scw.WithContext(ctx))
@@ -156,7 +159,7 @@ func ResourceIngressRuleRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	diags := setIngressRuleState(d, res, region)
+	diags := setIngressRuleState(d, m, res, region)
 
 	err = identity.SetRegionalIdentity(d, region, ID)
 	if err != nil {
@@ -166,7 +169,7 @@ func ResourceIngressRuleRead(ctx context.Context, d *schema.ResourceData, m any)
 	return diags
 }
 
-func setIngressRuleState(d *schema.ResourceData, rule *vpc.IngressRule, region scw.Region) diag.Diagnostics {
+func setIngressRuleState(d *schema.ResourceData, m any, rule *vpc.IngressRule, region scw.Region) diag.Diagnostics {
 	source, err := types.FlattenIPNet(rule.Source)
 	if err != nil {
 		return diag.FromErr(err)
@@ -184,7 +187,7 @@ func setIngressRuleState(d *schema.ResourceData, rule *vpc.IngressRule, region s
 
 	_ = d.Set("nexthop_private_network_id", regional.NewIDString(region, rule.NexthopPrivateNetworkID))
 	_ = d.Set("description", types.FlattenStringPtr(rule.Description))
-	_ = d.Set("tags", rule.Tags)
+	tags.Set(d, m, rule.Tags)
 	_ = d.Set("region", region)
 	_ = d.Set("created_at", types.FlattenTime(rule.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(rule.UpdatedAt))
@@ -232,8 +235,8 @@ func ResourceIngressRuleUpdate(ctx context.Context, d *schema.ResourceData, m an
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
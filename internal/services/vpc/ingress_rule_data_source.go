@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -81,7 +82,10 @@ func dataSourceIngressRuleReadByID(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	return setIngressRuleState(d, rule, region)
+	diags := setIngressRuleState(d, m, rule, region)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
 
 func dataSourceIngressRuleReadByFilters(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -128,5 +132,8 @@ func dataSourceIngressRuleReadByFilters(ctx context.Context, d *schema.ResourceD
 	d.SetId(regional.NewIDString(region, rule.ID))
 	_ = d.Set("ingress_rule_id", regional.NewIDString(region, rule.ID))
 
-	return setIngressRuleState(d, rule, region)
+	diags := setIngressRuleState(d, m, rule, region)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
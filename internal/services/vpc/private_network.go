@@ -15,6 +15,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -32,8 +33,9 @@ func ResourcePrivateNetwork() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{Version: 0, Type: vpcPrivateNetworkUpgradeV1SchemaType(), Upgrade: vpcPrivateNetworkV1SUpgradeFunc},
 		},
-		SchemaFunc: privateNetworkSchema,
-		Identity:   identity.DefaultRegional(),
+		SchemaFunc:    privateNetworkSchema,
+		CustomizeDiff: tags.CustomizeDiff,
+		Identity:      identity.DefaultRegional(),
 	}
 }
 
@@ -162,6 +164,7 @@ func privateNetworkSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"is_regional": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -225,7 +228,7 @@ func ResourceVPCPrivateNetworkCreate(ctx context.Context, d *schema.ResourceData
 
 	req := &vpc.CreatePrivateNetworkRequest{
 		Name:                           types.ExpandOrGenerateString(d.Get("name"), "pn"),
-		Tags:                           types.ExpandStrings(d.Get("tags")),
+		Tags:                           tags.Expand(d, m),
 		DefaultRoutePropagationEnabled: d.Get("enable_default_route_propagation").(bool),
 		ProjectID:                      d.Get("project_id").(string),
 		Region:                         region,
@@ -299,7 +302,7 @@ func setPrivateNetworkState(d *schema.ResourceData, m any, pn *vpc.PrivateNetwor
 	_ = d.Set("project_id", pn.ProjectID)
 	_ = d.Set("created_at", types.FlattenTime(pn.CreatedAt))
 	_ = d.Set("updated_at", types.FlattenTime(pn.UpdatedAt))
-	_ = d.Set("tags", pn.Tags)
+	tags.Set(d, m, pn.Tags)
 	_ = d.Set("enable_default_route_propagation", pn.DefaultRoutePropagationEnabled)
 	_ = d.Set("region", pn.Region.String())
 	_ = d.Set("is_regional", true)
@@ -323,7 +326,7 @@ func ResourceVPCPrivateNetworkUpdate(ctx context.Context, d *schema.ResourceData
 		PrivateNetworkID:               ID,
 		Region:                         region,
 		Name:                           new(d.Get("name").(string)),
-		Tags:                           types.ExpandUpdatedStringsPtr(d.Get("tags")),
+		Tags:                           tags.ExpandUpdated(d, m),
 		DefaultRoutePropagationEnabled: types.ExpandBoolPtr(d.Get("enable_default_route_propagation").(bool)),
 	}, scw.WithContext(ctx))
 	if err != nil {
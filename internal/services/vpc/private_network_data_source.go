@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -88,5 +89,8 @@ func DataSourceVPCPrivateNetworkRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	return setPrivateNetworkState(d, m, pn)
+	diags := setPrivateNetworkState(d, m, pn)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -26,6 +27,7 @@ func ResourceRoute() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    routeSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 		Identity:      identity.DefaultRegional(),
 	}
 }
@@ -53,6 +55,7 @@ func routeSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"destination": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -114,7 +117,7 @@ func ResourceRouteCreate(ctx context.Context, d *schema.ResourceData, m any) dia
 
 	req := &vpc.CreateRouteRequest{
 		Description:             d.Get("description").(string),
-		Tags:                    types.ExpandStrings(d.Get("tags")),
+		Tags:                    tags.Expand(d, m),
 		VpcID:                   locality.ExpandID(d.Get("vpc_id").(string)),
 		NexthopResourceID:       types.ExpandStringPtr(resourceID),
 		NexthopPrivateNetworkID: types.ExpandStringPtr(locality.ExpandID(d.Get("nexthop_private_network_id").(string))),
@@ -156,7 +159,7 @@ func ResourceRouteRead(ctx context.Context, d *schema.ResourceData, m any) diag.
 		return diag.FromErr(err)
 	}
 
-	diags := setRouteState(d, res)
+	diags := setRouteState(d, m, res)
 
 	err = identity.SetRegionalIdentity(d, region, ID)
 	if err != nil {
@@ -166,7 +169,7 @@ func ResourceRouteRead(ctx context.Context, d *schema.ResourceData, m any) diag.
 	return diags
 }
 
-func setRouteState(d *schema.ResourceData, res *vpc.Route) diag.Diagnostics {
+func setRouteState(d *schema.ResourceData, m any, res *vpc.Route) diag.Diagnostics {
 	_ = d.Set("description", res.Description)
 	_ = d.Set("vpc_id", regional.NewIDString(res.Region, res.VpcID))
 	_ = d.Set("nexthop_resource_id", types.FlattenStringPtr(res.NexthopResourceID))
@@ -183,9 +186,7 @@ func setRouteState(d *schema.ResourceData, res *vpc.Route) diag.Diagnostics {
 
 	_ = d.Set("destination", destination)
 
-	if len(res.Tags) > 0 {
-		_ = d.Set("tags", res.Tags)
-	}
+	tags.Set(d, m, res.Tags)
 
 	_ = d.Set("srn", res.Srn)
 
@@ -240,8 +241,8 @@ func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m any) dia
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -83,7 +84,10 @@ func dataSourceRouteReadByID(ctx context.Context, d *schema.ResourceData, m any,
 		return diag.FromErr(err)
 	}
 
-	return setRouteState(d, res)
+	diags := setRouteState(d, m, res)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
 
 func dataSourceRouteReadByFilters(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -138,5 +142,8 @@ func dataSourceRouteReadByFilters(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(routeRegionalID)
 	_ = d.Set("route_id", routeRegionalID)
 
-	return setRouteState(d, route.Route)
+	diags := setRouteState(d, m, route.Route)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
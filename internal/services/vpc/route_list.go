@@ -248,7 +248,7 @@ func (r *RouteListResource) List(ctx context.Context, req list.ListRequest, stre
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			sdkDiags := setRouteState(resourceData, route)
+			sdkDiags := setRouteState(resourceData, r.meta, route)
 			if sdkDiags.HasError() {
 				tflog.Error(ctx, "error from setting VPC route state")
 
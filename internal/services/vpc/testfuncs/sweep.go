@@ -9,29 +9,29 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_vpc", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc", &resource.Sweeper{
 		Name:         "scaleway_vpc",
 		F:            testSweepVPC,
 		Dependencies: []string{"scaleway_vpc_private_network", "scaleway_vpc_connector"},
 	})
 
-	resource.AddTestSweepers("scaleway_vpc_private_network", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc_private_network", &resource.Sweeper{
 		Name:         "scaleway_vpc_private_network",
 		F:            testSweepVPCPrivateNetwork,
 		Dependencies: []string{"scaleway_ipam_ip"},
 	})
 
-	resource.AddTestSweepers("scaleway_vpc_route", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc_route", &resource.Sweeper{
 		Name: "scaleway_vpc_route",
 		F:    testSweepVPCRoute,
 	})
 
-	resource.AddTestSweepers("scaleway_vpc_connector", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc_connector", &resource.Sweeper{
 		Name: "scaleway_vpc_connector",
 		F:    testSweepVPCConnector,
 	})
 
-	resource.AddTestSweepers("scaleway_vpc_ingress_rule", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc_ingress_rule", &resource.Sweeper{
 		Name: "scaleway_vpc_ingress_rule",
 		F:    testSweepVPCIngressRule,
 	})
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -12,6 +13,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -25,14 +27,17 @@ func ResourceVPC() *schema.Resource {
 		SchemaVersion: 0,
 		SchemaFunc:    vpcSchema,
 		Identity:      identity.DefaultRegional(),
-		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
-			before, after := diff.GetChange("enable_routing")
-			if before != nil && before.(bool) && after != nil && !after.(bool) {
-				return errors.New("routing cannot be disabled on this VPC")
-			}
-
-			return nil
-		},
+		CustomizeDiff: customdiff.All(
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				before, after := diff.GetChange("enable_routing")
+				if before != nil && before.(bool) && after != nil && !after.(bool) {
+					return errors.New("routing cannot be disabled on this VPC")
+				}
+
+				return nil
+			},
+			tags.CustomizeDiff,
+		),
 	}
 }
 
@@ -52,6 +57,7 @@ func vpcSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"enable_routing": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -106,7 +112,7 @@ func ResourceVPCCreate(ctx context.Context, d *schema.ResourceData, m any) diag.
 
 	res, err := vpcAPI.CreateVPC(&vpc.CreateVPCRequest{
 		Name:               types.ExpandOrGenerateString(d.Get("name"), "vpc"),
-		Tags:               types.ExpandStrings(d.Get("tags")),
+		Tags:               tags.Expand(d, m),
 		EnableRouting:      d.Get("enable_routing").(bool),
 		EnableTransitivity: d.Get("enable_transitivity").(bool),
 		ProjectID:          d.Get("project_id").(string),
@@ -154,7 +160,7 @@ func ResourceVPCRead(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 		return diag.FromErr(err)
 	}
 
-	diags := setVPCState(d, res)
+	diags := setVPCState(d, m, res)
 
 	err = identity.SetRegionalIdentity(d, region, ID)
 	if err != nil {
@@ -164,7 +170,7 @@ func ResourceVPCRead(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 	return diags
 }
 
-func setVPCState(d *schema.ResourceData, res *vpc.VPC) diag.Diagnostics {
+func setVPCState(d *schema.ResourceData, m any, res *vpc.VPC) diag.Diagnostics {
 	_ = d.Set("name", res.Name)
 	_ = d.Set("organization_id", res.OrganizationID)
 	_ = d.Set("project_id", res.ProjectID)
@@ -177,9 +183,7 @@ func setVPCState(d *schema.ResourceData, res *vpc.VPC) diag.Diagnostics {
 	_ = d.Set("region", res.Region)
 	_ = d.Set("srn", res.Srn)
 
-	if len(res.Tags) > 0 {
-		_ = d.Set("tags", res.Tags)
-	}
+	tags.Set(d, m, res.Tags)
 
 	return nil
 }
@@ -202,8 +206,8 @@ func ResourceVPCUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
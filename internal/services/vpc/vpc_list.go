@@ -201,7 +201,7 @@ func (r *VPCListResource) List(ctx context.Context, req list.ListRequest, stream
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			diagsState := setVPCState(resourceData, rawVPC)
+			diagsState := setVPCState(resourceData, r.meta, rawVPC)
 			if diagsState.HasError() {
 				tflog.Error(ctx, "error from setting setVPCState")
 
@@ -12,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -27,6 +28,7 @@ func ResourceIP() *schema.Resource {
 		Identity:      identity.DefaultZonal(),
 		SchemaVersion: 0,
 		SchemaFunc:    ipSchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -45,6 +47,7 @@ func ipSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all":   tags.AllSchema(),
 		"project_id": account.ProjectIDSchema(),
 		"zone":       zonal.Schema(),
 		// Computed elements
@@ -75,7 +78,7 @@ func ResourceIPCreate(ctx context.Context, d *schema.ResourceData, m any) diag.D
 	}
 
 	req := &vpcgw.CreateIPRequest{
-		Tags:      types.ExpandStrings(d.Get("tags")),
+		Tags:      tags.Expand(d, m),
 		ProjectID: d.Get("project_id").(string),
 		Zone:      zone,
 	}
@@ -97,7 +100,7 @@ func ResourceIPCreate(ctx context.Context, d *schema.ResourceData, m any) diag.D
 		updateRequest := &vpcgw.UpdateIPRequest{
 			IPID:    res.ID,
 			Zone:    zone,
-			Tags:    new(types.ExpandStrings(d.Get("tags"))),
+			Tags:    tags.ExpandUpdated(d, m),
 			Reverse: types.ExpandStringPtr(reverse.(string)),
 		}
 
@@ -130,7 +133,7 @@ func ResourceIPRead(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	diags := setIPState(d, ip)
+	diags := setIPState(d, m, ip)
 
 	err = identity.SetZonalIdentity(d, ip.Zone, ip.ID)
 	if err != nil {
@@ -140,14 +143,14 @@ func ResourceIPRead(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 	return diags
 }
 
-func setIPState(d *schema.ResourceData, ip *vpcgw.IP) diag.Diagnostics {
+func setIPState(d *schema.ResourceData, m any, ip *vpcgw.IP) diag.Diagnostics {
 	_ = d.Set("organization_id", ip.OrganizationID)
 	_ = d.Set("address", ip.Address.String())
 	_ = d.Set("project_id", ip.ProjectID)
 	_ = d.Set("created_at", ip.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", ip.UpdatedAt.Format(time.RFC3339))
 	_ = d.Set("zone", ip.Zone)
-	_ = d.Set("tags", ip.Tags)
+	tags.Set(d, m, ip.Tags)
 	_ = d.Set("reverse", ip.Reverse)
 
 	return nil
@@ -166,8 +169,8 @@ func ResourceVPCPublicGatewayIPUpdate(ctx context.Context, d *schema.ResourceDat
 
 	hasChanged := false
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -195,7 +195,7 @@ func (r *IPListResource) List(ctx context.Context, req list.ListRequest, stream
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			sdkDiags := setIPState(resourceData, ip)
+			sdkDiags := setIPState(resourceData, r.meta, ip)
 			if sdkDiags.HasError() {
 				tflog.Error(ctx, "error from setting public gateway IP state")
 
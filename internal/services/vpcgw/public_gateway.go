@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -36,6 +37,7 @@ func ResourcePublicGateway() *schema.Resource {
 		},
 		SchemaVersion: 0,
 		SchemaFunc:    publicGatewaySchema,
+		CustomizeDiff: tags.CustomizeDiff,
 	}
 }
 
@@ -78,6 +80,7 @@ func publicGatewaySchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"tags_all": tags.AllSchema(),
 		"bastion_enabled": {
 			Type:        schema.TypeBool,
 			Description: "Enable SSH bastion on the gateway",
@@ -159,7 +162,7 @@ func ResourceVPCPublicGatewayCreate(ctx context.Context, d *schema.ResourceData,
 	req := &vpcgw.CreateGatewayRequest{
 		Name:          types.ExpandOrGenerateString(d.Get("name"), "pn"),
 		Type:          d.Get("type").(string),
-		Tags:          types.ExpandStrings(d.Get("tags")),
+		Tags:          tags.Expand(d, m),
 		ProjectID:     d.Get("project_id").(string),
 		EnableBastion: d.Get("bastion_enabled").(bool),
 		Zone:          zone,
@@ -224,7 +227,7 @@ func ResourceVPCPublicGatewayRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	diags := setPublicGatewayState(d, gateway)
+	diags := setPublicGatewayState(d, m, gateway)
 
 	err = identity.SetZonalIdentity(d, gateway.Zone, gateway.ID)
 	if err != nil {
@@ -234,14 +237,14 @@ func ResourceVPCPublicGatewayRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
-func setPublicGatewayState(d *schema.ResourceData, gateway *vpcgw.Gateway) diag.Diagnostics {
+func setPublicGatewayState(d *schema.ResourceData, m any, gateway *vpcgw.Gateway) diag.Diagnostics {
 	_ = d.Set("name", gateway.Name)
 	_ = d.Set("type", gateway.Type)
 	_ = d.Set("status", gateway.Status.String())
 	_ = d.Set("organization_id", gateway.OrganizationID)
 	_ = d.Set("project_id", gateway.ProjectID)
 	_ = d.Set("zone", gateway.Zone)
-	_ = d.Set("tags", gateway.Tags)
+	tags.Set(d, m, gateway.Tags)
 	_ = d.Set("created_at", gateway.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", gateway.UpdatedAt.Format(time.RFC3339))
 	_ = d.Set("bastion_enabled", gateway.BastionEnabled)
@@ -279,8 +282,8 @@ func ResourceVPCPublicGatewayUpdate(ctx context.Context, d *schema.ResourceData,
 		updateRequest.Name = new(d.Get("name").(string))
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 	}
 
 	if d.HasChange("bastion_port") {
@@ -9,6 +9,8 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -78,10 +80,13 @@ func DataSourceVPCPublicGatewayRead(ctx context.Context, d *schema.ResourceData,
 
 	parsedID := zonal.ExpandID(zonedID)
 
-	gateway, err := waitForVPCPublicGateway(ctx, api, parsedID.Zone, parsedID.ID, defaultTimeout)
+	gateway, err := waitForVPCPublicGateway(ctx, api, parsedID.Zone, parsedID.ID, meta.TimeoutOr(m.(*meta.Meta).DefaultTimeouts().Read, defaultTimeout))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return setPublicGatewayState(d, gateway)
+	diags := setPublicGatewayState(d, m, gateway)
+	tags.SetDataSource(d, m)
+
+	return diags
 }
@@ -211,7 +211,7 @@ func (r *PublicGatewayListResource) List(ctx context.Context, req list.ListReque
 			identitySetDiags := result.Identity.Set(ctx, *tfTypeIdentity)
 			result.Diagnostics.Append(identitySetDiags...)
 
-			sdkDiags := setPublicGatewayState(resourceData, gw)
+			sdkDiags := setPublicGatewayState(resourceData, r.meta, gw)
 			if sdkDiags.HasError() {
 				tflog.Error(ctx, "error from setting public gateway state")
 
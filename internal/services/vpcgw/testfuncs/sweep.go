@@ -9,15 +9,15 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_vpc_public_gateway_ip", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc_public_gateway_ip", &resource.Sweeper{
 		Name: "scaleway_vpc_public_gateway_ip",
 		F:    testSweepVPCPublicGatewayIP,
 	})
-	resource.AddTestSweepers("scaleway_gateway_network", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_gateway_network", &resource.Sweeper{
 		Name: "scaleway_gateway_network",
 		F:    testSweepVPCGatewayNetwork,
 	})
-	resource.AddTestSweepers("scaleway_vpc_public_gateway", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc_public_gateway", &resource.Sweeper{
 		Name: "scaleway_vpc_public_gateway",
 		F:    testSweepVPCPublicGateway,
 	})
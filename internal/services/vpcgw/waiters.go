@@ -11,8 +11,8 @@ import (
 
 func waitForVPCPublicGateway(ctx context.Context, api *v2.API, zone scw.Zone, id string, timeout time.Duration) (*v2.Gateway, error) {
 	retryInterval := defaultRetry
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	gateway, err := api.WaitForGateway(&v2.WaitForGatewayRequest{
@@ -27,8 +27,8 @@ func waitForVPCPublicGateway(ctx context.Context, api *v2.API, zone scw.Zone, id
 
 func waitForVPCGatewayNetwork(ctx context.Context, api *v2.API, zone scw.Zone, id string, timeout time.Duration) (*v2.GatewayNetwork, error) {
 	retryIntervalGWNetwork := defaultRetry
-	if transport.DefaultWaitRetryInterval != nil {
-		retryIntervalGWNetwork = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryIntervalGWNetwork = *interval
 	}
 
 	gatewayNetwork, err := api.WaitForGatewayNetwork(&v2.WaitForGatewayNetworkRequest{
@@ -66,8 +66,8 @@ func NewAPIWithRegionAndID(m any, id string) (*webhosting.HostingAPI, scw.Region
 
 func waitForHosting(ctx context.Context, api *webhosting.HostingAPI, region scw.Region, hostingID string, timeout time.Duration) (*webhosting.Hosting, error) {
 	retryInterval := hostingRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	if interval := transport.WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	return api.WaitForHosting(&webhosting.WaitForHostingRequest{
@@ -9,7 +9,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_webhosting", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_webhosting", &resource.Sweeper{
 		Name: "scaleway_webhosting",
 		F:    testSweepWebhosting,
 	})
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/webhosting/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -11,6 +12,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/identity"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -33,21 +35,24 @@ func ResourceWebhosting() *schema.Resource {
 		SchemaVersion: 0,
 		SchemaFunc:    webhostingSchema,
 		Identity:      identity.DefaultRegional(),
-		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
-			if diff.HasChange("tags") {
-				oldTagsInterface, newTagsInterface := diff.GetChange("tags")
-				oldTags := types.ExpandStrings(oldTagsInterface)
-				newTags := types.ExpandStrings(newTagsInterface)
-				// If the 'internal' tag was present and is now removed, restore it.
-				if types.SliceContainsString(oldTags, "internal") && !types.SliceContainsString(newTags, "internal") {
-					if err := diff.SetNew("tags", oldTags); err != nil {
-						return err
+		CustomizeDiff: customdiff.All(
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				if diff.HasChange("tags") {
+					oldTagsInterface, newTagsInterface := diff.GetChange("tags")
+					oldTags := types.ExpandStrings(oldTagsInterface)
+					newTags := types.ExpandStrings(newTagsInterface)
+					// If the 'internal' tag was present and is now removed, restore it.
+					if types.SliceContainsString(oldTags, "internal") && !types.SliceContainsString(newTags, "internal") {
+						if err := diff.SetNew("tags", oldTags); err != nil {
+							return err
+						}
 					}
 				}
-			}
 
-			return nil
-		},
+				return nil
+			},
+			tags.CustomizeDiff,
+		),
 	}
 }
 
@@ -79,6 +84,7 @@ func webhostingSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "The tags of the hosting",
 		},
+		"tags_all": tags.AllSchema(),
 		"option_ids": {
 			Type: schema.TypeList,
 			Elem: &schema.Schema{
@@ -257,8 +263,8 @@ func resourceWebhostingCreate(ctx context.Context, d *schema.ResourceData, m any
 		Domain:    d.Get("domain").(string),
 	}
 
-	if rawTags, ok := d.GetOk("tags"); ok {
-		hostingCreateRequest.Tags = types.ExpandStrings(rawTags)
+	if hostingTags := tags.Expand(d, m); len(hostingTags) > 0 {
+		hostingCreateRequest.Tags = hostingTags
 	}
 
 	if rawOptionIDs, ok := d.GetOk("option_ids"); ok {
@@ -349,7 +355,7 @@ func setWebhostingState(ctx context.Context, d *schema.ResourceData, m any, webh
 
 	_ = d.Set("records", flattenDNSRecords(dnsRecordsResponse.Records))
 	_ = d.Set("name_servers", flattenNameServers(dnsRecordsResponse.NameServers))
-	_ = d.Set("tags", webhostingResponse.Tags)
+	tags.Set(d, m, webhostingResponse.Tags)
 	_ = d.Set("offer_id", regional.NewIDString(region, webhostingResponse.Offer.ID))
 	_ = d.Set("domain", webhostingResponse.Domain) //nolint:staticcheck // deprecated in SDK, exported for backward compatibility
 	_ = d.Set("created_at", types.FlattenTime(webhostingResponse.CreatedAt))
@@ -407,8 +413,8 @@ func resourceWebhostingUpdate(ctx context.Context, d *schema.ResourceData, m any
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
+	if tags.HasChange(d) {
+		updateRequest.Tags = tags.ExpandUpdated(d, m)
 		hasChanged = true
 	}
 
@@ -0,0 +1,167 @@
+package tags
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+// AllSchema returns the schema of the computed tags_all attribute of resources with a list of tags.
+func AllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed:    true,
+		Description: "All the tags of the resource, including the ones inherited from the provider default_tags block",
+	}
+}
+
+// AllMapSchema returns the schema of the computed tags_all attribute of resources with key/value tags.
+func AllMapSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed:    true,
+		Description: "All the tags of the resource, including the ones inherited from the provider default_tags block",
+	}
+}
+
+// Expand returns the tags to send to the API: the resource tags merged with the provider default tags.
+// The remote tags ignored by the provider ignore_tags block are kept, so they are not removed by an update.
+func Expand(d *schema.ResourceData, m any) []string {
+	ignoreTags := m.(*meta.Meta).IgnoreTags()
+	remoteTags, _ := d.GetChange("tags_all")
+
+	return KeepIgnored(
+		Merge(types.ExpandStrings(d.Get("tags")), m.(*meta.Meta).DefaultTags()),
+		types.ExpandStrings(remoteTags),
+		ignoreTags.Keys,
+		ignoreTags.KeyPrefixes,
+	)
+}
+
+// ExpandUpdated is Expand for update requests, an empty list is returned so removing every tag updates the resource.
+func ExpandUpdated(d *schema.ResourceData, m any) *[]string {
+	tags := Expand(d, m)
+
+	return &tags
+}
+
+// ExpandMap is Expand for resources with key/value tags.
+func ExpandMap(d *schema.ResourceData, m any) map[string]any {
+	ignoreTags := m.(*meta.Meta).IgnoreTags()
+	remoteTags, _ := d.GetChange("tags_all")
+
+	return KeepIgnoredMap(
+		MergeMap(d.Get("tags").(map[string]any), m.(*meta.Meta).DefaultTags()),
+		remoteTags.(map[string]any),
+		ignoreTags.Keys,
+		ignoreTags.KeyPrefixes,
+	)
+}
+
+// HasChange reports whether the tags of the resource or the tags inherited from the provider changed.
+func HasChange(d *schema.ResourceData) bool {
+	return d.HasChanges("tags", "tags_all")
+}
+
+// Flatten returns the remote tags to store in the state, without the tags ignored by the provider ignore_tags block.
+func Flatten(m any, remoteTags []string) any {
+	ignoreTags := m.(*meta.Meta).IgnoreTags()
+
+	return types.FlattenSliceString(Ignore(remoteTags, ignoreTags.Keys, ignoreTags.KeyPrefixes))
+}
+
+// FlattenMap is Flatten for resources with key/value tags.
+func FlattenMap(m any, remoteTags map[string]any) map[string]any {
+	ignoreTags := m.(*meta.Meta).IgnoreTags()
+
+	return IgnoreMap(remoteTags, ignoreTags.Keys, ignoreTags.KeyPrefixes)
+}
+
+// Set stores the remote tags in tags_all, and in tags without the default tags that are not configured on the resource.
+// The tags ignored by the provider ignore_tags block are only stored in tags_all, so that updates can keep them.
+func Set(d *schema.ResourceData, m any, remoteTags []string) {
+	ignoreTags := m.(*meta.Meta).IgnoreTags()
+	configuredTags := types.ExpandStrings(d.Get("tags"))
+
+	_ = d.Set("tags", RemoveDefaults(Ignore(remoteTags, ignoreTags.Keys, ignoreTags.KeyPrefixes), m.(*meta.Meta).DefaultTags(), configuredTags))
+	_ = d.Set("tags_all", types.FlattenSliceString(remoteTags))
+}
+
+// SetMap is Set for resources with key/value tags.
+func SetMap(d *schema.ResourceData, m any, remoteTags map[string]any) {
+	configuredTags := d.Get("tags").(map[string]any)
+
+	_ = d.Set("tags", RemoveDefaultsMap(FlattenMap(m, remoteTags), m.(*meta.Meta).DefaultTags(), configuredTags))
+	_ = d.Set("tags_all", remoteTags)
+}
+
+// SetDataSource exposes every remote tag in the tags attribute, data sources do not hide the default tags.
+// The tags ignored by the provider ignore_tags block are removed from both attributes.
+func SetDataSource(d *schema.ResourceData, m any) {
+	remoteTags := types.ExpandStrings(d.Get("tags_all"))
+
+	_ = d.Set("tags", Flatten(m, remoteTags))
+	_ = d.Set("tags_all", Flatten(m, remoteTags))
+}
+
+// SetDataSourceMap is SetDataSource for data sources with key/value tags.
+func SetDataSourceMap(d *schema.ResourceData, m any) {
+	remoteTags := FlattenMap(m, d.Get("tags_all").(map[string]any))
+
+	_ = d.Set("tags", remoteTags)
+	_ = d.Set("tags_all", remoteTags)
+}
+
+// CustomizeDiff plans tags_all from the resource tags and the provider default tags,
+// so changing the default tags only shows a diff on tags_all. The remote tags ignored by the provider ignore_tags block are kept.
+func CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m any) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	ignoreTags := m.(*meta.Meta).IgnoreTags()
+	oldTagsAll, _ := diff.GetChange("tags_all")
+	tagsAll := KeepIgnored(
+		Merge(types.ExpandStrings(diff.Get("tags")), m.(*meta.Meta).DefaultTags()),
+		types.ExpandStrings(oldTagsAll),
+		ignoreTags.Keys,
+		ignoreTags.KeyPrefixes,
+	)
+
+	if Equal(types.ExpandStrings(oldTagsAll), tagsAll) {
+		return nil
+	}
+
+	return diff.SetNew("tags_all", tagsAll)
+}
+
+// CustomizeDiffMap is CustomizeDiff for resources with key/value tags.
+func CustomizeDiffMap(_ context.Context, diff *schema.ResourceDiff, m any) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	ignoreTags := m.(*meta.Meta).IgnoreTags()
+	oldTagsAll, _ := diff.GetChange("tags_all")
+	tagsAll := KeepIgnoredMap(
+		MergeMap(diff.Get("tags").(map[string]any), m.(*meta.Meta).DefaultTags()),
+		oldTagsAll.(map[string]any),
+		ignoreTags.Keys,
+		ignoreTags.KeyPrefixes,
+	)
+
+	if maps.Equal(oldTagsAll.(map[string]any), tagsAll) {
+		return nil
+	}
+
+	return diff.SetNew("tags_all", tagsAll)
+}
@@ -0,0 +1,191 @@
+package tags
+
+import (
+	"slices"
+	"strings"
+)
+
+// Merge returns the tags of a resource followed by the default tags it does not already contain.
+// The resource tags are returned as is when there is no default tag.
+func Merge(resourceTags []string, defaultTags []string) []string {
+	if len(defaultTags) == 0 {
+		return resourceTags
+	}
+
+	merged := make([]string, 0, len(resourceTags)+len(defaultTags))
+
+	for _, tag := range resourceTags {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+
+	for _, tag := range defaultTags {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+
+	return merged
+}
+
+// RemoveDefaults returns the remote tags without the default tags, except the ones explicitly configured on the resource.
+func RemoveDefaults(remoteTags []string, defaultTags []string, configuredTags []string) []string {
+	tags := make([]string, 0, len(remoteTags))
+
+	for _, tag := range remoteTags {
+		if slices.Contains(defaultTags, tag) && !slices.Contains(configuredTags, tag) {
+			continue
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// Equal reports whether both lists contain the same tags, regardless of their order.
+func Equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := slices.Clone(a)
+	sortedB := slices.Clone(b)
+
+	slices.Sort(sortedA)
+	slices.Sort(sortedB)
+
+	return slices.Equal(sortedA, sortedB)
+}
+
+// ToMap converts "key=value" tags to a map, as used by key/value tagged resources like buckets.
+// A tag without "=" is converted to a key with an empty value.
+func ToMap(tags []string) map[string]any {
+	tagsMap := make(map[string]any, len(tags))
+
+	for _, tag := range tags {
+		key, value, _ := strings.Cut(tag, "=")
+		tagsMap[key] = value
+	}
+
+	return tagsMap
+}
+
+// MergeMap returns the key/value tags of a resource completed with the default tags whose key is not already set.
+func MergeMap(resourceTags map[string]any, defaultTags []string) map[string]any {
+	merged := make(map[string]any, len(resourceTags)+len(defaultTags))
+
+	for key, value := range ToMap(defaultTags) {
+		merged[key] = value
+	}
+
+	for key, value := range resourceTags {
+		merged[key] = value
+	}
+
+	return merged
+}
+
+// RemoveDefaultsMap returns the remote key/value tags without the default tags, except the keys explicitly configured on the resource.
+func RemoveDefaultsMap(remoteTags map[string]any, defaultTags []string, configuredTags map[string]any) map[string]any {
+	defaultTagsMap := ToMap(defaultTags)
+	tags := make(map[string]any, len(remoteTags))
+
+	for key, value := range remoteTags {
+		if defaultValue, isDefault := defaultTagsMap[key]; isDefault && defaultValue == value {
+			if _, isConfigured := configuredTags[key]; !isConfigured {
+				continue
+			}
+		}
+
+		tags[key] = value
+	}
+
+	return tags
+}
+
+// Key returns the key of a tag: the part before the first "=", or the whole tag.
+func Key(tag string) string {
+	key, _, _ := strings.Cut(tag, "=")
+
+	return key
+}
+
+// IsIgnored reports whether the tag key is one of the ignored keys or starts with one of the ignored key prefixes.
+func IsIgnored(key string, ignoredKeys []string, ignoredKeyPrefixes []string) bool {
+	if slices.Contains(ignoredKeys, key) {
+		return true
+	}
+
+	return slices.ContainsFunc(ignoredKeyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+// Ignore returns the tags whose key is not ignored.
+// The tags are returned as is when nothing is ignored.
+func Ignore(tags []string, ignoredKeys []string, ignoredKeyPrefixes []string) []string {
+	if len(ignoredKeys) == 0 && len(ignoredKeyPrefixes) == 0 {
+		return tags
+	}
+
+	return slices.DeleteFunc(slices.Clone(tags), func(tag string) bool {
+		return IsIgnored(Key(tag), ignoredKeys, ignoredKeyPrefixes)
+	})
+}
+
+// IgnoreMap is Ignore for key/value tags.
+func IgnoreMap(tags map[string]any, ignoredKeys []string, ignoredKeyPrefixes []string) map[string]any {
+	if len(ignoredKeys) == 0 && len(ignoredKeyPrefixes) == 0 {
+		return tags
+	}
+
+	filtered := make(map[string]any, len(tags))
+
+	for key, value := range tags {
+		if !IsIgnored(key, ignoredKeys, ignoredKeyPrefixes) {
+			filtered[key] = value
+		}
+	}
+
+	return filtered
+}
+
+// Ignored returns the tags whose key is ignored.
+func Ignored(tags []string, ignoredKeys []string, ignoredKeyPrefixes []string) []string {
+	if len(ignoredKeys) == 0 && len(ignoredKeyPrefixes) == 0 {
+		return nil
+	}
+
+	return slices.DeleteFunc(slices.Clone(tags), func(tag string) bool {
+		return !IsIgnored(Key(tag), ignoredKeys, ignoredKeyPrefixes)
+	})
+}
+
+// KeepIgnored returns the tags followed by the ignored tags of the remote tags they do not already contain,
+// so that updating the tags of a resource does not remove the tags managed outside of Terraform.
+func KeepIgnored(tags []string, remoteTags []string, ignoredKeys []string, ignoredKeyPrefixes []string) []string {
+	return Merge(tags, Ignored(remoteTags, ignoredKeys, ignoredKeyPrefixes))
+}
+
+// KeepIgnoredMap is KeepIgnored for key/value tags, the configured value of a key is kept over the remote one.
+func KeepIgnoredMap(tags map[string]any, remoteTags map[string]any, ignoredKeys []string, ignoredKeyPrefixes []string) map[string]any {
+	if len(ignoredKeys) == 0 && len(ignoredKeyPrefixes) == 0 {
+		return tags
+	}
+
+	kept := make(map[string]any, len(tags))
+
+	for key, value := range remoteTags {
+		if IsIgnored(key, ignoredKeys, ignoredKeyPrefixes) {
+			kept[key] = value
+		}
+	}
+
+	for key, value := range tags {
+		kept[key] = value
+	}
+
+	return kept
+}
@@ -0,0 +1,163 @@
+package tags_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	cases := []struct {
+		name         string
+		resourceTags []string
+		defaultTags  []string
+		expected     []string
+	}{
+		{
+			name:         "no default tags",
+			resourceTags: nil,
+			defaultTags:  nil,
+			expected:     nil,
+		},
+		{
+			name:         "only default tags",
+			resourceTags: nil,
+			defaultTags:  []string{"env=dev"},
+			expected:     []string{"env=dev"},
+		},
+		{
+			name:         "resource tags first",
+			resourceTags: []string{"app", "env=dev"},
+			defaultTags:  []string{"env=dev", "team=core"},
+			expected:     []string{"app", "env=dev", "team=core"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, tags.Merge(c.resourceTags, c.defaultTags))
+		})
+	}
+}
+
+func TestRemoveDefaults(t *testing.T) {
+	remote := []string{"app", "env=dev", "team=core"}
+
+	assert.Equal(t, []string{"app"}, tags.RemoveDefaults(remote, []string{"env=dev", "team=core"}, []string{"app"}))
+	assert.Equal(t, []string{"app", "env=dev"}, tags.RemoveDefaults(remote, []string{"env=dev", "team=core"}, []string{"app", "env=dev"}))
+	assert.Equal(t, remote, tags.RemoveDefaults(remote, nil, nil))
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, tags.Equal([]string{"a", "b"}, []string{"b", "a"}))
+	assert.True(t, tags.Equal(nil, []string{}))
+	assert.False(t, tags.Equal([]string{"a"}, []string{"a", "b"}))
+	assert.False(t, tags.Equal([]string{"a", "a"}, []string{"a", "b"}))
+}
+
+func TestMergeMap(t *testing.T) {
+	merged := tags.MergeMap(map[string]any{"env": "prod"}, []string{"env=dev", "team=core", "managed"})
+
+	assert.Equal(t, map[string]any{"env": "prod", "team": "core", "managed": ""}, merged)
+}
+
+func TestRemoveDefaultsMap(t *testing.T) {
+	remote := map[string]any{"env": "prod", "team": "core", "app": "web"}
+	defaults := []string{"env=dev", "team=core"}
+
+	assert.Equal(t, map[string]any{"env": "prod", "app": "web"}, tags.RemoveDefaultsMap(remote, defaults, map[string]any{"app": "web"}))
+	assert.Equal(t, remote, tags.RemoveDefaultsMap(remote, defaults, map[string]any{"team": "core", "app": "web"}))
+}
+
+func TestIgnore(t *testing.T) {
+	remote := []string{"app", "env=dev", "billing=team-a", "cockpit-managed", "cockpit-source=logs"}
+
+	assert.Equal(t, remote, tags.Ignore(remote, nil, nil))
+	assert.Equal(t, []string{"app", "env=dev"}, tags.Ignore(remote, []string{"billing"}, []string{"cockpit-"}))
+	assert.Equal(t, []string{"app", "billing=team-a", "cockpit-managed", "cockpit-source=logs"}, tags.Ignore(remote, []string{"env", "dev"}, nil))
+	assert.Equal(t, []string{"app", "env=dev", "billing=team-a", "cockpit-managed", "cockpit-source=logs"}, remote, "the remote tags must not be modified")
+}
+
+func TestIgnoreMap(t *testing.T) {
+	remote := map[string]any{"app": "web", "billing": "team-a", "cockpit-source": "logs"}
+
+	assert.Equal(t, remote, tags.IgnoreMap(remote, nil, nil))
+	assert.Equal(t, map[string]any{"app": "web"}, tags.IgnoreMap(remote, []string{"billing"}, []string{"cockpit-"}))
+}
+
+func TestIgnored(t *testing.T) {
+	remote := []string{"app", "env=dev", "billing=team-a", "cockpit-managed"}
+
+	assert.Nil(t, tags.Ignored(remote, nil, nil))
+	assert.Equal(t, []string{"billing=team-a", "cockpit-managed"}, tags.Ignored(remote, []string{"billing"}, []string{"cockpit-"}))
+	assert.Equal(t, []string{"app", "env=dev", "billing=team-a", "cockpit-managed"}, remote, "the remote tags must not be modified")
+}
+
+func TestKeepIgnored(t *testing.T) {
+	cases := []struct {
+		name               string
+		tags               []string
+		remoteTags         []string
+		ignoredKeys        []string
+		ignoredKeyPrefixes []string
+		expected           []string
+	}{
+		{
+			name:       "nothing ignored",
+			tags:       []string{"app"},
+			remoteTags: []string{"app", "billing=team-a"},
+			expected:   []string{"app"},
+		},
+		{
+			name:               "create",
+			tags:               []string{"app", "env=dev"},
+			remoteTags:         nil,
+			ignoredKeys:        []string{"billing"},
+			ignoredKeyPrefixes: []string{"cockpit-"},
+			expected:           []string{"app", "env=dev"},
+		},
+		{
+			name:               "update keeps ignored remote tags",
+			tags:               []string{"app", "env=prod"},
+			remoteTags:         []string{"app", "env=dev", "billing=team-a", "cockpit-managed"},
+			ignoredKeys:        []string{"billing"},
+			ignoredKeyPrefixes: []string{"cockpit-"},
+			expected:           []string{"app", "env=prod", "billing=team-a", "cockpit-managed"},
+		},
+		{
+			name:        "update removing every tag",
+			tags:        []string{},
+			remoteTags:  []string{"app", "billing=team-a"},
+			ignoredKeys: []string{"billing"},
+			expected:    []string{"billing=team-a"},
+		},
+		{
+			name:        "ignored tag also configured",
+			tags:        []string{"billing=team-a"},
+			remoteTags:  []string{"billing=team-a"},
+			ignoredKeys: []string{"billing"},
+			expected:    []string{"billing=team-a"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, tags.KeepIgnored(c.tags, c.remoteTags, c.ignoredKeys, c.ignoredKeyPrefixes))
+		})
+	}
+}
+
+func TestKeepIgnoredMap(t *testing.T) {
+	remote := map[string]any{"env": "dev", "billing": "team-a", "cockpit-source": "logs"}
+
+	assert.Equal(t, map[string]any{"env": "prod"}, tags.KeepIgnoredMap(map[string]any{"env": "prod"}, remote, nil, nil))
+	assert.Equal(t,
+		map[string]any{"env": "prod", "billing": "team-a", "cockpit-source": "logs"},
+		tags.KeepIgnoredMap(map[string]any{"env": "prod"}, remote, []string{"billing"}, []string{"cockpit-"}),
+	)
+	assert.Equal(t,
+		map[string]any{"billing": "team-b", "cockpit-source": "logs"},
+		tags.KeepIgnoredMap(map[string]any{"billing": "team-b"}, remote, []string{"billing"}, []string{"cockpit-"}),
+	)
+}
@@ -0,0 +1,132 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+)
+
+// httpLogMaxBodySize is the size above which bodies are not written to the HTTP log
+const httpLogMaxBodySize = 1 << 20
+
+type httpLogResourceKey struct{}
+
+type httpLogResource struct {
+	resourceType string
+	resourceID   string
+}
+
+// WithResource returns a context recording the resource on behalf of which the requests are sent, for the HTTP log.
+func WithResource(ctx context.Context, resourceType string, resourceID string) context.Context {
+	return context.WithValue(ctx, httpLogResourceKey{}, httpLogResource{
+		resourceType: resourceType,
+		resourceID:   resourceID,
+	})
+}
+
+// HTTPLogTransport writes every request and its response to an HTTP log, with secrets redacted.
+type HTTPLogTransport struct {
+	Transport http.RoundTripper
+	Log       *logging.HTTPLog
+}
+
+// NewHTTPLogTransport creates a http transport writing every request to the HTTP log file at path.
+// The transport is returned as is when path is empty.
+func NewHTTPLogTransport(defaultTransport http.RoundTripper, path string) (http.RoundTripper, error) {
+	if path == "" {
+		return defaultTransport, nil
+	}
+
+	httpLog, err := logging.OpenHTTPLog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HTTPLogTransport{
+		Transport: defaultTransport,
+		Log:       httpLog,
+	}, nil
+}
+
+// RoundTrip sends the request and writes it to the HTTP log.
+func (t *HTTPLogTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	entry := &logging.HTTPLogEntry{
+		Time:   time.Now().UTC(),
+		Method: r.Method,
+		URL:    logging.RedactURL(r.URL),
+	}
+
+	if resource, ok := r.Context().Value(httpLogResourceKey{}).(httpLogResource); ok {
+		entry.ResourceType = resource.resourceType
+		entry.ResourceID = resource.resourceID
+	}
+
+	if r.GetBody != nil && isJSON(r.Header) {
+		if body, err := r.GetBody(); err == nil {
+			entry.RequestBody, _ = readRedactedBody(body, logging.RedactJSON)
+			_ = body.Close()
+		}
+	}
+
+	resp, err := t.Transport.RoundTrip(r)
+
+	entry.DurationMs = time.Since(entry.Time).Milliseconds()
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	if resp != nil {
+		entry.Status = resp.StatusCode
+		entry.RequestID = resp.Header.Get("X-Request-Id")
+
+		if isJSON(resp.Header) && resp.Body != nil {
+			var body []byte
+
+			entry.ResponseBody, body = readRedactedBody(resp.Body, func(v any) bool {
+				return logging.RedactResponseJSON(r.URL, v)
+			})
+			resp.Body = readCloser{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		}
+	}
+
+	t.Log.Write(entry)
+
+	return resp, err
+}
+
+// readCloser reads from the buffered start of a body before the rest of it, and closes the original body.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func isJSON(header http.Header) bool {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+
+	return mediaType == "application/json"
+}
+
+// readRedactedBody reads up to httpLogMaxBodySize of a JSON body and returns it decoded and redacted with redact, with the bytes read.
+// The decoded body is nil if the body is too large or not valid JSON.
+func readRedactedBody(body io.Reader, redact func(v any) bool) (any, []byte) {
+	data, err := io.ReadAll(io.LimitReader(body, httpLogMaxBodySize+1))
+	if err != nil || len(data) > httpLogMaxBodySize {
+		return nil, data
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, data
+	}
+
+	redact(decoded)
+
+	return decoded, data
+}
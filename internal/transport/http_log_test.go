@@ -0,0 +1,169 @@
+package transport_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPLogTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "request-id")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"key-id","secret_key":"11111111-1111-1111-1111-111111111111"}`))
+	}))
+	defer server.Close()
+
+	logPath := filepath.Join(t.TempDir(), "http.log")
+
+	logTransport, err := transport.NewHTTPLogTransport(http.DefaultTransport, logPath)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: transport.NewRetryableTransport(logTransport)}
+
+	ctx := transport.WithResource(t.Context(), "scaleway_iam_api_key", "key-id")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/iam/v1alpha1/api-keys?token=my-token", strings.NewReader(`{"password":"my-password","name":"key"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"key-id","secret_key":"11111111-1111-1111-1111-111111111111"}`, string(body), "the response body must not be modified")
+
+	logFile, err := os.Open(logPath)
+	require.NoError(t, err)
+
+	defer logFile.Close()
+
+	scanner := bufio.NewScanner(logFile)
+	require.True(t, scanner.Scan())
+
+	entry := logging.HTTPLogEntry{}
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+
+	assert.Equal(t, http.MethodPost, entry.Method)
+	assert.Equal(t, server.URL+"/iam/v1alpha1/api-keys?token="+logging.SensitiveFields["token"].(string), entry.URL)
+	assert.Equal(t, http.StatusCreated, entry.Status)
+	assert.Equal(t, "request-id", entry.RequestID)
+	assert.Equal(t, "scaleway_iam_api_key", entry.ResourceType)
+	assert.Equal(t, "key-id", entry.ResourceID)
+	assert.Equal(t, map[string]any{"name": "key", "password": logging.SensitiveFields["password"]}, entry.RequestBody)
+	assert.Equal(t, map[string]any{"id": "key-id", "secret_key": logging.SensitiveFields["secret_key"]}, entry.ResponseBody)
+	assert.False(t, scanner.Scan(), "a single request must be logged")
+}
+
+func TestHTTPLogTransport_SecretAccess(t *testing.T) {
+	t.Parallel()
+
+	// {"access_key":"SCWXXXXXXXXXXXXXXXXX","secret_key":"11111111-1111-1111-1111-111111111111"}
+	payload := "eyJhY2Nlc3Nfa2V5IjoiU0NXWFhYWFhYWFhYWFhYWFhYWFgiLCJzZWNyZXRfa2V5IjoiMTExMTExMTEtMTExMS0xMTExLTExMTEtMTExMTExMTExMTExIn0="
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"secret_id":"secret-id","revision":1,"data":"` + payload + `"}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		wantData string
+	}{
+		{
+			name:     "access by ID",
+			path:     "/secret-manager/v1beta1/regions/fr-par/secrets/secret-id/versions/latest_enabled/access",
+			wantData: logging.SecretPayloadPlaceholder,
+		},
+		{
+			name:     "access by path",
+			path:     "/secret-manager/v1beta1/regions/fr-par/secrets-by-path/versions/1/access?secret_name=credentials",
+			wantData: logging.SecretPayloadPlaceholder,
+		},
+		{
+			name:     "other endpoint",
+			path:     "/secret-manager/v1beta1/regions/fr-par/secrets/secret-id/versions/1",
+			wantData: payload,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logPath := filepath.Join(t.TempDir(), "http.log")
+
+			logTransport, err := transport.NewHTTPLogTransport(http.DefaultTransport, logPath)
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+tt.path, nil)
+			require.NoError(t, err)
+
+			resp, err := (&http.Client{Transport: logTransport}).Do(req)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.Contains(t, string(body), payload, "the response body must not be modified")
+
+			logFile, err := os.ReadFile(logPath)
+			require.NoError(t, err)
+
+			entry := logging.HTTPLogEntry{}
+			require.NoError(t, json.Unmarshal(logFile, &entry))
+			assert.Equal(t, map[string]any{"secret_id": "secret-id", "revision": float64(1), "data": tt.wantData}, entry.ResponseBody)
+		})
+	}
+}
+
+// TestHTTPLogTransport_CloseHTTPLogs is not parallel, as CloseHTTPLogs closes the logs of every test.
+func TestHTTPLogTransport_CloseHTTPLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	logPath := filepath.Join(t.TempDir(), "http.log")
+
+	sendRequest := func() {
+		logTransport, err := transport.NewHTTPLogTransport(http.DefaultTransport, logPath)
+		require.NoError(t, err)
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodDelete, server.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: logTransport}).Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	sendRequest()
+	logging.CloseHTTPLogs()
+	sendRequest()
+	logging.CloseHTTPLogs()
+
+	logFile, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+
+	assert.Len(t, strings.Split(strings.TrimSpace(string(logFile)), "\n"), 2, "the log must be reopened after being closed")
+}
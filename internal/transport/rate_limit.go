@@ -0,0 +1,123 @@
+package transport
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	sharedRateLimitersMu sync.Mutex
+	sharedRateLimiters   = map[float64]*RateLimiter{}
+)
+
+// RateLimiter is a token bucket allowing a number of requests per second, with bursts up to one second of requests.
+type RateLimiter struct {
+	mu         sync.Mutex
+	rate       float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewRateLimiter creates a token bucket allowing requestsPerSecond requests per second.
+func NewRateLimiter(requestsPerSecond float64) *RateLimiter {
+	burst := math.Max(1, math.Ceil(requestsPerSecond))
+
+	return &RateLimiter{
+		rate:       requestsPerSecond,
+		burst:      burst,
+		tokens:     burst,
+		lastRefill: time.Now(),
+	}
+}
+
+// SharedRateLimiter returns the process wide rate limiter for the given rate.
+// The SDKv2 and framework providers are served by the same process, they share it so the limit applies to both.
+func SharedRateLimiter(requestsPerSecond float64) *RateLimiter {
+	sharedRateLimitersMu.Lock()
+	defer sharedRateLimitersMu.Unlock()
+
+	limiter, exists := sharedRateLimiters[requestsPerSecond]
+	if !exists {
+		limiter = NewRateLimiter(requestsPerSecond)
+		sharedRateLimiters[requestsPerSecond] = limiter
+	}
+
+	return limiter
+}
+
+// reserve takes a token from the bucket and returns how long to wait before using it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.lastRefill).Seconds()*l.rate)
+	l.lastRefill = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token taken by reserve when the request is not sent.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a request is allowed or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RateLimitedTransport waits for the rate limiter before sending each request.
+type RateLimitedTransport struct {
+	Transport http.RoundTripper
+	Limiter   *RateLimiter
+}
+
+// NewRateLimitedTransport creates a http transport sending at most requestsPerSecond requests per second.
+// The transport is returned as is when requestsPerSecond is not positive.
+func NewRateLimitedTransport(defaultTransport http.RoundTripper, requestsPerSecond float64) http.RoundTripper {
+	if requestsPerSecond <= 0 {
+		return defaultTransport
+	}
+
+	return &RateLimitedTransport{
+		Transport: defaultTransport,
+		Limiter:   SharedRateLimiter(requestsPerSecond),
+	}
+}
+
+// RoundTrip waits for the rate limiter and sends the request.
+func (t *RateLimitedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := t.Limiter.Wait(r.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.Transport.RoundTrip(r)
+}
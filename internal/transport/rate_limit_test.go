@@ -0,0 +1,65 @@
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("allows a burst of one second of requests", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := transport.NewRateLimiter(5)
+		start := time.Now()
+
+		for range 5 {
+			if err := limiter.Wait(t.Context()); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+			t.Fatalf("expected the burst not to wait, waited %s", elapsed)
+		}
+	})
+
+	t.Run("waits once the bucket is empty", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := transport.NewRateLimiter(10)
+		start := time.Now()
+
+		for range 12 {
+			if err := limiter.Wait(t.Context()); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+			t.Fatalf("expected to wait for 2 tokens, waited %s", elapsed)
+		}
+	})
+
+	t.Run("stops waiting when the context is done", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := transport.NewRateLimiter(0.1)
+
+		if err := limiter.Wait(t.Context()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+
+		if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -34,10 +35,38 @@ const (
 // DefaultWaitRetryInterval is used to set the retry interval to 0 during acceptance tests
 var DefaultWaitRetryInterval *time.Duration
 
+type waitRetryIntervalKey struct{}
+
+// WithWaitRetryInterval returns a context making the waiters check the status of resources at the given interval,
+// as set in the provider default_timeouts block. The context is returned as is when the interval is nil.
+func WithWaitRetryInterval(ctx context.Context, interval *time.Duration) context.Context {
+	if interval == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, waitRetryIntervalKey{}, *interval)
+}
+
+// WaitRetryInterval returns the interval at which the waiters must check the status of resources, nil to use their own.
+// DefaultWaitRetryInterval takes precedence over the interval of the context, so acceptance tests do not wait.
+func WaitRetryInterval(ctx context.Context) *time.Duration {
+	if DefaultWaitRetryInterval != nil {
+		return DefaultWaitRetryInterval
+	}
+
+	if interval, ok := ctx.Value(waitRetryIntervalKey{}).(time.Duration); ok {
+		return &interval
+	}
+
+	return nil
+}
+
 type RetryableTransportOptions struct {
 	RetryMax     *int
 	RetryWaitMax *time.Duration
 	RetryWaitMin *time.Duration
+	// RetryOnStatus lists HTTP status codes retried in addition to 429 and 5xx
+	RetryOnStatus []int
 }
 
 func NewRetryableTransportWithOptions(defaultTransport http.RoundTripper, options RetryableTransportOptions) http.RoundTripper {
@@ -54,6 +83,10 @@ func NewRetryableTransportWithOptions(defaultTransport http.RoundTripper, option
 			return true, err
 		}
 
+		if ctx.Err() == nil && slices.Contains(options.RetryOnStatus, resp.StatusCode) {
+			return true, nil
+		}
+
 		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
 	}
 
@@ -108,7 +141,7 @@ func (c *RetryableTransport) RoundTrip(r *http.Request) (*http.Response, error)
 		body = bytes.NewReader(bs)
 	}
 
-	req, err := retryablehttp.NewRequest(r.Method, r.URL.String(), body)
+	req, err := retryablehttp.NewRequestWithContext(r.Context(), r.Method, r.URL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +181,8 @@ func RetryOn403Value[T any](ctx context.Context, fn func() (T, error)) (T, error
 	)
 
 	wait := RetryOn403WaitTime
-	if DefaultWaitRetryInterval != nil {
-		wait = *DefaultWaitRetryInterval
+	if interval := WaitRetryInterval(ctx); interval != nil {
+		wait = *interval
 	}
 
 	deadline := time.Now().Add(IAMPropagationTimeout)
@@ -192,8 +225,8 @@ func RetryOnTransientStateError[T any, U any](action func() (T, error), waiter f
 // Retries the specified function when it returns a 404 error.
 func RetryOn404[T any](ctx context.Context, f func(context.Context) (T, error)) (T, error) {
 	wait := RetryOn404WaitTime
-	if DefaultWaitRetryInterval != nil {
-		wait = *DefaultWaitRetryInterval
+	if interval := WaitRetryInterval(ctx); interval != nil {
+		wait = *interval
 	}
 
 	deadline := time.Now().Add(RetryOn404WaitTimeout)
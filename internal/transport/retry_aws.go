@@ -30,8 +30,8 @@ func RetryWhenAWSErrCodeNotEquals[T any](ctx context.Context, codes []string, co
 // It will retry if the shouldRetry function returns true. It will stop if the shouldRetry function returns false.
 func retryWhen[T any](ctx context.Context, config *RetryWhenConfig[T], shouldRetry func(error) bool) (T, error) {
 	retryInterval := config.Interval
-	if DefaultWaitRetryInterval != nil {
-		retryInterval = *DefaultWaitRetryInterval
+	if interval := WaitRetryInterval(ctx); interval != nil {
+		retryInterval = *interval
 	}
 
 	timer := time.NewTimer(config.Timeout)
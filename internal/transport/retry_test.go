@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
@@ -84,3 +86,65 @@ func TestRetryOn403Value(t *testing.T) {
 		t.Fatalf("expected 2 calls, got %d", calls)
 	}
 }
+
+func TestRetryableTransportRetryOnStatus(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusConflict)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: transport.NewRetryableTransportWithOptions(http.DefaultTransport, transport.RetryableTransportOptions{
+		RetryWaitMin:  new(time.Duration(0)),
+		RetryWaitMax:  new(time.Duration(0)),
+		RetryOnStatus: []int{http.StatusConflict},
+	})}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestWaitRetryInterval(t *testing.T) {
+	t.Parallel()
+
+	if interval := transport.WaitRetryInterval(t.Context()); interval != nil {
+		t.Fatalf("expected no interval, got %s", *interval)
+	}
+
+	if ctx := transport.WithWaitRetryInterval(t.Context(), nil); transport.WaitRetryInterval(ctx) != nil {
+		t.Fatal("expected no interval when the provider does not set one")
+	}
+
+	pollInterval := 10 * time.Second
+
+	interval := transport.WaitRetryInterval(transport.WithWaitRetryInterval(t.Context(), &pollInterval))
+	if interval == nil || *interval != pollInterval {
+		t.Fatalf("expected interval %s, got %v", pollInterval, interval)
+	}
+}
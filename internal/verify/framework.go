@@ -2,7 +2,9 @@ package verify
 
 import (
 	"context"
+	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -33,6 +35,36 @@ func IsStringUUIDOrUUIDWithZone() validator.String {
 	)
 }
 
+// IsStringDuration checks that the string is a valid duration (e.g. 30s, 5m), like IsDuration for SDKv2 schemas
+func IsStringDuration() validator.String {
+	return durationValidator{}
+}
+
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "must be a valid duration (e.g. 30s, 5m)"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value Duration",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(context.Background()), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
 // MutuallyExclusiveStringConflicts builds a ConflictsWith validator listing every attribute in the group except `self`
 func MutuallyExclusiveStringConflicts(self string, group ...string) []validator.String {
 	conflicts := make([]path.Expression, 0, len(group))
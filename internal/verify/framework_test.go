@@ -212,6 +212,68 @@ func TestStringValidatorUUIDOrUUIDWithLocality(t *testing.T) {
 	}
 }
 
+func TestStringValidatorDuration(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	testCases := map[string]struct {
+		value   types.String
+		wantErr bool
+	}{
+		"seconds": {
+			value: types.StringValue("30s"),
+		},
+		"minutes and seconds": {
+			value: types.StringValue("1m30s"),
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"missing unit": {
+			value:   types.StringValue("30"),
+			wantErr: true,
+		},
+		"invalid": {
+			value:   types.StringValue("thirty seconds"),
+			wantErr: true,
+		},
+		"empty string": {
+			value:   types.StringValue(""),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				ConfigValue: tc.value,
+			}
+
+			resp := validator.StringResponse{}
+
+			verify.IsStringDuration().ValidateString(ctx, req, &resp)
+
+			if tc.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected error, got none")
+				}
+
+				if errStr := resp.Diagnostics[0].Summary(); errStr != "Invalid Attribute Value Duration" {
+					t.Fatalf("unexpected error description %q", errStr)
+				}
+			} else if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics[0].Summary())
+			}
+		})
+	}
+}
+
 func TestStringValidatorRegionWithWarning(t *testing.T) {
 	t.Parallel()
 
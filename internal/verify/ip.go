@@ -3,6 +3,7 @@ package verify
 import (
 	"fmt"
 	"net"
+	"slices"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -72,3 +73,87 @@ func IsIPv4CIDR() schema.SchemaValidateDiagFunc {
 		return nil
 	}
 }
+
+const (
+	// PrivateNetworkIPv4MinPrefixLength is the shortest prefix of an IPv4 Private Network subnet
+	PrivateNetworkIPv4MinPrefixLength = 20
+	// PrivateNetworkIPv4MaxPrefixLength is the longest prefix of an IPv4 Private Network subnet
+	PrivateNetworkIPv4MaxPrefixLength = 28
+	// PrivateNetworkIPv6PrefixLength is the prefix of an IPv6 Private Network subnet
+	PrivateNetworkIPv6PrefixLength = 64
+)
+
+var (
+	privateNetworkIPv4Ranges = []*net.IPNet{
+		mustParseCIDR("10.0.0.0/8"),
+		mustParseCIDR("172.16.0.0/12"),
+		mustParseCIDR("192.168.0.0/16"),
+	}
+	privateNetworkIPv6Range = mustParseCIDR("fd00::/8")
+)
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	return ipNet
+}
+
+// IsPrivateNetworkCIDR validates that the value is a subnet usable by a Private Network:
+// an RFC 1918 IPv4 range between /20 and /28, or a unique local IPv6 /64 range.
+func IsPrivateNetworkCIDR() schema.SchemaValidateDiagFunc {
+	return func(i any, path cty.Path) diag.Diagnostics {
+		v, ok := i.(string)
+		if !ok {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "expected type to be string",
+				AttributePath: path,
+			}}
+		}
+
+		ip, ipNet, err := net.ParseCIDR(v)
+		if err != nil {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("expected a valid CIDR, got %v", v),
+				AttributePath: path,
+			}}
+		}
+
+		if !ip.Equal(ipNet.IP) {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("%v is not a network address, expected %v", v, ipNet),
+				AttributePath: path,
+			}}
+		}
+
+		prefixLength, _ := ipNet.Mask.Size()
+
+		if ip.To4() == nil {
+			if !privateNetworkIPv6Range.Contains(ip) || prefixLength != PrivateNetworkIPv6PrefixLength {
+				return diag.Diagnostics{diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       fmt.Sprintf("%v cannot be used by a Private Network, IPv6 subnets must be /%d ranges within %v", v, PrivateNetworkIPv6PrefixLength, privateNetworkIPv6Range),
+					AttributePath: path,
+				}}
+			}
+
+			return nil
+		}
+
+		if !slices.ContainsFunc(privateNetworkIPv4Ranges, func(r *net.IPNet) bool { return r.Contains(ip) }) ||
+			prefixLength < PrivateNetworkIPv4MinPrefixLength || prefixLength > PrivateNetworkIPv4MaxPrefixLength {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("%v cannot be used by a Private Network, IPv4 subnets must be RFC 1918 ranges (10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16) between /%d and /%d", v, PrivateNetworkIPv4MinPrefixLength, PrivateNetworkIPv4MaxPrefixLength),
+				AttributePath: path,
+			}}
+		}
+
+		return nil
+	}
+}
@@ -21,3 +21,17 @@ func TestValidateStandaloneIPorCIDRWithInvalidIPReturnError(t *testing.T) {
 		assert.Len(t, diags, 1)
 	}
 }
+
+func TestValidatePrivateNetworkCIDRWithValidCIDRReturnNothing(t *testing.T) {
+	for _, cidr := range []string{"172.16.32.0/22", "10.0.0.0/20", "192.168.0.0/28", "fd46:78ab:30b8:c949::/64"} {
+		diags := verify.IsPrivateNetworkCIDR()(cidr, cty.Path{})
+		assert.Empty(t, diags)
+	}
+}
+
+func TestValidatePrivateNetworkCIDRWithInvalidCIDRReturnError(t *testing.T) {
+	for _, cidr := range []string{"172.16.32.1", "172.16.32.1/22", "51.15.0.0/24", "10.0.0.0/16", "192.168.0.0/30", "2001:db8::/64", "fd46:78ab:30b8::/48"} {
+		diags := verify.IsPrivateNetworkCIDR()(cidr, cty.Path{})
+		assert.Len(t, diags, 1)
+	}
+}
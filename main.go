@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
 	"github.com/scaleway/terraform-provider-scaleway/v2/provider"
 )
 
@@ -38,6 +39,9 @@ func main() {
 		muxServer.ProviderServer,
 		serveOpts...,
 	)
+
+	logging.CloseHTTPLogs()
+
 	if err != nil {
 		log.Fatal(err)
 	}
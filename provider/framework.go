@@ -3,6 +3,9 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -12,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
@@ -22,12 +26,15 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/billing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/block"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/cockpit"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/container"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/datalab"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/domain"
+	functionservice "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/function"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/jobs"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/k8s"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/keymanager"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/lb"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/mongodb"
@@ -40,6 +47,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/secret"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpcgw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
 var (
@@ -50,6 +58,8 @@ var (
 
 type ScalewayProvider struct {
 	providerMeta *meta.Meta
+	// configuredMeta is the meta used by the resources once the provider is configured
+	configuredMeta *meta.Meta
 }
 
 func NewFrameworkProvider(m *meta.Meta) func() provider.Provider {
@@ -63,14 +73,51 @@ func (p *ScalewayProvider) Metadata(_ context.Context, _ provider.MetadataReques
 }
 
 type ScalewayProviderModel struct {
-	AccessKey      types.String `tfsdk:"access_key"`
-	SecretKey      types.String `tfsdk:"secret_key"`
-	Profile        types.String `tfsdk:"profile"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	APIURL         types.String `tfsdk:"api_url"`
-	Region         types.String `tfsdk:"region"`
-	Zone           types.String `tfsdk:"zone"`
+	AccessKey            types.String  `tfsdk:"access_key"`
+	SecretKey            types.String  `tfsdk:"secret_key"`
+	Profile              types.String  `tfsdk:"profile"`
+	ProjectID            types.String  `tfsdk:"project_id"`
+	OrganizationID       types.String  `tfsdk:"organization_id"`
+	APIURL               types.String  `tfsdk:"api_url"`
+	Region               types.String  `tfsdk:"region"`
+	Zone                 types.String  `tfsdk:"zone"`
+	DefaultTags          types.List    `tfsdk:"default_tags"`
+	IgnoreTags           types.List    `tfsdk:"ignore_tags"`
+	Retry                types.List    `tfsdk:"retry"`
+	MaxRequestsPerSecond types.Float64 `tfsdk:"max_requests_per_second"`
+	CredentialsSecret    types.List    `tfsdk:"credentials_secret"`
+	HTTPLogFile          types.String  `tfsdk:"http_log_file"`
+	DefaultTimeouts      types.List    `tfsdk:"default_timeouts"`
+}
+
+type ScalewayProviderDefaultTagsModel struct {
+	Tags types.List `tfsdk:"tags"`
+}
+
+type ScalewayProviderIgnoreTagsModel struct {
+	Keys        types.List `tfsdk:"keys"`
+	KeyPrefixes types.List `tfsdk:"key_prefixes"`
+}
+
+type ScalewayProviderCredentialsSecretModel struct {
+	SecretID types.String `tfsdk:"secret_id"`
+	Revision types.String `tfsdk:"revision"`
+}
+
+type ScalewayProviderDefaultTimeoutsModel struct {
+	Create       types.String `tfsdk:"create"`
+	Read         types.String `tfsdk:"read"`
+	Update       types.String `tfsdk:"update"`
+	Delete       types.String `tfsdk:"delete"`
+	Default      types.String `tfsdk:"default"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+}
+
+type ScalewayProviderRetryModel struct {
+	MaxAttempts   types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff    types.String `tfsdk:"min_backoff"`
+	MaxBackoff    types.String `tfsdk:"max_backoff"`
+	RetryOnStatus types.List   `tfsdk:"retry_on_status"`
 }
 
 func (p *ScalewayProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -108,11 +155,171 @@ func (p *ScalewayProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:    true,
 				Description: "The zone you want to attach the resource to",
 			},
+			"http_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a file where every request sent to the Scaleway API is written as a JSON line, with secrets redacted. Can also be set with the SCW_TF_HTTP_LOG_FILE environment variable.",
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests per second sent to the Scaleway API. No limit if unset.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				Description: "Tags added to every resource supporting tags.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The tags added to every resource supporting tags.",
+						},
+					},
+				},
+			},
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "Tags managed outside of Terraform, ignored when reading the tags of resources.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The tag keys to ignore. The key of a tag is the part before the first `=`, or the whole tag.",
+						},
+						"key_prefixes": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The tag key prefixes to ignore.",
+						},
+					},
+				},
+			},
+			"credentials_secret": schema.ListNestedBlock{
+				Description: "A Secret Manager secret version containing the credentials to use, accessed with the credentials of the other sources.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"secret_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the secret, with or without its region (e.g. `fr-par/11111111-1111-1111-1111-111111111111`).",
+							Validators: []validator.String{
+								verify.IsStringUUIDOrUUIDWithLocality(),
+							},
+						},
+						"revision": schema.StringAttribute{
+							Optional:    true,
+							Description: "The revision of the secret version: a number, `latest` or `latest_enabled`. Defaults to `latest_enabled`.",
+						},
+					},
+				},
+			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Timeouts used by every resource supporting them, unless the resource sets its own in a timeouts block.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout of the creation of resources (e.g. `30m`).",
+							Validators: []validator.String{
+								verify.IsStringDuration(),
+							},
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout of the read of resources and data sources (e.g. `10m`).",
+							Validators: []validator.String{
+								verify.IsStringDuration(),
+							},
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout of the update of resources and of actions (e.g. `30m`).",
+							Validators: []validator.String{
+								verify.IsStringDuration(),
+							},
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout of the deletion of resources (e.g. `30m`).",
+							Validators: []validator.String{
+								verify.IsStringDuration(),
+							},
+						},
+						"default": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout of the operations of resources with a single timeout for every operation (e.g. `30m`).",
+							Validators: []validator.String{
+								verify.IsStringDuration(),
+							},
+						},
+						"poll_interval": schema.StringAttribute{
+							Optional:    true,
+							Description: "The interval between two checks of the status of a resource while waiting for it (e.g. `10s`).",
+							Validators: []validator.String{
+								verify.IsStringDuration(),
+							},
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				Description: "The retry policy of the requests sent to the Scaleway API.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of attempts of a request, including the first one. Defaults to 4.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"min_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The minimum time to wait before retrying a request (e.g. `2s`). Defaults to `2s`.",
+							Validators: []validator.String{
+								verify.IsStringDuration(),
+							},
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum time to wait before retrying a request (e.g. `2m`). Defaults to `2m`.",
+							Validators: []validator.String{
+								verify.IsStringDuration(),
+							},
+						},
+						"retry_on_status": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.Int64Type,
+							Description: "The HTTP status codes to retry in addition to 429 and 5xx.",
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func modelToFrameworkConfig(model *ScalewayProviderModel) *meta.FrameworkProviderConfig {
+func modelToFrameworkConfig(ctx context.Context, model *ScalewayProviderModel) (*meta.FrameworkProviderConfig, diag.Diagnostics) {
 	config := &meta.FrameworkProviderConfig{}
 
 	if !model.AccessKey.IsNull() && !model.AccessKey.IsUnknown() {
@@ -147,7 +354,93 @@ func modelToFrameworkConfig(model *ScalewayProviderModel) *meta.FrameworkProvide
 		config.APIURL = model.APIURL.ValueString()
 	}
 
-	return config
+	var diags diag.Diagnostics
+
+	if !model.DefaultTags.IsNull() && !model.DefaultTags.IsUnknown() {
+		var defaultTags []ScalewayProviderDefaultTagsModel
+
+		diags.Append(model.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+
+		if len(defaultTags) > 0 && !defaultTags[0].Tags.IsNull() && !defaultTags[0].Tags.IsUnknown() {
+			diags.Append(defaultTags[0].Tags.ElementsAs(ctx, &config.DefaultTags, false)...)
+		}
+	}
+
+	if !model.IgnoreTags.IsNull() && !model.IgnoreTags.IsUnknown() {
+		var ignoreTags []ScalewayProviderIgnoreTagsModel
+
+		diags.Append(model.IgnoreTags.ElementsAs(ctx, &ignoreTags, false)...)
+
+		if len(ignoreTags) > 0 {
+			config.IgnoreTags = &meta.IgnoreTagsConfig{}
+
+			if !ignoreTags[0].Keys.IsNull() && !ignoreTags[0].Keys.IsUnknown() {
+				diags.Append(ignoreTags[0].Keys.ElementsAs(ctx, &config.IgnoreTags.Keys, false)...)
+			}
+
+			if !ignoreTags[0].KeyPrefixes.IsNull() && !ignoreTags[0].KeyPrefixes.IsUnknown() {
+				diags.Append(ignoreTags[0].KeyPrefixes.ElementsAs(ctx, &config.IgnoreTags.KeyPrefixes, false)...)
+			}
+		}
+	}
+
+	if !model.Retry.IsNull() && !model.Retry.IsUnknown() {
+		var retry []ScalewayProviderRetryModel
+
+		diags.Append(model.Retry.ElementsAs(ctx, &retry, false)...)
+
+		if len(retry) > 0 {
+			config.Retry = &meta.RetryConfig{
+				MaxAttempts: int(retry[0].MaxAttempts.ValueInt64()),
+				MinBackoff:  retry[0].MinBackoff.ValueString(),
+				MaxBackoff:  retry[0].MaxBackoff.ValueString(),
+			}
+
+			if !retry[0].RetryOnStatus.IsNull() && !retry[0].RetryOnStatus.IsUnknown() {
+				diags.Append(retry[0].RetryOnStatus.ElementsAs(ctx, &config.Retry.RetryOnStatus, false)...)
+			}
+		}
+	}
+
+	if !model.CredentialsSecret.IsNull() && !model.CredentialsSecret.IsUnknown() {
+		var credentialsSecret []ScalewayProviderCredentialsSecretModel
+
+		diags.Append(model.CredentialsSecret.ElementsAs(ctx, &credentialsSecret, false)...)
+
+		if len(credentialsSecret) > 0 {
+			config.CredentialsSecret = &meta.CredentialsSecretConfig{
+				SecretID: credentialsSecret[0].SecretID.ValueString(),
+				Revision: credentialsSecret[0].Revision.ValueString(),
+			}
+		}
+	}
+
+	if !model.DefaultTimeouts.IsNull() && !model.DefaultTimeouts.IsUnknown() {
+		var defaultTimeouts []ScalewayProviderDefaultTimeoutsModel
+
+		diags.Append(model.DefaultTimeouts.ElementsAs(ctx, &defaultTimeouts, false)...)
+
+		if len(defaultTimeouts) > 0 {
+			config.DefaultTimeouts = &meta.DefaultTimeoutsConfig{
+				Create:       defaultTimeouts[0].Create.ValueString(),
+				Read:         defaultTimeouts[0].Read.ValueString(),
+				Update:       defaultTimeouts[0].Update.ValueString(),
+				Delete:       defaultTimeouts[0].Delete.ValueString(),
+				Default:      defaultTimeouts[0].Default.ValueString(),
+				PollInterval: defaultTimeouts[0].PollInterval.ValueString(),
+			}
+		}
+	}
+
+	if !model.HTTPLogFile.IsNull() && !model.HTTPLogFile.IsUnknown() {
+		config.HTTPLogFile = model.HTTPLogFile.ValueString()
+	}
+
+	if !model.MaxRequestsPerSecond.IsNull() && !model.MaxRequestsPerSecond.IsUnknown() {
+		config.MaxRequestsPerSecond = model.MaxRequestsPerSecond.ValueFloat64()
+	}
+
+	return config, diags
 }
 
 func (p *ScalewayProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -169,8 +462,16 @@ func (p *ScalewayProvider) Configure(ctx context.Context, req provider.Configure
 		m = p.providerMeta
 	} else {
 		frameworkConfig := &meta.FrameworkProviderConfig{}
+
 		if data != nil {
-			frameworkConfig = modelToFrameworkConfig(data)
+			var diags diag.Diagnostics
+
+			frameworkConfig, diags = modelToFrameworkConfig(ctx, data)
+			resp.Diagnostics.Append(diags...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
 		}
 
 		var err error
@@ -200,6 +501,8 @@ func (p *ScalewayProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
+	p.configuredMeta = m
+
 	resp.ResourceData = m
 	resp.DataSourceData = m
 	resp.ActionData = m
@@ -231,6 +534,7 @@ func (p *ScalewayProvider) EphemeralResources(_ context.Context) []func() epheme
 		keymanager.NewEncryptEphemeralResource,
 		keymanager.NewGenerateDataKeyEphemeralResource,
 		keymanager.NewSignEphemeralResource,
+		object.NewPresignedURLEphemeralResource,
 		scwconfig.NewScwConfigEphemeralResource,
 		secret.NewVersionEphemeralResource,
 	}
@@ -288,15 +592,31 @@ func (p *ScalewayProvider) ListResources(_ context.Context) []func() list.ListRe
 		account.NewProjectListResource,
 		block.NewSnapshotListResource,
 		block.NewVolumeListResource,
+		container.NewContainerListResource,
+		container.NewCronListResource,
+		container.NewNamespaceListResource,
+		container.NewTriggerListResource,
 		domain.NewRecordListResource,
 		domain.NewZoneListResource,
+		functionservice.NewCronListResource,
+		functionservice.NewFunctionListResource,
+		functionservice.NewNamespaceListResource,
+		functionservice.NewTriggerListResource,
 		iam.NewSSHKeyListResource,
 		iam.NewGroupListResource,
 		iam.NewUserListResource,
 		iam.NewApplicationListResource,
 		iam.NewPolicyListResource,
 		iam.NewAPIKeyListResource,
+		instance.NewIPListResource,
+		instance.NewPlacementGroupListResource,
+		instance.NewSecurityGroupListResource,
+		instance.NewServerListResource,
+		instance.NewSnapshotListResource,
+		instance.NewVolumeListResource,
 		ipam.NewIPListResource,
+		k8s.NewClusterListResource,
+		k8s.NewPoolListResource,
 		keymanager.NewKeyListResource,
 		lb.NewLbListResource,
 		lb.NewFrontendListResource,
@@ -324,5 +644,16 @@ func (p *ScalewayProvider) Functions(_ context.Context) []func() function.Functi
 	return []func() function.Function{
 		functions.NewRegionFromID,
 		functions.NewIDFromRegionalID,
+		functions.NewZoneFromID,
+		functions.NewIDFromZonalID,
+		functions.NewLocalityFromID,
+		functions.NewBuildRegionalID,
+		functions.NewBuildZonalID,
+		functions.NewParseSRN,
+		functions.NewZoneToRegion,
+		functions.NewIsValidUUID,
+		functions.NewCIDRSubnetsNonOverlapping,
+		functions.NewVPCReservedIPs,
+		functions.NewCIDRContains,
 	}
 }
@@ -56,6 +56,7 @@ func TestFrameworkProviderConfigSources_ActiveProfile(t *testing.T) {
 		profile, credentialsSource, err := meta.LoadProfileFromFrameworkConfig(
 			t.Context(),
 			&meta.FrameworkProviderConfig{},
+			nil,
 		)
 		if err != nil {
 			t.Fatalf("Failed to load profile: %v", err)
@@ -110,6 +111,7 @@ func TestFrameworkProviderConfigSources_ProviderConfig(t *testing.T) {
 				Region:    "fr-par",
 				Zone:      "fr-par-1",
 			},
+			nil,
 		)
 		if err != nil {
 			t.Fatalf("Failed to load profile: %v", err)
@@ -168,6 +170,7 @@ func TestFrameworkProviderConfigSources_EnvConfig(t *testing.T) {
 				Region:    "fr-par",
 				Zone:      "fr-par-1",
 			},
+			nil,
 		)
 		if err != nil {
 			t.Fatalf("Failed to load profile: %v", err)
@@ -207,6 +210,7 @@ func TestFrameworkProviderConfigSources_NoConfig(t *testing.T) {
 		profile, _, err := meta.LoadProfileFromFrameworkConfig(
 			t.Context(),
 			&meta.FrameworkProviderConfig{},
+			nil,
 		)
 		if err != nil {
 			t.Fatalf("Failed to load profile: %v", err)
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+)
+
+// frameworkProviderServer is the protocol server of the framework provider, with the RPCs the mux server looks for.
+type frameworkProviderServer interface {
+	tfprotov6.ProviderServer
+	tfprotov6.ListResourceServer
+	tfprotov6.ActionServer
+}
+
+// contextProviderServer records the type and ID of the framework resources, data sources and actions in the context of their RPCs,
+// for the HTTP log, and the poll interval of the provider default_timeouts block, for the waiters.
+// It is the framework counterpart of addResourceContext.
+type contextProviderServer struct {
+	frameworkProviderServer
+
+	provider *ScalewayProvider
+
+	resourceTypesOnce sync.Once
+	resourceTypes     map[string]tftypes.Type
+}
+
+// newContextProviderServer wraps the protocol server of the framework provider, the server is returned as is if it does not serve every RPC.
+func newContextProviderServer(server tfprotov6.ProviderServer, provider *ScalewayProvider) tfprotov6.ProviderServer {
+	frameworkServer, ok := server.(frameworkProviderServer)
+	if !ok {
+		return server
+	}
+
+	return &contextProviderServer{
+		frameworkProviderServer: frameworkServer,
+		provider:                provider,
+	}
+}
+
+func (s *contextProviderServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return s.frameworkProviderServer.ReadResource(s.withResource(ctx, req.TypeName, req.CurrentState), req)
+}
+
+func (s *contextProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return s.frameworkProviderServer.PlanResourceChange(s.withResource(ctx, req.TypeName, req.PriorState), req)
+}
+
+func (s *contextProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	return s.frameworkProviderServer.ApplyResourceChange(s.withResource(ctx, req.TypeName, req.PriorState), req)
+}
+
+func (s *contextProviderServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	return s.frameworkProviderServer.ImportResourceState(s.withContext(ctx, req.TypeName, req.ID), req)
+}
+
+func (s *contextProviderServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return s.frameworkProviderServer.ReadDataSource(s.withContext(ctx, req.TypeName, ""), req)
+}
+
+func (s *contextProviderServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	return s.frameworkProviderServer.OpenEphemeralResource(s.withContext(ctx, req.TypeName, ""), req)
+}
+
+func (s *contextProviderServer) ListResource(ctx context.Context, req *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	return s.frameworkProviderServer.ListResource(s.withContext(ctx, req.TypeName, ""), req)
+}
+
+func (s *contextProviderServer) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionServerStream, error) {
+	return s.frameworkProviderServer.InvokeAction(s.withContext(ctx, req.ActionType, ""), req)
+}
+
+// withResource is withContext for resources, with the id attribute of their state.
+// The ID is empty when the resource has no state yet, like during its creation.
+func (s *contextProviderServer) withResource(ctx context.Context, typeName string, state *tfprotov6.DynamicValue) context.Context {
+	return s.withContext(ctx, typeName, s.resourceID(ctx, typeName, state))
+}
+
+// withContext returns a context recording the type and ID of the resource, and the poll interval of the configured provider.
+func (s *contextProviderServer) withContext(ctx context.Context, typeName string, resourceID string) context.Context {
+	ctx = transport.WithResource(ctx, typeName, resourceID)
+
+	if s.provider != nil && s.provider.configuredMeta != nil {
+		ctx = transport.WithWaitRetryInterval(ctx, s.provider.configuredMeta.DefaultTimeouts().PollInterval)
+	}
+
+	return ctx
+}
+
+func (s *contextProviderServer) resourceID(ctx context.Context, typeName string, state *tfprotov6.DynamicValue) string {
+	if state == nil {
+		return ""
+	}
+
+	s.resourceTypesOnce.Do(func() {
+		s.resourceTypes = map[string]tftypes.Type{}
+
+		resp, err := s.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		if err != nil {
+			return
+		}
+
+		for name, resourceSchema := range resp.ResourceSchemas {
+			s.resourceTypes[name] = resourceSchema.ValueType()
+		}
+	})
+
+	resourceType, exists := s.resourceTypes[typeName]
+	if !exists {
+		return ""
+	}
+
+	value, err := state.Unmarshal(resourceType)
+	if err != nil || !value.IsKnown() || value.IsNull() {
+		return ""
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := value.As(&attributes); err != nil {
+		return ""
+	}
+
+	id, exists := attributes["id"]
+	if !exists || !id.IsKnown() || id.IsNull() {
+		return ""
+	}
+
+	var resourceID string
+	if err := id.As(&resourceID); err != nil {
+		return ""
+	}
+
+	return resourceID
+}
@@ -28,7 +28,12 @@ func NewProviderList(ctx context.Context, providerConfig *Config) ([]func() tfpr
 
 	return []func() tfprotov6.ProviderServer{
 		// Provider using terraform-plugin-framework
-		providerserver.NewProtocol6(frameworkProvider()),
+		func() tfprotov6.ProviderServer {
+			fwProvider := frameworkProvider()
+			scwProvider, _ := fwProvider.(*ScalewayProvider)
+
+			return newContextProviderServer(providerserver.NewProtocol6(fwProvider)(), scwProvider)
+		},
 
 		func() tfprotov6.ProviderServer {
 			return upgradedSdkProvider
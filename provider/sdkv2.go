@@ -4,9 +4,11 @@ import (
 	"context"
 	"maps"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
@@ -55,6 +57,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpcgw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/webhosting"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -85,6 +88,58 @@ func addBetaResources(provider *schema.Provider) {
 	maps.Copy(provider.DataSourcesMap, betaDataSources)
 }
 
+// applyDefaultTimeouts replaces the default timeouts declared by the resource with the ones of the provider default_timeouts block.
+// Timeouts the resource does not declare are not added, as it would change its schema.
+func applyDefaultTimeouts(resource *schema.Resource, defaultTimeouts meta.DefaultTimeouts) {
+	if resource.Timeouts == nil {
+		return
+	}
+
+	for _, timeout := range []struct {
+		resourceTimeout **time.Duration
+		defaultTimeout  *time.Duration
+	}{
+		{&resource.Timeouts.Create, defaultTimeouts.Create},
+		{&resource.Timeouts.Read, defaultTimeouts.Read},
+		{&resource.Timeouts.Update, defaultTimeouts.Update},
+		{&resource.Timeouts.Delete, defaultTimeouts.Delete},
+		{&resource.Timeouts.Default, defaultTimeouts.Default},
+	} {
+		if *timeout.resourceTimeout != nil && timeout.defaultTimeout != nil {
+			*timeout.resourceTimeout = new(*timeout.defaultTimeout)
+		}
+	}
+}
+
+// addResourceContext makes the CRUD functions of the resource record its type and ID in their context, for the HTTP log,
+// and the poll interval of the provider default_timeouts block, for the waiters.
+func addResourceContext(resourceType string, resource *schema.Resource) {
+	wrap := func(f func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+			ctx = transport.WithResource(ctx, resourceType, d.Id())
+
+			if providerMeta, ok := m.(*meta.Meta); ok {
+				ctx = transport.WithWaitRetryInterval(ctx, providerMeta.DefaultTimeouts().PollInterval)
+			}
+
+			return f(ctx, d, m)
+		}
+	}
+
+	resource.CreateContext = wrap(resource.CreateContext)
+	resource.ReadContext = wrap(resource.ReadContext)
+	resource.UpdateContext = wrap(resource.UpdateContext)
+	resource.DeleteContext = wrap(resource.DeleteContext)
+	resource.CreateWithoutTimeout = wrap(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = wrap(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = wrap(resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = wrap(resource.DeleteWithoutTimeout)
+}
+
 // SDKProvider returns a terraform.ResourceProvider.
 func SDKProvider(config *Config) plugin.ProviderFunc {
 	return func() *schema.Provider {
@@ -125,6 +180,165 @@ func SDKProvider(config *Config) plugin.ProviderFunc {
 					Optional:    true,
 					Description: "The Scaleway API URL to use.",
 				},
+				"default_tags": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Tags added to every resource supporting tags.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tags": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The tags added to every resource supporting tags.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				"ignore_tags": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Tags managed outside of Terraform, ignored when reading the tags of resources.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"keys": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The tag keys to ignore. The key of a tag is the part before the first `=`, or the whole tag.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"key_prefixes": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The tag key prefixes to ignore.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				"retry": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "The retry policy of the requests sent to the Scaleway API.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_attempts": {
+								Type:             schema.TypeInt,
+								Optional:         true,
+								Description:      "The maximum number of attempts of a request, including the first one. Defaults to 4.",
+								ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+							},
+							"min_backoff": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The minimum time to wait before retrying a request (e.g. `2s`). Defaults to `2s`.",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"max_backoff": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The maximum time to wait before retrying a request (e.g. `2m`). Defaults to `2m`.",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"retry_on_status": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The HTTP status codes to retry in addition to 429 and 5xx.",
+								Elem: &schema.Schema{
+									Type:             schema.TypeInt,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(100, 599)),
+								},
+							},
+						},
+					},
+				},
+				"credentials_secret": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "A Secret Manager secret version containing the credentials to use, accessed with the credentials of the other sources.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"secret_id": {
+								Type:             schema.TypeString,
+								Required:         true,
+								Description:      "The ID of the secret, with or without its region (e.g. `fr-par/11111111-1111-1111-1111-111111111111`).",
+								ValidateDiagFunc: verify.IsUUIDorUUIDWithLocality(),
+							},
+							"revision": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The revision of the secret version: a number, `latest` or `latest_enabled`. Defaults to `latest_enabled`.",
+							},
+						},
+					},
+				},
+				"max_requests_per_second": {
+					Type:             schema.TypeFloat,
+					Optional:         true,
+					Description:      "The maximum number of requests per second sent to the Scaleway API. No limit if unset.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
+				},
+				"default_timeouts": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Timeouts used by every resource supporting them, unless the resource sets its own in a timeouts block.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"create": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The default timeout of the creation of resources (e.g. `30m`).",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"read": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The default timeout of the read of resources and data sources (e.g. `10m`).",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"update": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The default timeout of the update of resources and of actions (e.g. `30m`).",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"delete": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The default timeout of the deletion of resources (e.g. `30m`).",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"default": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The default timeout of the operations of resources with a single timeout for every operation (e.g. `30m`).",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"poll_interval": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The interval between two checks of the status of a resource while waiting for it (e.g. `10s`).",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+						},
+					},
+				},
+				"http_log_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The path of a file where every request sent to the Scaleway API is written as a JSON line, with secrets redacted. Can also be set with the SCW_TF_HTTP_LOG_FILE environment variable.",
+				},
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
@@ -190,6 +404,7 @@ func SDKProvider(config *Config) plugin.ProviderFunc {
 				"scaleway_instance_placement_group":                           instance.ResourcePlacementGroup(),
 				"scaleway_instance_private_nic":                               instance.ResourcePrivateNIC(),
 				"scaleway_instance_security_group":                            instance.ResourceSecurityGroup(),
+				"scaleway_instance_security_group_rule":                       instance.ResourceSecurityGroupRule(),
 				"scaleway_instance_security_group_rules":                      instance.ResourceSecurityGroupRules(),
 				"scaleway_instance_server":                                    instance.ResourceServer(),
 				"scaleway_instance_snapshot":                                  instance.ResourceSnapshot(),
@@ -229,12 +444,18 @@ func SDKProvider(config *Config) plugin.ProviderFunc {
 				"scaleway_mongodb_snapshot":                                   mongodb.ResourceSnapshot(),
 				"scaleway_mongodb_user":                                       mongodb.ResourceUser(),
 				"scaleway_object":                                             object.ResourceObject(),
+				"scaleway_object_directory":                                   object.ResourceObjectDirectory(),
 				"scaleway_opensearch_deployment":                              opensearch.ResourceDeployment(),
 				"scaleway_object_bucket":                                      object.ResourceBucket(),
 				"scaleway_object_bucket_acl":                                  object.ResourceBucketACL(),
+				"scaleway_object_bucket_cors_configuration":                   object.ResourceBucketCorsConfiguration(),
+				"scaleway_object_bucket_lifecycle_configuration":              object.ResourceBucketLifecycleConfiguration(),
 				"scaleway_object_bucket_lock_configuration":                   object.ResourceLockConfiguration(),
+				"scaleway_object_bucket_notification":                         object.ResourceBucketNotification(),
 				"scaleway_object_bucket_policy":                               object.ResourceBucketPolicy(),
+				"scaleway_object_bucket_replication_configuration":            object.ResourceBucketReplicationConfiguration(),
 				"scaleway_object_bucket_server_side_encryption_configuration": object.ResourceBucketServerSideEncryptionConfiguration(),
+				"scaleway_object_bucket_versioning":                           object.ResourceBucketVersioning(),
 				"scaleway_object_bucket_website_configuration":                object.ResourceBucketWebsiteConfiguration(),
 				"scaleway_rdb_acl":                                            rdb.ResourceACL(),
 				"scaleway_rdb_database":                                       rdb.ResourceDatabase(),
@@ -328,6 +549,7 @@ func SDKProvider(config *Config) plugin.ProviderFunc {
 				"scaleway_instance_placement_group":                           instance.DataSourcePlacementGroup(),
 				"scaleway_instance_private_nic":                               instance.DataSourcePrivateNIC(),
 				"scaleway_instance_security_group":                            instance.DataSourceSecurityGroup(),
+				"scaleway_instance_security_group_rules":                      instance.DataSourceSecurityGroupRules(),
 				"scaleway_instance_server":                                    instance.DataSourceServer(),
 				"scaleway_instance_servers":                                   instance.DataSourceServers(),
 				"scaleway_instance_server_type":                               instance.DataSourceServerType(),
@@ -410,6 +632,14 @@ func SDKProvider(config *Config) plugin.ProviderFunc {
 
 		addBetaResources(p)
 
+		for resourceType, resource := range p.ResourcesMap {
+			addResourceContext(resourceType, resource)
+		}
+
+		for resourceType, resource := range p.DataSourcesMap {
+			addResourceContext(resourceType, resource)
+		}
+
 		p.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (any, diag.Diagnostics) {
 			terraformVersion := p.TerraformVersion
 
@@ -426,6 +656,14 @@ func SDKProvider(config *Config) plugin.ProviderFunc {
 				return nil, diag.FromErr(err)
 			}
 
+			for _, resource := range p.ResourcesMap {
+				applyDefaultTimeouts(resource, m.DefaultTimeouts())
+			}
+
+			for _, dataSource := range p.DataSourcesMap {
+				applyDefaultTimeouts(dataSource, m.DefaultTimeouts())
+			}
+
 			var diags diag.Diagnostics
 
 			ok, message, err := m.HasMultipleVariableSources()
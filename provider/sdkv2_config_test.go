@@ -70,6 +70,7 @@ func TestSDKv2ProviderConfigSources_ActiveProfile(t *testing.T) {
 		profile, credentialsSource, err := meta.LoadProfile(
 			context.Background(),
 			providerSchema,
+			nil,
 		)
 		if err != nil {
 			t.Fatalf("Failed to load profile: %v", err)
@@ -162,6 +163,7 @@ func TestSDKv2ProviderConfigSources_ProviderConfig(t *testing.T) {
 		profile, credentialsSource, err := meta.LoadProfile(
 			context.Background(),
 			providerSchema,
+			nil,
 		)
 		if err != nil {
 			t.Fatalf("Failed to load profile: %v", err)
@@ -258,6 +260,7 @@ func TestSDKv2ProviderConfigSources_EnvConfig(t *testing.T) {
 		profile, credentialsSource, err := meta.LoadProfile(
 			context.Background(),
 			providerSchema,
+			nil,
 		)
 		if err != nil {
 			t.Fatalf("Failed to load profile: %v", err)
@@ -335,6 +338,7 @@ func TestSDKv2ProviderConfigSources_NoConfig(t *testing.T) {
 		profile, _, err := meta.LoadProfile(
 			context.Background(),
 			providerSchema,
+			nil,
 		)
 		if err != nil {
 			t.Fatalf("Failed to load profile: %v", err)
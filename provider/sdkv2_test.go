@@ -214,6 +214,24 @@ func TestSDKProvider_ResourceIdentityNotEmpty(t *testing.T) {
 	}
 }
 
+func TestSDKProvider_TaggedResourcesHaveTagsAll(t *testing.T) {
+	p := provider.SDKProvider(nil)()
+	for name, d := range p.ResourcesMap {
+		tagsSchema, hasTags := d.SchemaMap()["tags"]
+		if !hasTags || !tagsSchema.Optional {
+			continue
+		}
+
+		if _, hasTagsAll := d.SchemaMap()["tags_all"]; !hasTagsAll {
+			t.Errorf("tags_all for resource %s is missing", name)
+		}
+
+		if d.CustomizeDiff == nil {
+			t.Errorf("CustomizeDiff for resource %s is nil, tags_all is never planned", name)
+		}
+	}
+}
+
 func TestSDKProvider_ResourceImporterNotEmpty(t *testing.T) {
 	p := provider.SDKProvider(nil)()
 	for name, d := range p.ResourcesMap {
@@ -250,7 +268,7 @@ func TestSDKProvider_DataSourceExistForEachResource(t *testing.T) {
 		"scaleway_iam_group_membership",
 		"scaleway_inference_deployment",
 		"scaleway_instance_ip_reverse_dns",
-		"scaleway_instance_security_group_rules",
+		"scaleway_instance_security_group_rule",
 		"scaleway_instance_user_data",
 		"scaleway_iot_network",
 		"scaleway_iot_route",
@@ -269,8 +287,14 @@ func TestSDKProvider_DataSourceExistForEachResource(t *testing.T) {
 		"scaleway_mongodb_snapshot",
 		"scaleway_mongodb_user",
 		"scaleway_object_bucket_acl",
+		"scaleway_object_bucket_cors_configuration",
+		"scaleway_object_bucket_lifecycle_configuration",
 		"scaleway_object_bucket_lock_configuration",
+		"scaleway_object_bucket_notification",
+		"scaleway_object_bucket_replication_configuration",
+		"scaleway_object_bucket_versioning",
 		"scaleway_object_bucket_website_configuration",
+		"scaleway_object_directory",
 		"scaleway_rdb_read_replica",
 		"scaleway_rdb_snapshot",
 		"scaleway_rdb_user",